@@ -5,15 +5,25 @@ import (
 	"log"
 	"net"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
 	"github.com/daiyanuthsa/grpc-ecom-be/internal/handler"
 	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
 	"github.com/daiyanuthsa/grpc-ecom-be/internal/service"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/address"
 	"github.com/daiyanuthsa/grpc-ecom-be/pb/auth"
 	"github.com/daiyanuthsa/grpc-ecom-be/pb/cart"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/category"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/coupon"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/inventory"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/order"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/payment"
 	"github.com/daiyanuthsa/grpc-ecom-be/pb/product"
-	gocache "github.com/patrickmn/go-cache"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/role"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/user"
 
 	"github.com/daiyanuthsa/grpc-ecom-be/internal/middleware"
 	database "github.com/daiyanuthsa/grpc-ecom-be/pkg"
@@ -39,42 +49,208 @@ func main() {
 		log.Fatalf("failed to connect to database: %v", err)
 	}
 
+	if _, err := jwtentity.DefaultKeySet(); err != nil {
+		log.Fatalf("failed to load JWT keys: %v", err)
+	}
+
 	// Define all public (unauthenticated) endpoints in a single, clean slice.
 	publicEndpoints := []string{
 		"/auth.AuthService/Login",
 		"/auth.AuthService/Register", // Easy to add new ones!
+		"/auth.AuthService/RefreshToken",
+		"/auth.AuthService/VerifyEmail",
+		"/auth.AuthService/ResendVerification",
+		"/auth.AuthService/RequestPasswordReset",
+		"/auth.AuthService/ResetPassword",
+		"/auth.AuthService/GetOIDCAuthorizationURL",
+		"/auth.AuthService/OIDCLogin",
+		"/auth.AuthService/VerifyTOTP",
 		"/product.ProductService/DetailProduct",
 		"/product.ProductService/ListProducts",
 		"/product.ProductService/HighlightProducts",
+		"/product.ProductService/SearchProducts",
+		"/product.ProductService/ListProductsByCategory",
+		"/category.CategoryService/ListCategories",
+		"/inventory.InventoryService/GetStock",
+		"/cart.CartService/CreateGuestCart",
 	}
 
-	cacheService := gocache.New(time.Hour*24, time.Hour)
-	authMiddleware := middleware.NewAuthMiddleware(cacheService, publicEndpoints)
+	// Endpoint yang bisa dipanggil dengan atau tanpa login; tanpa login dipakai guest cart dari metadata x-cart-token.
+	optionalAuthEndpoints := []string{
+		"/cart.CartService/AddProductToCart",
+		"/cart.CartService/ListCart",
+		"/cart.CartService/UpdateCartItem",
+		"/cart.CartService/DeleteCartItem",
+	}
+
+	// Permission yang dibutuhkan tiap method; method yang tidak ada di sini cukup login saja.
+	methodPermissions := map[string]string{
+		"/auth.AuthService/UnlockAccount":                entity.PermissionUserManage,
+		"/product.ProductService/CreateProduct":          entity.PermissionProductWrite,
+		"/product.ProductService/UpdateProduct":          entity.PermissionProductWrite,
+		"/product.ProductService/DeleteProduct":          entity.PermissionProductWrite,
+		"/product.ProductService/ListProductsAdmin":      entity.PermissionProductRead,
+		"/product.ProductService/SetProductOptions":      entity.PermissionProductWrite,
+		"/product.ProductService/CreateProductVariant":   entity.PermissionProductWrite,
+		"/product.ProductService/UpdateProductVariant":   entity.PermissionProductWrite,
+		"/product.ProductService/DeleteProductVariant":   entity.PermissionProductWrite,
+		"/product.ProductService/AttachProductImage":     entity.PermissionProductWrite,
+		"/product.ProductService/ReorderProductImages":   entity.PermissionProductWrite,
+		"/product.ProductService/SetPrimaryProductImage": entity.PermissionProductWrite,
+		"/product.ProductService/DetachProductImage":     entity.PermissionProductWrite,
+		"/category.CategoryService/CreateCategory":       entity.PermissionCategoryWrite,
+		"/category.CategoryService/UpdateCategory":       entity.PermissionCategoryWrite,
+		"/category.CategoryService/DeleteCategory":       entity.PermissionCategoryWrite,
+		"/inventory.InventoryService/SetStock":           entity.PermissionInventoryWrite,
+		"/inventory.InventoryService/AdjustStock":        entity.PermissionInventoryWrite,
+		"/order.OrderService/UpdateOrderStatus":          entity.PermissionOrderWrite,
+		"/payment.PaymentService/CapturePayment":         entity.PermissionPaymentWrite,
+		"/payment.PaymentService/RefundPayment":          entity.PermissionPaymentWrite,
+		"/coupon.CouponService/CreateCoupon":             entity.PermissionCouponManage,
+		"/coupon.CouponService/ListCoupons":              entity.PermissionCouponManage,
+		"/coupon.CouponService/DeactivateCoupon":         entity.PermissionCouponManage,
+		"/role.RoleService/ListPermissions":              entity.PermissionRoleManage,
+		"/role.RoleService/ListRoles":                    entity.PermissionRoleManage,
+		"/role.RoleService/CreateRole":                   entity.PermissionRoleManage,
+		"/role.RoleService/UpdateRole":                   entity.PermissionRoleManage,
+		"/role.RoleService/DeleteRole":                   entity.PermissionRoleManage,
+		"/role.RoleService/AssignUserRole":               entity.PermissionRoleManage,
+		"/user.UserAdminService/ListUsers":               entity.PermissionUserRead,
+		"/user.UserAdminService/GetUser":                 entity.PermissionUserRead,
+		"/user.UserAdminService/ChangeUserRole":          entity.PermissionRoleManage,
+		"/user.UserAdminService/DeleteUser":              entity.PermissionUserManage,
+		"/user.UserAdminService/RestoreUser":             entity.PermissionUserManage,
+		"/user.UserAdminService/ForceLogoutUser":         entity.PermissionUserManage,
+	}
 
 	// Repositories
 	authRepo := repository.NewAuthRepository(db)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	revokedTokenRepo := repository.NewRevokedTokenRepository(db)
+	userTokenRepo := repository.NewUserTokenRepository(db)
+	rateLimitRepo := repository.NewRateLimitRepository(db)
+	userIdentityRepo := repository.NewUserIdentityRepository(db)
+	userTOTPRepo := repository.NewUserTOTPRepository(db)
+	roleRepo := repository.NewRoleRepository(db)
+	userRepo := repository.NewUserRepository(db)
 	productRepo := repository.NewProductRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	variantRepo := repository.NewProductVariantRepository(db)
+	productImageRepo := repository.NewProductImageRepository(db)
 	cartRepo := repository.NewCartRepository(db) // Use SQL DB for Cart
+	guestCartRepo := repository.NewGuestCartRepository(db)
+	inventoryRepo := repository.NewInventoryRepository(db)
+	orderRepo := repository.NewOrderRepository(db)
+	addressRepo := repository.NewAddressRepository(db)
+	paymentRepo := repository.NewPaymentRepository(db)
+	couponRepo := repository.NewCouponRepository(db)
+
+	paymentProvider, err := service.NewPaymentProvider(os.Getenv("PAYMENT_PROVIDER"), os.Getenv("PAYMENT_WEBHOOK_SECRET"))
+	if err != nil {
+		log.Fatalf("failed to create payment provider: %v", err)
+	}
+
+	revocationStore, err := service.NewTokenRevocationStore(ctx, os.Getenv("TOKEN_REVOCATION_STORE"), revokedTokenRepo, time.Hour)
+	if err != nil {
+		log.Fatalf("failed to create token revocation store: %v", err)
+	}
+	authMiddleware := middleware.NewAuthMiddleware(revocationStore, publicEndpoints, optionalAuthEndpoints)
+
+	mailer, err := service.NewMailer(os.Getenv("MAILER"), service.MailerConfig{
+		From:         os.Getenv("MAIL_FROM"),
+		SMTPHost:     os.Getenv("SMTP_HOST"),
+		SMTPPort:     os.Getenv("SMTP_PORT"),
+		SMTPUsername: os.Getenv("SMTP_USERNAME"),
+		SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+		LogDir:       os.Getenv("MAIL_LOG_DIR"),
+		Dev:          os.Getenv("ENVIRONMENT") == "dev",
+	})
+	if err != nil {
+		log.Fatalf("failed to create mailer: %v", err)
+	}
+
+	oidcProviders, err := service.LoadOIDCProviders(os.Getenv("OIDC_PROVIDERS"))
+	if err != nil {
+		log.Fatalf("failed to load OIDC providers: %v", err)
+	}
+
+	totpEncryptionKey, err := service.ParseTOTPEncryptionKey(os.Getenv("TOTP_ENCRYPTION_KEY"))
+	if err != nil {
+		log.Fatalf("failed to load TOTP encryption key: %v", err)
+	}
+	totpRequiredRoles := strings.FieldsFunc(os.Getenv("TOTP_REQUIRED_ROLES"), func(r rune) bool { return r == ',' || r == ' ' })
+	if len(totpRequiredRoles) > 0 && totpEncryptionKey == nil {
+		log.Fatalf("TOTP_ENCRYPTION_KEY is required when TOTP_REQUIRED_ROLES is set")
+	}
+	totpIssuer := os.Getenv("TOTP_ISSUER")
+	if totpIssuer == "" {
+		totpIssuer = "grpc-ecom"
+	}
+
+	pricingConfig, err := service.ParsePricingConfig(os.Getenv("PRICING_TAX_RATE"), os.Getenv("PRICING_SHIPPING_FEE"), os.Getenv("PRICING_FREE_SHIPPING_THRESHOLD"))
+	if err != nil {
+		log.Fatalf("failed to load pricing config: %v", err)
+	}
+	pricingEngine := service.NewPricingEngine(pricingConfig)
+
+	trustedProxyHops, err := service.ParseTrustedProxyHops(os.Getenv("TRUSTED_PROXY_HOPS"))
+	if err != nil {
+		log.Fatalf("failed to load proxy config: %v", err)
+	}
 
 	// Services
-	authService := service.NewAuthService(authRepo, cacheService)
-	productService := service.NewProductService(productRepo, service.NewStorageService(ctx))
-	cartService := service.NewCartService(cartRepo, productRepo)
+	authService := service.NewAuthService(authRepo, refreshTokenRepo, userTokenRepo, rateLimitRepo, userIdentityRepo, userTOTPRepo, guestCartRepo, revocationStore, mailer, oidcProviders, service.AuthConfig{
+		RequireVerifiedEmail: os.Getenv("REQUIRE_VERIFIED_EMAIL") == "true",
+		AppURL:               os.Getenv("FRONTEND_URL"),
+		TrustedProxyHops:     trustedProxyHops,
+		TOTPIssuer:           totpIssuer,
+		TOTPEncryptionKey:    totpEncryptionKey,
+	})
+	roleService := service.NewRoleService(roleRepo, authRepo, revocationStore)
+	permissionMiddleware := middleware.NewPermissionMiddleware(roleService, methodPermissions, totpRequiredRoles)
+	userAdminService := service.NewUserAdminService(userRepo, authRepo, refreshTokenRepo, revocationStore, roleService)
+	productService := service.NewProductService(productRepo, categoryRepo, variantRepo, productImageRepo, service.NewStorageService(ctx))
+	categoryService := service.NewCategoryService(categoryRepo)
+	cartService := service.NewCartService(cartRepo, productRepo, inventoryRepo, variantRepo, guestCartRepo, couponRepo, rateLimitRepo, pricingEngine, trustedProxyHops)
+	go service.RunGuestCartPurge(ctx, guestCartRepo, service.GuestCartPurgeInterval)
+	go service.RunRateLimitPurge(ctx, rateLimitRepo, service.RateLimitPurgeInterval)
+	inventoryService := service.NewInventoryService(inventoryRepo, productRepo, variantRepo)
+	addressService := service.NewAddressService(addressRepo)
+	orderService := service.NewOrderService(orderRepo, addressRepo, pricingEngine)
+	paymentService := service.NewPaymentService(paymentRepo, orderRepo, paymentProvider)
+	couponService := service.NewCouponService(couponRepo, productRepo, categoryRepo)
 
 	// Handlers
 	authHandler := handler.NewAuthHandler(authService)
 	productHandler := handler.NewProductHandler(productService)
+	categoryHandler := handler.NewCategoryHandler(categoryService)
+	roleHandler := handler.NewRoleHandler(roleService)
+	userAdminHandler := handler.NewUserAdminHandler(userAdminService)
 	cartHandler := handler.NewCartHandler(cartService)
+	inventoryHandler := handler.NewInventoryHandler(inventoryService)
+	addressHandler := handler.NewAddressHandler(addressService)
+	orderHandler := handler.NewOrderHandler(orderService)
+	paymentHandler := handler.NewPaymentHandler(paymentService)
+	couponHandler := handler.NewCouponHandler(couponService)
 
 	serv := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			middleware.ErrorMiddleware,
-			authMiddleware.Middleware), // Add the error handling middleware
+			authMiddleware.Middleware,
+			permissionMiddleware.Middleware), // Add the error handling middleware
 	)
 
 	auth.RegisterAuthServiceServer(serv, authHandler)
 	product.RegisterProductServiceServer(serv, productHandler)
+	category.RegisterCategoryServiceServer(serv, categoryHandler)
+	role.RegisterRoleServiceServer(serv, roleHandler)
+	user.RegisterUserAdminServiceServer(serv, userAdminHandler)
 	cart.RegisterCartServiceServer(serv, cartHandler)
+	inventory.RegisterInventoryServiceServer(serv, inventoryHandler)
+	address.RegisterAddressServiceServer(serv, addressHandler)
+	order.RegisterOrderServiceServer(serv, orderHandler)
+	payment.RegisterPaymentServiceServer(serv, paymentHandler)
+	coupon.RegisterCouponServiceServer(serv, couponHandler)
 
 	if os.Getenv("ENVIRONMENT") == "dev" {
 		reflection.Register(serv)
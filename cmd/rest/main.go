@@ -1,10 +1,15 @@
 package main
 
 import (
+	"context"
 	"os"
 	"log"
 
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
 	"github.com/daiyanuthsa/grpc-ecom-be/internal/handler"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/service"
+	database "github.com/daiyanuthsa/grpc-ecom-be/pkg"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/joho/godotenv"
@@ -16,6 +21,23 @@ func main() {
 	// 	log.Fatal("Error loading .env file")
 	// }
 	godotenv.Load()
+	ctx := context.Background()
+
+	db, err := database.ConnectDB(ctx, os.Getenv("DB_URI"))
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+
+	if _, err := jwtentity.DefaultKeySet(); err != nil {
+		log.Fatalf("failed to load JWT keys: %v", err)
+	}
+
+	paymentProvider, err := service.NewPaymentProvider(os.Getenv("PAYMENT_PROVIDER"), os.Getenv("PAYMENT_WEBHOOK_SECRET"))
+	if err != nil {
+		log.Fatalf("failed to create payment provider: %v", err)
+	}
+	paymentService := service.NewPaymentService(repository.NewPaymentRepository(db), repository.NewOrderRepository(db), paymentProvider)
+	paymentWebhookHandler := handler.NewPaymentWebhookHandler(paymentService)
 
 	app := fiber.New(fiber.Config{
 		BodyLimit: 10 * 1024 * 1024,
@@ -38,6 +60,8 @@ func main() {
 	})
 
 	app.Post("/product/upload", handler.UploadProductImageHandler)
+	app.Post("/payment/webhook", paymentWebhookHandler.HandleWebhook)
+	app.Get("/.well-known/jwks.json", handler.JWKSHandler)
 
 
 	log.Println("Starting REST server on port 9000")
@@ -0,0 +1,58 @@
+package entity
+
+import "time"
+
+const (
+	AddressTypeShipping = "shipping"
+	AddressTypeBilling  = "billing"
+)
+
+// Address is an entry in a user's address book.
+type Address struct {
+	Id                string
+	UserId            string
+	Label             string
+	RecipientName     string
+	Phone             string
+	Line1             string
+	Line2             string
+	City              string
+	Region            string
+	PostalCode        string
+	CountryCode       string
+	IsDefaultShipping bool
+	IsDefaultBilling  bool
+	CreatedAt         time.Time
+	UpdatedAt         *time.Time
+}
+
+// OrderAddress menyimpan snapshot alamat saat checkout,
+// sehingga perubahan atau penghapusan alamat setelahnya tidak mengubah riwayat order.
+type OrderAddress struct {
+	OrderId       string
+	AddressType   string
+	RecipientName string
+	Phone         string
+	Line1         string
+	Line2         string
+	City          string
+	Region        string
+	PostalCode    string
+	CountryCode   string
+}
+
+// Snapshot copies the address into an OrderAddress of the given type.
+func (a *Address) Snapshot(orderID string, addressType string) *OrderAddress {
+	return &OrderAddress{
+		OrderId:       orderID,
+		AddressType:   addressType,
+		RecipientName: a.RecipientName,
+		Phone:         a.Phone,
+		Line1:         a.Line1,
+		Line2:         a.Line2,
+		City:          a.City,
+		Region:        a.Region,
+		PostalCode:    a.PostalCode,
+		CountryCode:   a.CountryCode,
+	}
+}
@@ -7,12 +7,26 @@ import (
 )
 
 type CartItem struct {
-	ID        uuid.UUID
+	ID uuid.UUID
+	// UserID is the owner of the item. For guest cart items it is the guest cart id.
 	UserID    string
 	ProductID string
+	VariantID *string
 	Quantity  int
 	CreatedAt time.Time
 	CreatedBy string
 	UpdatedAt *time.Time
 	UpdatedBy *string
 }
+
+// GuestCartActor is recorded in created_by/updated_by for changes made to a guest cart.
+const GuestCartActor = "guest"
+
+// GuestCart is the cart of a visitor who has not logged in, accessed with an opaque token.
+// Only the hash of the token is stored.
+type GuestCart struct {
+	Id        string
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type Category struct {
+	Id          string
+	ParentId    *string
+	Name        string
+	Description string
+	CreatedAt   time.Time
+	CreatedBy   string
+	UpdatedAt   *time.Time
+	UpdatedBy   *string
+	DeletedAt   *time.Time
+	DeletedBy   *string
+	IsDeleted   bool
+}
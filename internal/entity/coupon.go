@@ -0,0 +1,62 @@
+package entity
+
+import "time"
+
+const (
+	CouponDiscountPercentage  = "PERCENTAGE"
+	CouponDiscountFixedAmount = "FIXED_AMOUNT"
+)
+
+// Coupon is a promotion code. A coupon without ProductIds and CategoryIds applies to every product;
+// CategoryIds also cover their sub-categories. UsageLimit and PerUserLimit nil mean unlimited.
+type Coupon struct {
+	Id           string
+	Code         string
+	Description  string
+	DiscountType string
+	// PercentOffBasisPoints berlaku untuk PERCENTAGE, mis. 1000 untuk 10%
+	PercentOffBasisPoints int64
+	// AmountOff berlaku untuk FIXED_AMOUNT
+	AmountOff       Money
+	MinCartValue    Money
+	ValidFrom       *time.Time
+	ValidTo         *time.Time
+	UsageLimit      *int
+	PerUserLimit    *int
+	RedemptionCount int
+	IsActive        bool
+	ProductIds      []string
+	CategoryIds     []string
+	CreatedAt       time.Time
+	CreatedBy       string
+	UpdatedAt       *time.Time
+	UpdatedBy       *string
+}
+
+// IsUsageLimitReached reports whether the coupon has been redeemed as many times as its global usage limit allows.
+func (c *Coupon) IsUsageLimitReached() bool {
+	return c.UsageLimit != nil && c.RedemptionCount >= *c.UsageLimit
+}
+
+// IsPerUserLimitReached reports whether a user who redeemed the coupon userRedemptions times may not redeem it again.
+func (c *Coupon) IsPerUserLimitReached(userRedemptions int) bool {
+	return c.PerUserLimit != nil && userRedemptions >= *c.PerUserLimit
+}
+
+// CartCoupon is the coupon applied to a user's cart, with what is needed to price it: the cart products
+// it applies to and how many times the user has already redeemed it.
+type CartCoupon struct {
+	Coupon             *Coupon
+	EligibleProductIds map[string]bool
+	UserRedemptions    int
+}
+
+// CouponRedemption records one use of a coupon by an order.
+type CouponRedemption struct {
+	Id        string
+	CouponId  string
+	UserId    string
+	OrderId   string
+	Discount  Money
+	CreatedAt time.Time
+}
@@ -0,0 +1,10 @@
+package entity
+
+import "time"
+
+type ProductStock struct {
+	ProductId string
+	Quantity  int
+	UpdatedAt time.Time
+	UpdatedBy string
+}
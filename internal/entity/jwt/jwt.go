@@ -2,8 +2,7 @@ package jwt
 
 import (
 	"context"
-	"fmt"
-	"os"
+	"time"
 
 	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
 	"github.com/golang-jwt/jwt/v5"
@@ -14,7 +13,13 @@ type JWTClaims struct {
 	FullName string `json:"full_name"`
 	Email    string `json:"email"`
 	RoleCode string `json:"role_code"`
+	// AMR lists the authentication methods of the login (RFC 8176). It contains "mfa" after two-factor authentication.
+	AMR []string `json:"amr,omitempty"`
+	// AuthTime is when the user signed in. Tokens issued by a refresh leave it empty.
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"`
 }
+
+const AuthMethodMFA = "mfa"
 type JwtEntityContextKey string
 var JwtEntityContextValue JwtEntityContextKey = "JwtEntity"
 
@@ -23,14 +28,45 @@ func (jc *JWTClaims) SetToContext(ctx context.Context) context.Context{
 	return ctx
 }
 
-func GetClaimsFromToken(token string) (*JWTClaims, error) {
+// RevocationKey returns the key under which the token is revoked: its jti,
+// or the token hash for tokens issued before access tokens carried a jti.
+func (jc *JWTClaims) RevocationKey(token string) string {
+	if jc.ID != "" {
+		return jc.ID
+	}
+	return utils.HashToken(token)
+}
 
-	tokenClaims, err := jwt.ParseWithClaims(token, &JWTClaims{}, func(t *jwt.Token) (interface{}, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected token signing method %v", t.Header["alg"])
+// IssuedAtTime returns the iat claim, or the zero time when the token has none.
+func (jc *JWTClaims) IssuedAtTime() time.Time {
+	if jc.IssuedAt == nil {
+		return time.Time{}
+	}
+	return jc.IssuedAt.Time
+}
+
+// HasMFA reports whether the login that issued the token passed two-factor authentication.
+func (jc *JWTClaims) HasMFA() bool {
+	for _, method := range jc.AMR {
+		if method == AuthMethodMFA {
+			return true
 		}
-		return []byte(os.Getenv("JWT_SECRET")), nil
-	})
+	}
+	return false
+}
+
+// AuthenticatedSince reports whether the token was issued by a sign-in at or after t, not by a refresh.
+func (jc *JWTClaims) AuthenticatedSince(t time.Time) bool {
+	return jc.AuthTime != nil && !jc.AuthTime.Time.Before(t.Truncate(time.Second))
+}
+
+func GetClaimsFromToken(token string) (*JWTClaims, error) {
+
+	keySet, err := DefaultKeySet()
+	if err != nil {
+		return nil, err
+	}
+	tokenClaims, err := jwt.ParseWithClaims(token, &JWTClaims{}, keySet.keyFunc)
 	if err != nil {
 		return nil, utils.UnauthenticatedResponse()
 	}
@@ -0,0 +1,331 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// HMACKeyID is the kid of HS256 tokens signed with JWT_SECRET.
+const HMACKeyID = "hs256"
+
+// verificationKey is a public key accepted for tokens carrying its kid.
+type verificationKey struct {
+	method jwt.SigningMethod
+	key    crypto.PublicKey
+}
+
+// KeySet holds the key used to sign new access tokens and every key still accepted when verifying them.
+// Rotating a key means signing with a new kid while the previous public key stays in the verification set
+// until the tokens it signed have expired.
+type KeySet struct {
+	signingKid       string
+	signingMethod    jwt.SigningMethod
+	signingKey       crypto.PrivateKey
+	verificationKeys map[string]verificationKey
+	// hmacSecret verifies HS256 tokens with kid HMACKeyID, and signs new tokens when no asymmetric key is configured.
+	hmacSecret []byte
+	// legacyKidlessUntil is the date until which HS256 tokens without a kid, issued before tokens carried one,
+	// are still accepted. Zero means they are rejected.
+	legacyKidlessUntil time.Time
+	now                func() time.Time
+}
+
+// JSONWebKey is a public key in JWK format (RFC 7517).
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+var (
+	defaultKeySet     *KeySet
+	defaultKeySetErr  error
+	defaultKeySetOnce sync.Once
+)
+
+// DefaultKeySet returns the key set configured through the environment, loading it on first use.
+//
+//	JWT_SIGNING_KEY_FILE       PEM private key (RSA or Ed25519) used to sign new tokens
+//	JWT_SIGNING_KEY_ID         kid of the signing key
+//	JWT_VERIFICATION_KEY_FILES comma separated kid=path pairs of PEM public keys from previous rotations
+//	JWT_SECRET                 HS256 secret, used for signing only when no signing key file is set
+//	JWT_LEGACY_KIDLESS_UNTIL   YYYY-MM-DD until which HS256 tokens without a kid are accepted; remove after that date
+func DefaultKeySet() (*KeySet, error) {
+	defaultKeySetOnce.Do(func() {
+		defaultKeySet, defaultKeySetErr = LoadKeySet(
+			os.Getenv("JWT_SIGNING_KEY_FILE"),
+			os.Getenv("JWT_SIGNING_KEY_ID"),
+			os.Getenv("JWT_VERIFICATION_KEY_FILES"),
+			os.Getenv("JWT_SECRET"),
+			os.Getenv("JWT_LEGACY_KIDLESS_UNTIL"),
+		)
+	})
+	return defaultKeySet, defaultKeySetErr
+}
+
+func LoadKeySet(signingKeyFile string, signingKid string, verificationKeyFiles string, hmacSecret string, legacyKidlessUntil string) (*KeySet, error) {
+	ks := &KeySet{
+		verificationKeys: make(map[string]verificationKey),
+		now:              time.Now,
+	}
+	if hmacSecret != "" {
+		ks.hmacSecret = []byte(hmacSecret)
+	}
+	if legacyKidlessUntil != "" {
+		if ks.hmacSecret == nil {
+			return nil, fmt.Errorf("JWT_SECRET is required when JWT_LEGACY_KIDLESS_UNTIL is set")
+		}
+		until, err := time.Parse(time.DateOnly, legacyKidlessUntil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWT_LEGACY_KIDLESS_UNTIL %q, expected YYYY-MM-DD: %w", legacyKidlessUntil, err)
+		}
+		ks.legacyKidlessUntil = until
+	}
+
+	if signingKeyFile != "" {
+		if signingKid == "" {
+			return nil, fmt.Errorf("JWT_SIGNING_KEY_ID is required when JWT_SIGNING_KEY_FILE is set")
+		}
+		privateKey, err := readPrivateKey(signingKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		method, publicKey, err := signingMethodFor(privateKey)
+		if err != nil {
+			return nil, err
+		}
+		ks.signingKid = signingKid
+		ks.signingMethod = method
+		ks.signingKey = privateKey
+		ks.verificationKeys[signingKid] = verificationKey{method: method, key: publicKey}
+	} else if ks.hmacSecret == nil {
+		return nil, fmt.Errorf("either JWT_SIGNING_KEY_FILE or JWT_SECRET must be set")
+	}
+	if _, exists := ks.verificationKeys[HMACKeyID]; exists {
+		return nil, fmt.Errorf("key id %q is reserved for JWT_SECRET", HMACKeyID)
+	}
+
+	for _, entry := range strings.Split(verificationKeyFiles, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		kid, path, ok := strings.Cut(entry, "=")
+		if !ok || kid == "" || path == "" {
+			return nil, fmt.Errorf("invalid verification key entry %q, expected kid=path", entry)
+		}
+		if kid == HMACKeyID {
+			return nil, fmt.Errorf("key id %q is reserved for JWT_SECRET", HMACKeyID)
+		}
+		if _, exists := ks.verificationKeys[kid]; exists {
+			return nil, fmt.Errorf("duplicate verification key id %q", kid)
+		}
+		publicKey, err := readPublicKey(path)
+		if err != nil {
+			return nil, err
+		}
+		method, err := verificationMethodFor(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		ks.verificationKeys[kid] = verificationKey{method: method, key: publicKey}
+	}
+
+	return ks, nil
+}
+
+// Sign signs the claims with the current signing key, falling back to HS256 with JWT_SECRET.
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	if ks.signingKey == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		token.Header["kid"] = HMACKeyID
+		return token.SignedString(ks.hmacSecret)
+	}
+	token := jwt.NewWithClaims(ks.signingMethod, claims)
+	token.Header["kid"] = ks.signingKid
+	return token.SignedString(ks.signingKey)
+}
+
+// keyFunc selects the verification key by the kid header and rejects any algorithm other than the one registered for it.
+// Tokens without a kid are only accepted until JWT_LEGACY_KIDLESS_UNTIL.
+func (ks *KeySet) keyFunc(t *jwt.Token) (interface{}, error) {
+	kid, _ := t.Header["kid"].(string)
+	if kid == "" {
+		if ks.legacyKidlessUntil.IsZero() || !ks.now().Before(ks.legacyKidlessUntil) {
+			return nil, fmt.Errorf("token has no key id")
+		}
+		kid = HMACKeyID
+	}
+	if kid == HMACKeyID {
+		if t.Method.Alg() != jwt.SigningMethodHS256.Alg() || ks.hmacSecret == nil {
+			return nil, fmt.Errorf("unexpected token signing method %v for key %q", t.Header["alg"], kid)
+		}
+		return ks.hmacSecret, nil
+	}
+
+	vk, ok := ks.verificationKeys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if t.Method.Alg() != vk.method.Alg() {
+		return nil, fmt.Errorf("unexpected token signing method %v for key %q", t.Header["alg"], kid)
+	}
+	return vk.key, nil
+}
+
+// JWKS returns the public verification keys. The HMAC secret is never published.
+func (ks *KeySet) JWKS() JSONWebKeySet {
+	set := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(ks.verificationKeys))}
+	for kid, vk := range ks.verificationKeys {
+		jwk := JSONWebKey{Kid: kid, Use: "sig", Alg: vk.method.Alg()}
+		switch key := vk.key.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(key)
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].Kid < set.Keys[j].Kid })
+	return set
+}
+
+// PublicKey decodes the JWK into an RSA, ECDSA or Ed25519 public key.
+func (k JSONWebKey) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RSA modulus of key %q: %w", k.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("invalid RSA exponent of key %q", k.Kid)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q of key %q", k.Crv, k.Kid)
+		}
+		x, errX := base64.RawURLEncoding.DecodeString(k.X)
+		y, errY := base64.RawURLEncoding.DecodeString(k.Y)
+		if errX != nil || errY != nil {
+			return nil, fmt.Errorf("invalid EC coordinates of key %q", k.Kid)
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, fmt.Errorf("EC key %q is not on curve %s", k.Kid, k.Crv)
+		}
+		return key, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q of key %q", k.Crv, k.Kid)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 key %q", k.Kid)
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q of key %q", k.Kty, k.Kid)
+	}
+}
+
+func signingMethodFor(privateKey crypto.PrivateKey) (jwt.SigningMethod, crypto.PublicKey, error) {
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		return jwt.SigningMethodRS256, &key.PublicKey, nil
+	case ed25519.PrivateKey:
+		return jwt.SigningMethodEdDSA, key.Public(), nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported signing key type %T", privateKey)
+	}
+}
+
+func verificationMethodFor(publicKey crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch publicKey.(type) {
+	case *rsa.PublicKey:
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported verification key type %T", publicKey)
+	}
+}
+
+func readPEMBlock(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file %s: %w", path, err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", path)
+	}
+	return block, nil
+}
+
+func readPrivateKey(path string) (crypto.PrivateKey, error) {
+	block, err := readPEMBlock(path)
+	if err != nil {
+		return nil, err
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("failed to parse private key in %s", path)
+}
+
+func readPublicKey(path string) (crypto.PublicKey, error) {
+	block, err := readPEMBlock(path)
+	if err != nil {
+		return nil, err
+	}
+	if key, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("failed to parse public key in %s", path)
+}
@@ -0,0 +1,263 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// writeEd25519Key writes a new Ed25519 key pair as PEM files and returns their paths.
+func writeEd25519Key(t *testing.T, name string) (privatePath string, publicPath string) {
+	t.Helper()
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	privatePath = filepath.Join(dir, name+".pem")
+	publicPath = filepath.Join(dir, name+".pub.pem")
+	if err := os.WriteFile(privatePath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(publicPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return privatePath, publicPath
+}
+
+// writeRSAKey writes a new 2048-bit RSA private key as a PEM file and returns its path.
+func writeRSAKey(t *testing.T, name string) string {
+	t.Helper()
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), name+".pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func testClaims() *JWTClaims {
+	return &JWTClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+func verify(ks *KeySet, token string) error {
+	_, err := jwt.ParseWithClaims(token, &JWTClaims{}, ks.keyFunc)
+	return err
+}
+
+// kidlessHS256 signs claims the way tokens were signed before they carried a kid.
+func kidlessHS256(t *testing.T, secret string) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims()).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestKeySetRotation(t *testing.T) {
+	oldPrivate, oldPublic := writeEd25519Key(t, "old")
+	newPrivate, _ := writeEd25519Key(t, "new")
+
+	oldKeys, err := LoadKeySet(oldPrivate, "k1", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldToken, err := oldKeys.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotated, err := LoadKeySet(newPrivate, "k2", "k1="+oldPublic, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newToken, err := rotated.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(rotated, oldToken); err != nil {
+		t.Errorf("token of the previous key rejected: %v", err)
+	}
+	if err := verify(rotated, newToken); err != nil {
+		t.Errorf("token of the signing key rejected: %v", err)
+	}
+
+	retired, err := LoadKeySet(newPrivate, "k2", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(retired, oldToken); err == nil {
+		t.Error("token of a retired key accepted")
+	}
+	if kids := retired.JWKS().Keys; len(kids) != 1 || kids[0].Kid != "k2" {
+		t.Errorf("got JWKS %+v, want only k2", kids)
+	}
+}
+
+func TestKeySetRejectsAlgorithmConfusion(t *testing.T) {
+	private, _ := writeEd25519Key(t, "k")
+	ks, err := LoadKeySet(private, "k1", "", "secret", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// HS256 token claiming the kid of the Ed25519 key
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims())
+	token.Header["kid"] = "k1"
+	signed, err := token.SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(ks, signed); err == nil {
+		t.Error("HS256 token with an Ed25519 kid accepted")
+	}
+	if len(ks.JWKS().Keys) != 1 {
+		t.Errorf("HMAC secret published in JWKS: %+v", ks.JWKS().Keys)
+	}
+}
+
+func TestKeySetHMACTokensCarryKid(t *testing.T) {
+	ks, err := LoadKeySet("", "", "", "secret", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := ks.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, _, err := jwt.NewParser().ParseUnverified(signed, &JWTClaims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.Header["kid"] != HMACKeyID {
+		t.Errorf("got kid %v, want %q", token.Header["kid"], HMACKeyID)
+	}
+	if err := verify(ks, signed); err != nil {
+		t.Errorf("HS256 token rejected: %v", err)
+	}
+}
+
+func TestKeySetLegacyKidlessTokens(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		until      string
+		wantAccept bool
+	}{
+		{name: "no legacy flag", until: "", wantAccept: false},
+		{name: "before removal date", until: "2026-03-02", wantAccept: true},
+		{name: "on removal date", until: "2026-03-01", wantAccept: false},
+		{name: "after removal date", until: "2026-02-01", wantAccept: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ks, err := LoadKeySet("", "", "", "secret", tt.until)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ks.now = func() time.Time { return now }
+
+			err = verify(ks, kidlessHS256(t, "secret"))
+			if (err == nil) != tt.wantAccept {
+				t.Errorf("got error %v, want accepted %v", err, tt.wantAccept)
+			}
+		})
+	}
+}
+
+func TestLoadKeySetRejectsInvalidLegacyFlag(t *testing.T) {
+	if _, err := LoadKeySet("", "", "", "secret", "next year"); err == nil {
+		t.Error("invalid JWT_LEGACY_KIDLESS_UNTIL accepted")
+	}
+	private, _ := writeEd25519Key(t, "k")
+	if _, err := LoadKeySet(private, "k1", "", "", "2026-03-01"); err == nil {
+		t.Error("JWT_LEGACY_KIDLESS_UNTIL accepted without JWT_SECRET")
+	}
+}
+
+func TestJWKSPublicKeysVerifyTokens(t *testing.T) {
+	tests := []struct {
+		name    string
+		keyFile func(t *testing.T) string
+		wantKty string
+		wantAlg string
+	}{
+		{name: "RSA", keyFile: func(t *testing.T) string { return writeRSAKey(t, "rsa") }, wantKty: "RSA", wantAlg: "RS256"},
+		{name: "Ed25519", keyFile: func(t *testing.T) string { path, _ := writeEd25519Key(t, "ed"); return path }, wantKty: "OKP", wantAlg: "EdDSA"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ks, err := LoadKeySet(tt.keyFile(t), "k1", "", "secret", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			token, err := ks.Sign(testClaims())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			keys := ks.JWKS().Keys
+			if len(keys) != 1 || keys[0].Kid != "k1" || keys[0].Kty != tt.wantKty || keys[0].Alg != tt.wantAlg || keys[0].Use != "sig" {
+				t.Fatalf("got JWKS %+v, want one %s key k1", keys, tt.wantAlg)
+			}
+			publicKey, err := keys[0].PublicKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Resource server memverifikasi token hanya dengan key dari JWKS
+			_, err = jwt.ParseWithClaims(token, &JWTClaims{}, func(*jwt.Token) (interface{}, error) { return publicKey, nil },
+				jwt.WithValidMethods([]string{tt.wantAlg}))
+			if err != nil {
+				t.Errorf("token rejected with the published key: %v", err)
+			}
+		})
+	}
+}
+
+func TestJSONWebKeyPublicKeyRejectsInvalidKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		key  JSONWebKey
+	}{
+		{name: "unknown key type", key: JSONWebKey{Kid: "k", Kty: "oct"}},
+		{name: "unknown OKP curve", key: JSONWebKey{Kid: "k", Kty: "OKP", Crv: "X25519", X: "AAAA"}},
+		{name: "short Ed25519 key", key: JSONWebKey{Kid: "k", Kty: "OKP", Crv: "Ed25519", X: "AAAA"}},
+		{name: "unknown EC curve", key: JSONWebKey{Kid: "k", Kty: "EC", Crv: "secp256k1", X: "AAAA", Y: "AAAA"}},
+		{name: "EC point not on curve", key: JSONWebKey{Kid: "k", Kty: "EC", Crv: "P-256", X: "AQ", Y: "AQ"}},
+		{name: "empty RSA exponent", key: JSONWebKey{Kid: "k", Kty: "RSA", N: "AQAB", E: ""}},
+	}
+	for _, tt := range tests {
+		if _, err := tt.key.PublicKey(); err == nil {
+			t.Errorf("%s: got a public key, want an error", tt.name)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+)
+
+// DefaultCurrency is the ISO 4217 currency of the store. Existing prices were stored in it.
+const DefaultCurrency = "IDR"
+
+// currencyExponents lists the ISO 4217 currencies whose minor unit is not 1/100 of the major unit.
+var currencyExponents = map[string]int{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+}
+
+// CurrencyExponent returns the number of decimals of the minor unit of an ISO 4217 currency, e.g. 2 for IDR and USD.
+func CurrencyExponent(currency string) int {
+	if exponent, ok := currencyExponents[currency]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// Money is an amount in the minor unit of its currency (e.g. 1500000 IDR is 15,000.00 IDR), so sums are exact.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// NewMoney creates a Money of amount minor units.
+func NewMoney(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// MoneyFromMajor converts an amount in major units, as sent in the legacy double fields, to Money.
+// It is rounded to the nearest minor unit.
+func MoneyFromMajor(amount float64, currency string) Money {
+	scale := math.Pow10(CurrencyExponent(currency))
+	return Money{Amount: int64(math.Round(amount * scale)), Currency: currency}
+}
+
+// Major returns the amount in major units for the legacy double fields, e.g. 1234 USD cents -> 12.34.
+func (m Money) Major() float64 {
+	return float64(m.Amount) / math.Pow10(CurrencyExponent(m.Currency))
+}
+
+// Times returns the money multiplied by a quantity.
+func (m Money) Times(quantity int64) Money {
+	return Money{Amount: m.Amount * quantity, Currency: m.Currency}
+}
+
+// String formats the money in major units with its currency, e.g. "IDR 15000.00".
+func (m Money) String() string {
+	return fmt.Sprintf("%s %.*f", m.Currency, CurrencyExponent(m.Currency), m.Major())
+}
@@ -0,0 +1,62 @@
+package entity
+
+import "testing"
+
+func TestCurrencyExponent(t *testing.T) {
+	tests := map[string]int{"IDR": 2, "USD": 2, "JPY": 0, "KRW": 0, "KWD": 3, "XYZ": 2}
+	for currency, want := range tests {
+		if got := CurrencyExponent(currency); got != want {
+			t.Errorf("CurrencyExponent(%q) = %d, want %d", currency, got, want)
+		}
+	}
+}
+
+func TestMoneyFromMajor(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		currency string
+		want     int64
+	}{
+		{amount: 15000, currency: "IDR", want: 1500000},
+		{amount: 12.34, currency: "USD", want: 1234},
+		// 0.1 + 0.2 tidak tepat di float64, hasilnya tetap dibulatkan ke sen terdekat
+		{amount: 0.1 + 0.2, currency: "USD", want: 30},
+		{amount: 19.999, currency: "USD", want: 2000},
+		{amount: 1500, currency: "JPY", want: 1500},
+		{amount: 1.2345, currency: "KWD", want: 1235},
+	}
+	for _, tt := range tests {
+		got := MoneyFromMajor(tt.amount, tt.currency)
+		if got.Amount != tt.want || got.Currency != tt.currency {
+			t.Errorf("MoneyFromMajor(%v, %q) = %+v, want %d %s", tt.amount, tt.currency, got, tt.want, tt.currency)
+		}
+	}
+}
+
+func TestMoneyMajorAndString(t *testing.T) {
+	tests := []struct {
+		money     Money
+		wantMajor float64
+		want      string
+	}{
+		{money: NewMoney(1500000, "IDR"), wantMajor: 15000, want: "IDR 15000.00"},
+		{money: NewMoney(1234, "USD"), wantMajor: 12.34, want: "USD 12.34"},
+		{money: NewMoney(5, "USD"), wantMajor: 0.05, want: "USD 0.05"},
+		{money: NewMoney(1500, "JPY"), wantMajor: 1500, want: "JPY 1500"},
+		{money: NewMoney(1235, "KWD"), wantMajor: 1.235, want: "KWD 1.235"},
+	}
+	for _, tt := range tests {
+		if got := tt.money.Major(); got != tt.wantMajor {
+			t.Errorf("%+v.Major() = %v, want %v", tt.money, got, tt.wantMajor)
+		}
+		if got := tt.money.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q, want %q", tt.money, got, tt.want)
+		}
+	}
+}
+
+func TestMoneyTimes(t *testing.T) {
+	if got, want := NewMoney(1999, "USD").Times(3), NewMoney(5997, "USD"); got != want {
+		t.Errorf("Times(3) = %+v, want %+v", got, want)
+	}
+}
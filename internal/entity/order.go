@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	OrderStatusPendingPayment = "PENDING_PAYMENT"
+	OrderStatusPaid           = "PAID"
+	OrderStatusProcessing     = "PROCESSING"
+	OrderStatusShipped        = "SHIPPED"
+	OrderStatusDelivered      = "DELIVERED"
+	OrderStatusCancelled      = "CANCELLED"
+	OrderStatusRefunded       = "REFUNDED"
+)
+
+// orderStatusTransitions berisi status tujuan yang sah untuk setiap status order.
+// CANCELLED dan REFUNDED adalah status akhir.
+var orderStatusTransitions = map[string][]string{
+	OrderStatusPendingPayment: {OrderStatusPaid, OrderStatusCancelled},
+	OrderStatusPaid:           {OrderStatusProcessing, OrderStatusRefunded},
+	OrderStatusProcessing:     {OrderStatusShipped, OrderStatusRefunded},
+	OrderStatusShipped:        {OrderStatusDelivered},
+	OrderStatusDelivered:      {OrderStatusRefunded},
+}
+
+// CanTransitionOrderStatus reports whether an order may move from one status to another.
+func CanTransitionOrderStatus(from string, to string) bool {
+	for _, next := range orderStatusTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// paymentOrderStatuses hanya bisa dicapai lewat payment service: PAID butuh payment yang sudah di-capture,
+// REFUNDED butuh refund di provider.
+var paymentOrderStatuses = map[string]bool{
+	OrderStatusPaid:     true,
+	OrderStatusRefunded: true,
+}
+
+// CanAdminTransitionOrderStatus reports whether an admin may move an order from one status to another by hand.
+// PAID and REFUNDED are excluded: they are set by capturing and refunding the order's payment.
+func CanAdminTransitionOrderStatus(from string, to string) bool {
+	return !paymentOrderStatuses[to] && CanTransitionOrderStatus(from, to)
+}
+
+type Order struct {
+	Id            string
+	UserId        string
+	Status        string
+	TotalPrice    Money // grand total: Subtotal - DiscountTotal + TaxTotal + ShippingTotal
+	Subtotal      Money
+	DiscountTotal Money
+	TaxTotal      Money
+	ShippingTotal Money
+	TotalQuantity int
+	// CouponId dan CouponCode terisi jika checkout memakai kupon
+	CouponId   *string
+	CouponCode *string
+	Items      []*OrderItem
+	// ShippingAddress dan BillingAddress kosong untuk order yang dibuat sebelum ada buku alamat
+	ShippingAddress *OrderAddress
+	BillingAddress  *OrderAddress
+	CreatedAt       time.Time
+	CreatedBy       string
+	UpdatedAt       *time.Time
+	UpdatedBy       *string
+}
+
+// OrderItem menyimpan snapshot nama dan harga produk saat checkout,
+// sehingga perubahan produk setelahnya tidak mengubah riwayat order.
+type OrderItem struct {
+	Id          string
+	OrderId     string
+	ProductId   string
+	ProductName string
+	VariantId   *string
+	Sku         *string
+	VariantName *string
+	Price       Money
+	Quantity    int
+	Discount    Money
+	Tax         Money
+	Total       Money // Price*Quantity - Discount + Tax
+	CreatedAt   time.Time
+}
+
+// CartLine is a user_cart row joined with its product and variant, read inside the checkout transaction.
+// Price is already the effective price (variant override or product price).
+type CartLine struct {
+	CartID      uuid.UUID
+	ProductID   string
+	ProductName string
+	VariantID   *string
+	VariantSku  *string
+	VariantName *string
+	Price       Money
+	Quantity    int
+}
+
+// OrderStatusHistory records who moved an order between statuses, when and why.
+type OrderStatusHistory struct {
+	Id              string
+	OrderId         string
+	FromStatus      *string
+	ToStatus        string
+	Reason          string
+	ChangedByUserId string
+	ChangedBy       string
+	CreatedAt       time.Time
+}
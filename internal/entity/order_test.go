@@ -0,0 +1,54 @@
+package entity
+
+import "testing"
+
+func TestCanTransitionOrderStatus(t *testing.T) {
+	tests := []struct {
+		from string
+		to   string
+		want bool
+	}{
+		{from: OrderStatusPendingPayment, to: OrderStatusPaid, want: true},
+		{from: OrderStatusPendingPayment, to: OrderStatusCancelled, want: true},
+		{from: OrderStatusPendingPayment, to: OrderStatusShipped},
+		{from: OrderStatusPaid, to: OrderStatusProcessing, want: true},
+		{from: OrderStatusPaid, to: OrderStatusRefunded, want: true},
+		{from: OrderStatusPaid, to: OrderStatusCancelled},
+		{from: OrderStatusProcessing, to: OrderStatusShipped, want: true},
+		{from: OrderStatusShipped, to: OrderStatusDelivered, want: true},
+		{from: OrderStatusShipped, to: OrderStatusRefunded},
+		{from: OrderStatusDelivered, to: OrderStatusRefunded, want: true},
+		{from: OrderStatusDelivered, to: OrderStatusPaid},
+		{from: OrderStatusCancelled, to: OrderStatusPendingPayment},
+		{from: OrderStatusRefunded, to: OrderStatusDelivered},
+		{from: OrderStatusPaid, to: OrderStatusPaid},
+		{from: "UNKNOWN", to: OrderStatusPaid},
+	}
+	for _, tt := range tests {
+		if got := CanTransitionOrderStatus(tt.from, tt.to); got != tt.want {
+			t.Errorf("%s -> %s: got %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestCanAdminTransitionOrderStatus(t *testing.T) {
+	tests := []struct {
+		from string
+		to   string
+		want bool
+	}{
+		{from: OrderStatusPendingPayment, to: OrderStatusCancelled, want: true},
+		{from: OrderStatusPaid, to: OrderStatusProcessing, want: true},
+		{from: OrderStatusShipped, to: OrderStatusDelivered, want: true},
+		{from: OrderStatusPendingPayment, to: OrderStatusPaid},
+		{from: OrderStatusPaid, to: OrderStatusRefunded},
+		{from: OrderStatusProcessing, to: OrderStatusRefunded},
+		{from: OrderStatusDelivered, to: OrderStatusRefunded},
+		{from: OrderStatusPendingPayment, to: OrderStatusShipped},
+	}
+	for _, tt := range tests {
+		if got := CanAdminTransitionOrderStatus(tt.from, tt.to); got != tt.want {
+			t.Errorf("%s -> %s: got %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
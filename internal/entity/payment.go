@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+const (
+	PaymentStatusPending  = "PENDING"
+	PaymentStatusCaptured = "CAPTURED"
+	PaymentStatusFailed   = "FAILED"
+	PaymentStatusRefunded = "REFUNDED"
+	PaymentStatusVoided   = "VOIDED"
+)
+
+type Payment struct {
+	Id               string
+	OrderId          string
+	Provider         string
+	ProviderIntentId string
+	ProviderRefundId *string
+	Amount           Money
+	Status           string
+	CreatedAt        time.Time
+	CreatedBy        string
+	UpdatedAt        *time.Time
+	UpdatedBy        *string
+}
@@ -0,0 +1,50 @@
+package entity
+
+import "context"
+
+const (
+	PermissionProductRead    = "product:read"
+	PermissionProductWrite   = "product:write"
+	PermissionCategoryWrite  = "category:write"
+	PermissionInventoryWrite = "inventory:write"
+	PermissionOrderRead      = "order:read"
+	PermissionOrderWrite     = "order:write"
+	PermissionPaymentWrite   = "payment:write"
+	PermissionCouponManage   = "coupon:manage"
+	PermissionUserRead       = "user:read"
+	PermissionUserManage     = "user:manage"
+	PermissionRoleManage     = "role:manage"
+)
+
+type Permission struct {
+	Code        string
+	Description string
+}
+
+// PermissionSet is the set of permission codes granted to the caller's role.
+type PermissionSet map[string]struct{}
+
+func NewPermissionSet(codes []string) PermissionSet {
+	set := make(PermissionSet, len(codes))
+	for _, code := range codes {
+		set[code] = struct{}{}
+	}
+	return set
+}
+
+func (ps PermissionSet) Has(code string) bool {
+	_, ok := ps[code]
+	return ok
+}
+
+type permissionContextKey struct{}
+
+func (ps PermissionSet) SetToContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, permissionContextKey{}, ps)
+}
+
+// HasPermission reports whether the permission middleware granted code to the caller of this request.
+func HasPermission(ctx context.Context, code string) bool {
+	ps, ok := ctx.Value(permissionContextKey{}).(PermissionSet)
+	return ok && ps.Has(code)
+}
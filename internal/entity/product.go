@@ -7,7 +7,7 @@ type Product struct {
 	Id          	string
 	Name        	string
 	Description 	string
-	Price       	float64
+	Price       	Money
 	ImageFileName    string
 	CreatedAt   	time.Time
 	CreatedBy   	*string
@@ -16,4 +16,30 @@ type Product struct {
 	DeletedAt  	 	time.Time
 	DeletedBy   	*string
 	IsDeleted   	bool
+	CategoryIds 	[]string
+}
+
+// ProductFilter berisi filter opsional untuk daftar produk. Field nil/kosong berarti tidak difilter.
+type ProductFilter struct {
+	MinPrice     *float64
+	MaxPrice     *float64
+	NameContains string
+	CreatedFrom  *time.Time
+	CreatedTo    *time.Time
+	UpdatedFrom  *time.Time
+	UpdatedTo    *time.Time
+	IsDeleted    *bool
+	// CategoryId juga mencakup produk di seluruh sub-kategorinya
+	CategoryId   string
+}
+
+type FacetBucket struct {
+	Key   string
+	Label string
+	Count int32
+}
+
+type ProductFacets struct {
+	Price     []*FacetBucket
+	CreatedAt []*FacetBucket
 }
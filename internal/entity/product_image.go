@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type ProductImage struct {
+	Id            string
+	ProductId     string
+	ImageFileName string
+	SortOrder     int
+	IsPrimary     bool
+	CreatedAt     time.Time
+	CreatedBy     string
+}
@@ -0,0 +1,46 @@
+package entity
+
+import "time"
+
+// ProductOption adalah tipe opsi sebuah produk (mis. "Size") beserta nilai yang diizinkan.
+type ProductOption struct {
+	Id        string
+	ProductId string
+	Name      string
+	Position  int
+	Values    []string
+}
+
+// ProductVariant adalah SKU yang bisa dibeli. Name berisi gabungan nilai opsi (mis. "M / Red");
+// Price nil berarti varian memakai harga produk.
+type ProductVariant struct {
+	Id            string
+	ProductId     string
+	Sku           string
+	Name          string
+	Price         *Money
+	ImageFileName *string
+	Stock         int
+	Options       []*ProductVariantOption
+	CreatedAt     time.Time
+	CreatedBy     string
+	UpdatedAt     *time.Time
+	UpdatedBy     *string
+	DeletedAt     *time.Time
+	DeletedBy     *string
+	IsDeleted     bool
+}
+
+type ProductVariantOption struct {
+	OptionId string
+	Name     string
+	Value    string
+}
+
+// EffectivePrice returns the variant price override, or productPrice when the variant has none.
+func (v *ProductVariant) EffectivePrice(productPrice Money) Money {
+	if v.Price != nil {
+		return *v.Price
+	}
+	return productPrice
+}
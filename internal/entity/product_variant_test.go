@@ -0,0 +1,16 @@
+package entity
+
+import "testing"
+
+func TestEffectivePrice(t *testing.T) {
+	productPrice := NewMoney(150000, "IDR")
+
+	if got := (&ProductVariant{}).EffectivePrice(productPrice); got != productPrice {
+		t.Fatalf("EffectivePrice without override = %v, want %v", got, productPrice)
+	}
+
+	override := NewMoney(175000, "IDR")
+	if got := (&ProductVariant{Price: &override}).EffectivePrice(productPrice); got != override {
+		t.Fatalf("EffectivePrice with override = %v, want %v", got, override)
+	}
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// RateLimit tracks the recent hits on one key, e.g. failed logins of an account or guest carts created by a client IP.
+type RateLimit struct {
+	Key         string
+	HitCount    int
+	LastHitAt   time.Time
+	LockedUntil *time.Time
+	ExpiresAt   time.Time
+}
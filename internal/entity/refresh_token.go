@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// RefreshToken is one opaque refresh token. Tokens rotated from the same login share a FamilyId,
+// so replaying an already rotated token can revoke the whole family.
+type RefreshToken struct {
+	Id           string
+	UserId       string
+	FamilyId     string
+	TokenHash    string
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+	RevokedAt    *time.Time
+	ReplacedById *string
+	// MFA is true when the login that started the family passed two-factor authentication.
+	MFA bool
+}
@@ -6,12 +6,23 @@ const(
 	UserRoleCustomer = "customer"
 	UserRoleAdmin = "admin"
 )
+
+// AnonymizedUserName menggantikan nama user yang menghapus akunnya dengan permintaan anonimisasi.
+const AnonymizedUserName = "Deleted User"
+
+// AnonymizedUserEmail returns a unique, undeliverable email for an anonymized user.
+func AnonymizedUserEmail(userID string) string {
+	return "deleted-" + userID + "@deleted.invalid"
+}
+
 type User struct {
 	Id        string
 	FullName  string
 	Email     string
 	Password  string
 	RoleCode  string
+	EmailVerified bool
+	EmailVerifiedAt *time.Time
 	CreatedAt time.Time
 	CreatedBy *string
 	UpdatedAt time.Time
@@ -31,4 +42,12 @@ type UserRole struct {
 	DeletedAt time.Time
 	DeletedBy *string
 	IsDeleted bool
+	Permissions []string
+}
+
+// UserFilter narrows the admin user listing. Nil/empty fields are ignored; IsDeleted nil means active users only.
+type UserFilter struct {
+	Search    string
+	RoleCode  string
+	IsDeleted *bool
 }
@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// UserIdentity links a user to an account at an OpenID Connect provider, identified by the provider's subject.
+type UserIdentity struct {
+	Id          string
+	UserId      string
+	Provider    string
+	Subject     string
+	Email       string
+	CreatedAt   time.Time
+	LastLoginAt *time.Time
+}
+
+// OIDCLoginState holds the state, nonce and PKCE verifier of an authorization request until its callback.
+// Only the hash of the state is stored.
+type OIDCLoginState struct {
+	StateHash    string
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+}
@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+const (
+	UserTokenPurposeEmailVerification = "email_verification"
+	UserTokenPurposePasswordReset     = "password_reset"
+	// UserTokenPurposeTOTPChallenge is returned by Login when a second factor is still required. It is not sent by email.
+	UserTokenPurposeTOTPChallenge = "totp_challenge"
+)
+
+// UserToken is a single-use token sent to the user by email. Only the hash of the token is stored.
+type UserToken struct {
+	Id        string
+	UserId    string
+	Purpose   string
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+}
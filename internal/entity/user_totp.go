@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// UserTOTP is the TOTP authenticator of a user. SecretEncrypted is the base32 secret sealed with the TOTP encryption key.
+// Two-factor authentication is enabled only once ConfirmedAt is set.
+type UserTOTP struct {
+	UserId          string
+	SecretEncrypted string
+	ConfirmedAt     *time.Time
+	LastUsedStep    int64
+	CreatedAt       time.Time
+}
+
+func (t *UserTOTP) Enabled() bool {
+	return t != nil && t.ConfirmedAt != nil
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/service"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/address"
+)
+
+type addressHandler struct {
+	address.UnimplementedAddressServiceServer
+
+	addressService service.IAddressService
+}
+
+func (ah *addressHandler) CreateAddress(ctx context.Context, request *address.CreateAddressRequest) (*address.CreateAddressResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &address.CreateAddressResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.addressService.CreateAddress(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *addressHandler) ListAddresses(ctx context.Context, request *address.ListAddressesRequest) (*address.ListAddressesResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &address.ListAddressesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.addressService.ListAddresses(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *addressHandler) UpdateAddress(ctx context.Context, request *address.UpdateAddressRequest) (*address.UpdateAddressResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &address.UpdateAddressResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.addressService.UpdateAddress(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *addressHandler) DeleteAddress(ctx context.Context, request *address.DeleteAddressRequest) (*address.DeleteAddressResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &address.DeleteAddressResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.addressService.DeleteAddress(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *addressHandler) SetDefaultAddress(ctx context.Context, request *address.SetDefaultAddressRequest) (*address.SetDefaultAddressResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &address.SetDefaultAddressResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.addressService.SetDefaultAddress(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func NewAddressHandler(addressService service.IAddressService) *addressHandler {
+	return &addressHandler{
+		addressService: addressService,
+	}
+}
@@ -53,6 +53,114 @@ func (ah *authHandler) Login(ctx context.Context, request *auth.LoginRequest) (*
 	return res, nil
 }
 
+func (ah *authHandler) RefreshToken(ctx context.Context, request *auth.RefreshTokenRequest) (*auth.RefreshTokenResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+
+		return &auth.RefreshTokenResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.RefreshToken(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *authHandler) VerifyEmail(ctx context.Context, request *auth.VerifyEmailRequest) (*auth.VerifyEmailResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+
+		return &auth.VerifyEmailResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.VerifyEmail(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *authHandler) ResendVerification(ctx context.Context, request *auth.ResendVerificationRequest) (*auth.ResendVerificationResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+
+		return &auth.ResendVerificationResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.ResendVerification(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *authHandler) RequestPasswordReset(ctx context.Context, request *auth.RequestPasswordResetRequest) (*auth.RequestPasswordResetResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+
+		return &auth.RequestPasswordResetResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.RequestPasswordReset(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *authHandler) ResetPassword(ctx context.Context, request *auth.ResetPasswordRequest) (*auth.ResetPasswordResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+
+		return &auth.ResetPasswordResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.ResetPassword(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *authHandler) UnlockAccount(ctx context.Context, request *auth.UnlockAccountRequest) (*auth.UnlockAccountResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+
+		return &auth.UnlockAccountResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.UnlockAccount(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (ah *authHandler) Logout(ctx context.Context, request *auth.LogoutRequest) (*auth.LogoutResponse, error) {
 	// Implement your logout logic here (if any)
 	res, err := ah.authService.Logout(ctx, request)
@@ -86,6 +194,176 @@ func (ah *authHandler) GetProfile(ctx context.Context, request *auth.GetProfileR
 	}
 	return res, nil
 }
+func (ah *authHandler) UpdateProfile(ctx context.Context, request *auth.UpdateProfileRequest) (*auth.UpdateProfileResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &auth.UpdateProfileResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.UpdateProfile(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *authHandler) DeleteMyAccount(ctx context.Context, request *auth.DeleteMyAccountRequest) (*auth.DeleteMyAccountResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &auth.DeleteMyAccountResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.DeleteMyAccount(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *authHandler) GetOIDCAuthorizationURL(ctx context.Context, request *auth.GetOIDCAuthorizationURLRequest) (*auth.GetOIDCAuthorizationURLResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &auth.GetOIDCAuthorizationURLResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.GetOIDCAuthorizationURL(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *authHandler) OIDCLogin(ctx context.Context, request *auth.OIDCLoginRequest) (*auth.OIDCLoginResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &auth.OIDCLoginResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.OIDCLogin(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *authHandler) ListIdentities(ctx context.Context, request *auth.ListIdentitiesRequest) (*auth.ListIdentitiesResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &auth.ListIdentitiesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.ListIdentities(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *authHandler) UnlinkIdentity(ctx context.Context, request *auth.UnlinkIdentityRequest) (*auth.UnlinkIdentityResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &auth.UnlinkIdentityResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.UnlinkIdentity(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *authHandler) EnrollTOTP(ctx context.Context, request *auth.EnrollTOTPRequest) (*auth.EnrollTOTPResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &auth.EnrollTOTPResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.EnrollTOTP(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *authHandler) ConfirmTOTP(ctx context.Context, request *auth.ConfirmTOTPRequest) (*auth.ConfirmTOTPResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &auth.ConfirmTOTPResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.ConfirmTOTP(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *authHandler) DisableTOTP(ctx context.Context, request *auth.DisableTOTPRequest) (*auth.DisableTOTPResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &auth.DisableTOTPResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.DisableTOTP(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ah *authHandler) VerifyTOTP(ctx context.Context, request *auth.VerifyTOTPRequest) (*auth.VerifyTOTPResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &auth.VerifyTOTPResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ah.authService.VerifyTOTP(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func NewAuthHandler(authService service.IAuthService) *authHandler {
 	return &authHandler{
 		authService: authService,
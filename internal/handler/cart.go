@@ -17,6 +17,14 @@ type cartHandler struct {
 	cartService service.ICartService
 }
 
+func (ch *cartHandler) CreateGuestCart(ctx context.Context, request *cart.CreateGuestCartRequest) (*cart.CreateGuestCartResponse, error) {
+	res, err := ch.cartService.CreateGuestCart(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (ch *cartHandler) AddProductToCart(ctx context.Context, request *cart.AddProductToCartRequest) (*cart.AddProductToCartResponse, error) {
 	validationErrors, err := utils.CheckValidation(request)
 	if err != nil {
@@ -85,6 +93,30 @@ func (ch *cartHandler) DeleteCartItem(ctx context.Context, request *cart.DeleteC
 	return res, nil
 }
 
+func (ch *cartHandler) ApplyCoupon(ctx context.Context, request *cart.ApplyCouponRequest) (*cart.ApplyCouponResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &cart.ApplyCouponResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ch.cartService.ApplyCoupon(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ch *cartHandler) RemoveCoupon(ctx context.Context, request *cart.RemoveCouponRequest) (*cart.RemoveCouponResponse, error) {
+	res, err := ch.cartService.RemoveCoupon(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
 
 func NewCartHandler(cartService service.ICartService) *cartHandler {
 	return &cartHandler{
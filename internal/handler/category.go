@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/service"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/category"
+)
+
+type categoryHandler struct {
+	category.UnimplementedCategoryServiceServer
+
+	categoryService service.ICategoryService
+}
+
+func (ch *categoryHandler) CreateCategory(ctx context.Context, request *category.CreateCategoryRequest) (*category.CreateCategoryResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &category.CreateCategoryResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ch.categoryService.CreateCategory(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ch *categoryHandler) UpdateCategory(ctx context.Context, request *category.UpdateCategoryRequest) (*category.UpdateCategoryResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &category.UpdateCategoryResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ch.categoryService.UpdateCategory(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ch *categoryHandler) DeleteCategory(ctx context.Context, request *category.DeleteCategoryRequest) (*category.DeleteCategoryResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &category.DeleteCategoryResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ch.categoryService.DeleteCategory(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ch *categoryHandler) ListCategories(ctx context.Context, request *category.ListCategoriesRequest) (*category.ListCategoriesResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &category.ListCategoriesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ch.categoryService.ListCategories(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func NewCategoryHandler(categoryService service.ICategoryService) *categoryHandler {
+	return &categoryHandler{
+		categoryService: categoryService,
+	}
+}
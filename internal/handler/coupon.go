@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/service"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/coupon"
+)
+
+type couponHandler struct {
+	coupon.UnimplementedCouponServiceServer
+
+	couponService service.ICouponService
+}
+
+func (ch *couponHandler) CreateCoupon(ctx context.Context, request *coupon.CreateCouponRequest) (*coupon.CreateCouponResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &coupon.CreateCouponResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ch.couponService.CreateCoupon(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ch *couponHandler) ListCoupons(ctx context.Context, request *coupon.ListCouponsRequest) (*coupon.ListCouponsResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &coupon.ListCouponsResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ch.couponService.ListCoupons(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ch *couponHandler) DeactivateCoupon(ctx context.Context, request *coupon.DeactivateCouponRequest) (*coupon.DeactivateCouponResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &coupon.DeactivateCouponResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ch.couponService.DeactivateCoupon(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func NewCouponHandler(couponService service.ICouponService) *couponHandler {
+	return &couponHandler{
+		couponService: couponService,
+	}
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/service"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/inventory"
+)
+
+type inventoryHandler struct {
+	inventory.UnimplementedInventoryServiceServer
+
+	inventoryService service.IInventoryService
+}
+
+func (ih *inventoryHandler) SetStock(ctx context.Context, request *inventory.SetStockRequest) (*inventory.SetStockResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &inventory.SetStockResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ih.inventoryService.SetStock(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ih *inventoryHandler) AdjustStock(ctx context.Context, request *inventory.AdjustStockRequest) (*inventory.AdjustStockResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &inventory.AdjustStockResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ih.inventoryService.AdjustStock(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ih *inventoryHandler) GetStock(ctx context.Context, request *inventory.GetStockRequest) (*inventory.GetStockResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &inventory.GetStockResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ih.inventoryService.GetStock(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func NewInventoryHandler(inventoryService service.IInventoryService) *inventoryHandler {
+	return &inventoryHandler{
+		inventoryService: inventoryService,
+	}
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/gofiber/fiber/v2"
+)
+
+// JWKSHandler mempublikasikan public key verifikasi access token agar service lain
+// bisa memverifikasi token tanpa berbagi secret.
+func JWKSHandler(c *fiber.Ctx) error {
+	keySet, err := jwtentity.DefaultKeySet()
+	if err != nil {
+		log.Printf("Failed to load JWT key set: %v", err)
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Failed to load keys",
+		})
+	}
+
+	// Key bisa dirotasi kapan saja, jadi cache dibuat singkat
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	return c.Status(http.StatusOK).JSON(keySet.JWKS())
+}
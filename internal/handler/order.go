@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/service"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/order"
+)
+
+type orderHandler struct {
+	order.UnimplementedOrderServiceServer
+
+	orderService service.IOrderService
+}
+
+func (oh *orderHandler) Checkout(ctx context.Context, request *order.CheckoutRequest) (*order.CheckoutResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &order.CheckoutResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := oh.orderService.Checkout(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (oh *orderHandler) GetOrder(ctx context.Context, request *order.GetOrderRequest) (*order.GetOrderResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &order.GetOrderResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := oh.orderService.GetOrder(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (oh *orderHandler) ListMyOrders(ctx context.Context, request *order.ListMyOrdersRequest) (*order.ListMyOrdersResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &order.ListMyOrdersResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := oh.orderService.ListMyOrders(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (oh *orderHandler) UpdateOrderStatus(ctx context.Context, request *order.UpdateOrderStatusRequest) (*order.UpdateOrderStatusResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &order.UpdateOrderStatusResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := oh.orderService.UpdateOrderStatus(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (oh *orderHandler) GetOrderTimeline(ctx context.Context, request *order.GetOrderTimelineRequest) (*order.GetOrderTimelineResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &order.GetOrderTimelineResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := oh.orderService.GetOrderTimeline(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func NewOrderHandler(orderService service.IOrderService) *orderHandler {
+	return &orderHandler{
+		orderService: orderService,
+	}
+}
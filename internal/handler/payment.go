@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/service"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/payment"
+)
+
+type paymentHandler struct {
+	payment.UnimplementedPaymentServiceServer
+
+	paymentService service.IPaymentService
+}
+
+func (ph *paymentHandler) CreatePayment(ctx context.Context, request *payment.CreatePaymentRequest) (*payment.CreatePaymentResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &payment.CreatePaymentResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ph.paymentService.CreatePayment(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ph *paymentHandler) CapturePayment(ctx context.Context, request *payment.CapturePaymentRequest) (*payment.CapturePaymentResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &payment.CapturePaymentResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ph.paymentService.CapturePayment(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ph *paymentHandler) RefundPayment(ctx context.Context, request *payment.RefundPaymentRequest) (*payment.RefundPaymentResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &payment.RefundPaymentResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ph.paymentService.RefundPayment(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func NewPaymentHandler(paymentService service.IPaymentService) *paymentHandler {
+	return &paymentHandler{
+		paymentService: paymentService,
+	}
+}
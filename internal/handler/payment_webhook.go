@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/service"
+	"github.com/gofiber/fiber/v2"
+)
+
+const PaymentSignatureHeader = "X-Payment-Signature"
+
+type paymentWebhookHandler struct {
+	paymentService service.IPaymentService
+}
+
+// HandleWebhook menerima notifikasi dari payment provider. Signature HMAC diverifikasi
+// sebelum payload diproses, dan pengiriman ulang event yang sama tidak mengubah apa pun.
+func (h *paymentWebhookHandler) HandleWebhook(c *fiber.Ctx) error {
+	err := h.paymentService.HandleWebhook(c.Context(), c.Body(), c.Get(PaymentSignatureHeader))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidWebhookSignature) {
+			return c.Status(http.StatusUnauthorized).JSON(fiber.Map{
+				"success": false,
+				"message": "Invalid signature",
+			})
+		}
+		if errors.Is(err, service.ErrPaymentNotFound) {
+			return c.Status(http.StatusNotFound).JSON(fiber.Map{
+				"success": false,
+				"message": "Payment not found",
+			})
+		}
+		log.Printf("Failed to process payment webhook: %v", err)
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Failed to process webhook",
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "Webhook processed",
+	})
+}
+
+func NewPaymentWebhookHandler(paymentService service.IPaymentService) *paymentWebhookHandler {
+	return &paymentWebhookHandler{
+		paymentService: paymentService,
+	}
+}
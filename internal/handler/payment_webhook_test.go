@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/service"
+	"github.com/gofiber/fiber/v2"
+)
+
+// memoryPaymentRepository mirrors the conditional updates of the SQL repository for a single payment.
+type memoryPaymentRepository struct {
+	repository.IPaymentRepository
+
+	payment     entity.Payment
+	orderStatus string
+	history     []*entity.OrderStatusHistory
+}
+
+func (r *memoryPaymentRepository) GetPaymentByIntentId(ctx context.Context, provider string, intentID string) (*entity.Payment, error) {
+	if r.payment.Provider != provider || r.payment.ProviderIntentId != intentID {
+		return nil, nil
+	}
+	p := r.payment
+	return &p, nil
+}
+
+func (r *memoryPaymentRepository) MarkPaymentCaptured(ctx context.Context, payment *entity.Payment, history *entity.OrderStatusHistory, capture repository.CaptureFunc) (bool, error) {
+	if r.payment.Status != entity.PaymentStatusPending {
+		return false, nil
+	}
+	if r.orderStatus != entity.OrderStatusPendingPayment {
+		return false, repository.ErrOrderNotPendingPayment
+	}
+	if capture != nil {
+		if err := capture(); err != nil {
+			return false, err
+		}
+	}
+	r.payment.Status = entity.PaymentStatusCaptured
+	r.orderStatus = entity.OrderStatusPaid
+	r.history = append(r.history, history)
+	return true, nil
+}
+
+func (r *memoryPaymentRepository) MarkPendingPaymentRefunded(ctx context.Context, paymentID string, refundID string, updatedBy string) (bool, error) {
+	if r.payment.Id != paymentID || r.payment.Status != entity.PaymentStatusPending {
+		return false, nil
+	}
+	r.payment.Status = entity.PaymentStatusRefunded
+	r.payment.ProviderRefundId = &refundID
+	return true, nil
+}
+
+func newWebhookTestApp(orderStatus string) (*fiber.App, *memoryPaymentRepository, *service.FakePaymentProvider) {
+	repo := &memoryPaymentRepository{
+		payment: entity.Payment{
+			Id:               "pay-1",
+			OrderId:          "order-1",
+			Provider:         service.FakePaymentProviderName,
+			ProviderIntentId: "fake_pi_pay-1",
+			Status:           entity.PaymentStatusPending,
+		},
+		orderStatus: orderStatus,
+	}
+	provider := service.NewFakePaymentProvider("test-secret")
+	app := fiber.New()
+	app.Post("/payment/webhook", NewPaymentWebhookHandler(service.NewPaymentService(repo, nil, provider)).HandleWebhook)
+	return app, repo, provider
+}
+
+func postWebhook(t *testing.T, app *fiber.App, payload []byte, signature string) int {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/payment/webhook", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(PaymentSignatureHeader, signature)
+	res, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("webhook request failed: %v", err)
+	}
+	return res.StatusCode
+}
+
+var succeededPayload = []byte(`{"id":"evt-1","type":"payment.succeeded","intent_id":"fake_pi_pay-1"}`)
+
+func TestHandleWebhookRejectsBadSignature(t *testing.T) {
+	app, repo, _ := newWebhookTestApp(entity.OrderStatusPendingPayment)
+
+	for _, signature := range []string{"", "deadbeef", service.NewFakePaymentProvider("other-secret").SignWebhookPayload(succeededPayload)} {
+		if code := postWebhook(t, app, succeededPayload, signature); code != http.StatusUnauthorized {
+			t.Errorf("signature %q: got status %d, want %d", signature, code, http.StatusUnauthorized)
+		}
+	}
+	if repo.payment.Status != entity.PaymentStatusPending || repo.orderStatus != entity.OrderStatusPendingPayment {
+		t.Errorf("payment %s / order %s changed by unsigned webhook", repo.payment.Status, repo.orderStatus)
+	}
+}
+
+func TestHandleWebhookReplayIsNoOp(t *testing.T) {
+	app, repo, provider := newWebhookTestApp(entity.OrderStatusPendingPayment)
+	signature := provider.SignWebhookPayload(succeededPayload)
+
+	for i := 0; i < 3; i++ {
+		if code := postWebhook(t, app, succeededPayload, signature); code != http.StatusOK {
+			t.Fatalf("delivery %d: got status %d, want %d", i+1, code, http.StatusOK)
+		}
+	}
+	if repo.payment.Status != entity.PaymentStatusCaptured || repo.orderStatus != entity.OrderStatusPaid {
+		t.Errorf("got payment %s / order %s, want %s / %s", repo.payment.Status, repo.orderStatus, entity.PaymentStatusCaptured, entity.OrderStatusPaid)
+	}
+	if len(repo.history) != 1 {
+		t.Errorf("got %d status history entries, want 1", len(repo.history))
+	}
+}
+
+func TestHandleWebhookOrderNotPendingPayment(t *testing.T) {
+	app, repo, provider := newWebhookTestApp(entity.OrderStatusCancelled)
+	signature := provider.SignWebhookPayload(succeededPayload)
+
+	// Provider berhenti mengirim ulang setelah 200, dan pengiriman ulang tidak me-refund dua kali
+	for i := 0; i < 2; i++ {
+		if code := postWebhook(t, app, succeededPayload, signature); code != http.StatusOK {
+			t.Fatalf("delivery %d: got status %d, want %d", i+1, code, http.StatusOK)
+		}
+	}
+	if repo.payment.Status != entity.PaymentStatusRefunded || repo.payment.ProviderRefundId == nil || repo.orderStatus != entity.OrderStatusCancelled {
+		t.Errorf("got payment %s (refund %v) / order %s, want a refunded payment and the order unchanged", repo.payment.Status, repo.payment.ProviderRefundId, repo.orderStatus)
+	}
+	if len(repo.history) != 0 {
+		t.Errorf("got %d status history entries, want none", len(repo.history))
+	}
+}
+
+func TestHandleWebhookUnknownIntent(t *testing.T) {
+	app, _, provider := newWebhookTestApp(entity.OrderStatusPendingPayment)
+	payload := []byte(`{"id":"evt-2","type":"payment.succeeded","intent_id":"fake_pi_other"}`)
+
+	if code := postWebhook(t, app, payload, provider.SignWebhookPayload(payload)); code != http.StatusNotFound {
+		t.Errorf("got status %d, want %d", code, http.StatusNotFound)
+	}
+}
@@ -126,6 +126,176 @@ func (ph *productHandler) HighlightProducts(ctx context.Context, request *produc
 	return res, nil
 }
 
+func (ph *productHandler) SearchProducts(ctx context.Context, request *product.SearchProductsRequest) (*product.SearchProductsResponse, error){
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &product.SearchProductsResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ph.productService.SearchProducts(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ph *productHandler) ListProductsByCategory(ctx context.Context, request *product.ListProductsByCategoryRequest) (*product.ListProductsByCategoryResponse, error){
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &product.ListProductsByCategoryResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ph.productService.ListProductsByCategory(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ph *productHandler) SetProductOptions(ctx context.Context, request *product.SetProductOptionsRequest) (*product.SetProductOptionsResponse, error){
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &product.SetProductOptionsResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ph.productService.SetProductOptions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ph *productHandler) CreateProductVariant(ctx context.Context, request *product.CreateProductVariantRequest) (*product.CreateProductVariantResponse, error){
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &product.CreateProductVariantResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ph.productService.CreateProductVariant(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ph *productHandler) UpdateProductVariant(ctx context.Context, request *product.UpdateProductVariantRequest) (*product.UpdateProductVariantResponse, error){
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &product.UpdateProductVariantResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ph.productService.UpdateProductVariant(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ph *productHandler) DeleteProductVariant(ctx context.Context, request *product.DeleteProductVariantRequest) (*product.DeleteProductVariantResponse, error){
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &product.DeleteProductVariantResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ph.productService.DeleteProductVariant(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ph *productHandler) AttachProductImage(ctx context.Context, request *product.AttachProductImageRequest) (*product.AttachProductImageResponse, error){
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &product.AttachProductImageResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ph.productService.AttachProductImage(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ph *productHandler) ReorderProductImages(ctx context.Context, request *product.ReorderProductImagesRequest) (*product.ReorderProductImagesResponse, error){
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &product.ReorderProductImagesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ph.productService.ReorderProductImages(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ph *productHandler) SetPrimaryProductImage(ctx context.Context, request *product.SetPrimaryProductImageRequest) (*product.SetPrimaryProductImageResponse, error){
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &product.SetPrimaryProductImageResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ph.productService.SetPrimaryProductImage(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (ph *productHandler) DetachProductImage(ctx context.Context, request *product.DetachProductImageRequest) (*product.DetachProductImageResponse, error){
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &product.DetachProductImageResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := ph.productService.DetachProductImage(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func NewProductHandler(productService service.IProductService) *productHandler {
 	return &productHandler{
 		productService: productService,
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/service"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/role"
+)
+
+type roleHandler struct {
+	role.UnimplementedRoleServiceServer
+
+	roleService service.IRoleService
+}
+
+func (rh *roleHandler) ListPermissions(ctx context.Context, request *role.ListPermissionsRequest) (*role.ListPermissionsResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &role.ListPermissionsResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := rh.roleService.ListPermissions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (rh *roleHandler) ListRoles(ctx context.Context, request *role.ListRolesRequest) (*role.ListRolesResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &role.ListRolesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := rh.roleService.ListRoles(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (rh *roleHandler) CreateRole(ctx context.Context, request *role.CreateRoleRequest) (*role.CreateRoleResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &role.CreateRoleResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := rh.roleService.CreateRole(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (rh *roleHandler) UpdateRole(ctx context.Context, request *role.UpdateRoleRequest) (*role.UpdateRoleResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &role.UpdateRoleResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := rh.roleService.UpdateRole(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (rh *roleHandler) DeleteRole(ctx context.Context, request *role.DeleteRoleRequest) (*role.DeleteRoleResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &role.DeleteRoleResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := rh.roleService.DeleteRole(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (rh *roleHandler) AssignUserRole(ctx context.Context, request *role.AssignUserRoleRequest) (*role.AssignUserRoleResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &role.AssignUserRoleResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := rh.roleService.AssignUserRole(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func NewRoleHandler(roleService service.IRoleService) *roleHandler {
+	return &roleHandler{
+		roleService: roleService,
+	}
+}
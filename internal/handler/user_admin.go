@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/service"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/user"
+)
+
+type userAdminHandler struct {
+	user.UnimplementedUserAdminServiceServer
+
+	userAdminService service.IUserAdminService
+}
+
+func (uh *userAdminHandler) ListUsers(ctx context.Context, request *user.ListUsersRequest) (*user.ListUsersResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &user.ListUsersResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := uh.userAdminService.ListUsers(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (uh *userAdminHandler) GetUser(ctx context.Context, request *user.GetUserRequest) (*user.GetUserResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &user.GetUserResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := uh.userAdminService.GetUser(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (uh *userAdminHandler) ChangeUserRole(ctx context.Context, request *user.ChangeUserRoleRequest) (*user.ChangeUserRoleResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &user.ChangeUserRoleResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := uh.userAdminService.ChangeUserRole(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (uh *userAdminHandler) DeleteUser(ctx context.Context, request *user.DeleteUserRequest) (*user.DeleteUserResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &user.DeleteUserResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := uh.userAdminService.DeleteUser(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (uh *userAdminHandler) RestoreUser(ctx context.Context, request *user.RestoreUserRequest) (*user.RestoreUserResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &user.RestoreUserResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := uh.userAdminService.RestoreUser(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (uh *userAdminHandler) ForceLogoutUser(ctx context.Context, request *user.ForceLogoutUserRequest) (*user.ForceLogoutUserResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(validationErrors) > 0 {
+		return &user.ForceLogoutUserResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+	res, err := uh.userAdminService.ForceLogoutUser(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func NewUserAdminHandler(userAdminService service.IUserAdminService) *userAdminHandler {
+	return &userAdminHandler{
+		userAdminService: userAdminService,
+	}
+}
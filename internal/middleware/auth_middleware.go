@@ -5,15 +5,17 @@ import (
 	"log"
 
 	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/service"
 	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
-	gocache "github.com/patrickmn/go-cache"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 
 type authMiddleware struct{
-	cacheService *gocache.Cache
+	revocationStore service.ITokenRevocationStore
 	whitelist    map[string]struct{} // The set of whitelisted endpoints
+	optional     map[string]struct{} // Endpoints that also work without a token (e.g. guest cart)
 }
 func (am *authMiddleware) Middleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler)(res any, err error) {
 	
@@ -23,25 +25,31 @@ func (am *authMiddleware) Middleware(ctx context.Context, req any, info *grpc.Un
         // If it is, skip all auth checks and proceed directly to the handler.
         return handler(ctx, req)
     }
+	// Tanpa header authorization, endpoint opsional dijalankan tanpa claims; token yang dikirim tetap harus valid
+	if _, ok := am.optional[info.FullMethod]; ok && !hasAuthorization(ctx) {
+		return handler(ctx, req)
+	}
 	// Ambil token dari meta data
 	tokenStr, err :=jwtentity.ParseTokenFromContex(ctx)
 	if err != nil {
 		return nil, utils.UnauthenticatedResponse()
 	}
 
-	// Cek blacklist/logout token dari cache
-	 _, ok := am.cacheService.Get(tokenStr)
-	 if ok{
-			// Kalau ketemu di cache berarti token sudah di logout
-			return nil, utils.UnauthenticatedResponse()
-		}
-
 	//parse token menjadi entity.jwt
 	claims, err := jwtentity.GetClaimsFromToken(tokenStr)
 	if err != nil {
 		return nil, utils.UnauthenticatedResponse()
 	}
 
+	// Cek apakah token sudah di logout
+	revoked, err := am.revocationStore.IsRevoked(ctx, claims.RevocationKey(tokenStr), claims.Subject, claims.IssuedAtTime())
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
 	//sematkan entity.jwt ke contex
 	ctx = claims.SetToContext(ctx)
 
@@ -51,15 +59,25 @@ func (am *authMiddleware) Middleware(ctx context.Context, req any, info *grpc.Un
 	return res, err
 }
 
-func NewAuthMiddleware(cacheService *gocache.Cache,  publicEndpoints []string)*authMiddleware{
+func hasAuthorization(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get("authorization")) > 0
+}
+
+func NewAuthMiddleware(revocationStore service.ITokenRevocationStore,  publicEndpoints []string, optionalAuthEndpoints []string)*authMiddleware{
 	// Create the whitelist map for efficient lookups
     whitelist := make(map[string]struct{})
     for _, endpoint := range publicEndpoints {
         whitelist[endpoint] = struct{}{}
     }
+	optional := make(map[string]struct{})
+	for _, endpoint := range optionalAuthEndpoints {
+		optional[endpoint] = struct{}{}
+	}
 
 	return &authMiddleware {
-		cacheService: cacheService,
+		revocationStore: revocationStore,
 		whitelist:    whitelist,
+		optional:     optional,
 	}
 }
\ No newline at end of file
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/service"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type permissionMiddleware struct {
+	permissionResolver service.IPermissionResolver
+	methodPermissions  map[string]string // full method name -> required permission
+	mfaRequiredRoles   map[string]bool   // roles that may only use protected methods after two-factor authentication
+}
+
+// Middleware harus dipasang setelah authMiddleware karena membaca claims dari context.
+func (pm *permissionMiddleware) Middleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res any, err error) {
+	required, protected := pm.methodPermissions[info.FullMethod]
+
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		// Endpoint publik tidak punya claims; method yang butuh permission tidak boleh dipanggil tanpa login
+		if protected {
+			return nil, utils.UnauthenticatedResponse()
+		}
+		return handler(ctx, req)
+	}
+
+	permissions, err := pm.permissionResolver.RolePermissions(ctx, claims.RoleCode)
+	if err != nil {
+		return nil, err
+	}
+	if protected && !permissions.Has(required) {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	// Tanpa 2FA user tetap bisa login dan mendaftarkan TOTP, tapi belum boleh memakai method yang butuh permission
+	if protected && pm.mfaRequiredRoles[claims.RoleCode] && !claims.HasMFA() {
+		return nil, status.Errorf(codes.PermissionDenied, "Two-factor authentication is required for this action")
+	}
+
+	// Permission juga disimpan di context untuk pengecekan di service (mis. pemilik atau punya order:read)
+	return handler(permissions.SetToContext(ctx), req)
+}
+
+func NewPermissionMiddleware(permissionResolver service.IPermissionResolver, methodPermissions map[string]string, mfaRequiredRoles []string) *permissionMiddleware {
+	required := make(map[string]bool, len(mfaRequiredRoles))
+	for _, role := range mfaRequiredRoles {
+		required[role] = true
+	}
+	return &permissionMiddleware{
+		permissionResolver: permissionResolver,
+		methodPermissions:  methodPermissions,
+		mfaRequiredRoles:   required,
+	}
+}
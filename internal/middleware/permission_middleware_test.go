@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type staticPermissionResolver map[string][]string
+
+func (r staticPermissionResolver) RolePermissions(ctx context.Context, roleCode string) (entity.PermissionSet, error) {
+	return entity.NewPermissionSet(r[roleCode]), nil
+}
+
+func TestPermissionMiddleware(t *testing.T) {
+	resolver := staticPermissionResolver{
+		"support": {entity.PermissionUserRead, entity.PermissionUserManage},
+		"admin":   {entity.PermissionUserManage, entity.PermissionRoleManage},
+	}
+	methods := map[string]string{
+		"/user.UserAdminService/ChangeUserRole": entity.PermissionRoleManage,
+		"/user.UserAdminService/DeleteUser":     entity.PermissionUserManage,
+	}
+	pm := NewPermissionMiddleware(resolver, methods, []string{"admin"})
+	tests := []struct {
+		name     string
+		method   string
+		roleCode string
+		amr      []string
+		wantCode codes.Code
+	}{
+		{name: "user manage cannot change roles", method: "/user.UserAdminService/ChangeUserRole", roleCode: "support", wantCode: codes.PermissionDenied},
+		{name: "user manage can delete users", method: "/user.UserAdminService/DeleteUser", roleCode: "support", wantCode: codes.OK},
+		{name: "role manage without mfa", method: "/user.UserAdminService/ChangeUserRole", roleCode: "admin", wantCode: codes.PermissionDenied},
+		{name: "role manage with mfa", method: "/user.UserAdminService/ChangeUserRole", roleCode: "admin", amr: []string{jwtentity.AuthMethodMFA}, wantCode: codes.OK},
+		{name: "unprotected method", method: "/cart.CartService/ListCart", roleCode: "customer", wantCode: codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := &jwtentity.JWTClaims{RoleCode: tt.roleCode, AMR: tt.amr}
+			called := false
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				return nil, nil
+			}
+			_, err := pm.Middleware(claims.SetToContext(context.Background()), nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("got code %v (%v), want %v", got, err, tt.wantCode)
+			}
+			if called != (tt.wantCode == codes.OK) {
+				t.Errorf("handler called = %v", called)
+			}
+		})
+	}
+}
+
+func TestPermissionMiddlewareRequiresLoginForProtectedMethods(t *testing.T) {
+	pm := NewPermissionMiddleware(staticPermissionResolver{}, map[string]string{"/role.RoleService/AssignUserRole": entity.PermissionRoleManage}, nil)
+	handler := func(ctx context.Context, req any) (any, error) { return nil, nil }
+
+	_, err := pm.Middleware(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/role.RoleService/AssignUserRole"}, handler)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("got %v, want Unauthenticated", err)
+	}
+}
@@ -0,0 +1,258 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/lib/pq"
+)
+
+type IAddressRepository interface {
+	// ListAddresses retrieves the user's addresses, defaults first.
+	ListAddresses(ctx context.Context, userID string) ([]*entity.Address, error)
+	// GetAddress retrieves an address owned by the user, or nil.
+	GetAddress(ctx context.Context, userID string, addressID string) (*entity.Address, error)
+	// GetDefaultAddress retrieves the user's default address of the given type, or nil.
+	GetDefaultAddress(ctx context.Context, userID string, addressType string) (*entity.Address, error)
+	// InsertAddress inserts a new address. When it is flagged as a default, the previous default is cleared first.
+	// The user's first address becomes the default for both types; the flags of address are updated to what was stored.
+	InsertAddress(ctx context.Context, address *entity.Address) error
+	// UpdateAddress updates the fields of an address owned by the user. It returns false when the address does not exist.
+	UpdateAddress(ctx context.Context, address *entity.Address) (bool, error)
+	// DeleteAddress soft-deletes an address owned by the user. It returns false when the address does not exist.
+	DeleteAddress(ctx context.Context, userID string, addressID string, deletedAt time.Time) (bool, error)
+	// SetDefaultAddress makes the address the user's default of the given type. It returns false when the address does not exist.
+	SetDefaultAddress(ctx context.Context, userID string, addressID string, addressType string, updatedAt time.Time) (bool, error)
+}
+
+type addressRepository struct {
+	db *sql.DB
+}
+
+// NewAddressRepository creates a new instance of IAddressRepository.
+func NewAddressRepository(db *sql.DB) IAddressRepository {
+	return &addressRepository{db: db}
+}
+
+const addressColumns = `id, user_id, label, recipient_name, phone, line1, line2, city, region, postal_code, country_code,
+	is_default_shipping, is_default_billing, created_at, updated_at`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAddress(row rowScanner) (*entity.Address, error) {
+	var a entity.Address
+	err := row.Scan(&a.Id, &a.UserId, &a.Label, &a.RecipientName, &a.Phone, &a.Line1, &a.Line2, &a.City, &a.Region, &a.PostalCode, &a.CountryCode,
+		&a.IsDefaultShipping, &a.IsDefaultBilling, &a.CreatedAt, &a.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (r *addressRepository) ListAddresses(ctx context.Context, userID string) ([]*entity.Address, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+addressColumns+` FROM user_address
+		WHERE user_id = $1 AND is_deleted = FALSE
+		ORDER BY is_default_shipping DESC, is_default_billing DESC, created_at`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch addresses: %w", err)
+	}
+	defer rows.Close()
+
+	var addresses []*entity.Address
+	for rows.Next() {
+		a, err := scanAddress(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan address: %w", err)
+		}
+		addresses = append(addresses, a)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+func (r *addressRepository) GetAddress(ctx context.Context, userID string, addressID string) (*entity.Address, error) {
+	// Syarat user_id membuat alamat milik user lain terlihat sama seperti alamat yang tidak ada
+	a, err := scanAddress(r.db.QueryRowContext(ctx, `SELECT `+addressColumns+` FROM user_address
+		WHERE id = $1 AND user_id = $2 AND is_deleted = FALSE`, addressID, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return a, nil
+}
+
+func (r *addressRepository) GetDefaultAddress(ctx context.Context, userID string, addressType string) (*entity.Address, error) {
+	column, err := defaultAddressColumn(addressType)
+	if err != nil {
+		return nil, err
+	}
+	a, err := scanAddress(r.db.QueryRowContext(ctx, `SELECT `+addressColumns+` FROM user_address
+		WHERE user_id = $1 AND `+column+` AND is_deleted = FALSE`, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return a, nil
+}
+
+// uniqueViolation is the PostgreSQL error code of a unique constraint violation.
+const uniqueViolation pq.ErrorCode = "23505"
+
+// insertAddressAttempts bounds the retries of InsertAddress when a concurrent insert claimed a default first.
+const insertAddressAttempts = 3
+
+func (r *addressRepository) InsertAddress(ctx context.Context, a *entity.Address) error {
+	for attempt := 1; ; attempt++ {
+		err := r.insertAddress(ctx, a)
+		// Transaksi lain yang sedang berjalan bisa lebih dulu menjadi default; setelah commit-nya terlihat, percobaan ulang berhasil
+		if err == nil || attempt == insertAddressAttempts || !isDefaultAddressConflict(err) {
+			return err
+		}
+	}
+}
+
+func (r *addressRepository) insertAddress(ctx context.Context, a *entity.Address) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin address transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if a.IsDefaultShipping {
+		if err = clearDefaultAddress(ctx, tx, a.UserId, entity.AddressTypeShipping, a.CreatedAt); err != nil {
+			return err
+		}
+	}
+	if a.IsDefaultBilling {
+		if err = clearDefaultAddress(ctx, tx, a.UserId, entity.AddressTypeBilling, a.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	// Alamat pertama user menjadi default untuk kedua tipe, diputuskan di statement yang sama dengan insert
+	err = tx.QueryRowContext(ctx, `INSERT INTO user_address (id, user_id, label, recipient_name, phone, line1, line2, city, region, postal_code, country_code,
+		is_default_shipping, is_default_billing, created_at)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12 OR first.is_first, $13 OR first.is_first, $14
+		FROM (SELECT NOT EXISTS (SELECT 1 FROM user_address WHERE user_id = $2 AND is_deleted = FALSE) AS is_first) first
+		RETURNING is_default_shipping, is_default_billing`,
+		a.Id, a.UserId, a.Label, a.RecipientName, a.Phone, a.Line1, a.Line2, a.City, a.Region, a.PostalCode, a.CountryCode,
+		a.IsDefaultShipping, a.IsDefaultBilling, a.CreatedAt).
+		Scan(&a.IsDefaultShipping, &a.IsDefaultBilling)
+	if err != nil {
+		return fmt.Errorf("failed to insert address: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// isDefaultAddressConflict reports whether err is a violation of the one-default-per-user indexes.
+func isDefaultAddressConflict(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) || pqErr.Code != uniqueViolation {
+		return false
+	}
+	return pqErr.Constraint == "uq_user_address_default_shipping" || pqErr.Constraint == "uq_user_address_default_billing"
+}
+
+func (r *addressRepository) UpdateAddress(ctx context.Context, a *entity.Address) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `UPDATE user_address SET label = $1, recipient_name = $2, phone = $3, line1 = $4, line2 = $5,
+		city = $6, region = $7, postal_code = $8, country_code = $9, updated_at = $10
+		WHERE id = $11 AND user_id = $12 AND is_deleted = FALSE`,
+		a.Label, a.RecipientName, a.Phone, a.Line1, a.Line2, a.City, a.Region, a.PostalCode, a.CountryCode, a.UpdatedAt, a.Id, a.UserId)
+	if err != nil {
+		return false, fmt.Errorf("failed to update address: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *addressRepository) DeleteAddress(ctx context.Context, userID string, addressID string, deletedAt time.Time) (bool, error) {
+	// Alamat yang dihapus juga dilepas dari status default
+	result, err := r.db.ExecContext(ctx, `UPDATE user_address SET is_deleted = TRUE, deleted_at = $1, is_default_shipping = FALSE, is_default_billing = FALSE
+		WHERE id = $2 AND user_id = $3 AND is_deleted = FALSE`,
+		deletedAt, addressID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete address: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *addressRepository) SetDefaultAddress(ctx context.Context, userID string, addressID string, addressType string, updatedAt time.Time) (bool, error) {
+	column, err := defaultAddressColumn(addressType)
+	if err != nil {
+		return false, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin address transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err = clearDefaultAddress(ctx, tx, userID, addressType, updatedAt); err != nil {
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctx, `UPDATE user_address SET `+column+` = TRUE, updated_at = $1
+		WHERE id = $2 AND user_id = $3 AND is_deleted = FALSE`,
+		updatedAt, addressID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to set default address: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit default address: %w", err)
+	}
+	return true, nil
+}
+
+// defaultAddressColumn maps an address type to its default flag column. The result is safe to put into SQL.
+func defaultAddressColumn(addressType string) (string, error) {
+	switch addressType {
+	case entity.AddressTypeShipping:
+		return "is_default_shipping", nil
+	case entity.AddressTypeBilling:
+		return "is_default_billing", nil
+	}
+	return "", fmt.Errorf("unknown address type %q", addressType)
+}
+
+func clearDefaultAddress(ctx context.Context, tx *sql.Tx, userID string, addressType string, updatedAt time.Time) error {
+	column, err := defaultAddressColumn(addressType)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `UPDATE user_address SET `+column+` = FALSE, updated_at = $1
+		WHERE user_id = $2 AND `+column+` AND is_deleted = FALSE`,
+		updatedAt, userID)
+	if err != nil {
+		return fmt.Errorf("failed to clear default address: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+func TestIsDefaultAddressConflict(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "default shipping index", err: &pq.Error{Code: uniqueViolation, Constraint: "uq_user_address_default_shipping"}, want: true},
+		{name: "wrapped default billing index", err: fmt.Errorf("failed to insert address: %w", &pq.Error{Code: uniqueViolation, Constraint: "uq_user_address_default_billing"}), want: true},
+		{name: "other unique index", err: &pq.Error{Code: uniqueViolation, Constraint: "user_address_pkey"}},
+		{name: "other error code", err: &pq.Error{Code: "23503", Constraint: "uq_user_address_default_shipping"}},
+		{name: "not a database error", err: fmt.Errorf("connection reset")},
+	}
+	for _, tt := range tests {
+		if got := isDefaultAddressConflict(tt.err); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestInsertAddressConcurrentFirstAddresses(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewAddressRepository(db)
+	userID := "test-" + uuid.NewString()
+	t.Cleanup(func() { db.Exec(`DELETE FROM user_address WHERE user_id = $1`, userID) })
+
+	const creates = 8
+	errs := make([]error, creates)
+	var wg sync.WaitGroup
+	for i := 0; i < creates; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = repo.InsertAddress(context.Background(), &entity.Address{
+				Id:            uuid.NewString(),
+				UserId:        userID,
+				RecipientName: "Test",
+				Phone:         "0800000000",
+				Line1:         fmt.Sprintf("Street %d", i),
+				City:          "Jakarta",
+				PostalCode:    "10110",
+				CountryCode:   "ID",
+				CreatedAt:     time.Now(),
+			})
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("create %d failed: %v", i, err)
+		}
+	}
+
+	addresses, err := repo.ListAddresses(context.Background(), userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var shipping, billing int
+	for _, a := range addresses {
+		if a.IsDefaultShipping {
+			shipping++
+		}
+		if a.IsDefaultBilling {
+			billing++
+		}
+	}
+	if len(addresses) != creates || shipping != 1 || billing != 1 {
+		t.Errorf("got %d addresses with %d default shipping and %d default billing, want %d with one of each", len(addresses), shipping, billing, creates)
+	}
+}
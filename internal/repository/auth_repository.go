@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
 	"time"
 
@@ -11,8 +12,15 @@ import (
 
 type IAuthRepository interface {
 	GetUserByEmail(ctx context.Context, email string) (*entity.User, error)
+	GetUserById(ctx context.Context, id string) (*entity.User, error)
 	InsertUser(ctx context.Context, user *entity.User) error
 	UpdateUserPassword(ctx context.Context, userID string, newHashedPassword string, updateBy string) error
+	MarkEmailVerified(ctx context.Context, userID string, verifiedAt time.Time) error
+	// UpdateUserProfile saves the full name, email and email verification state of a user.
+	UpdateUserProfile(ctx context.Context, user *entity.User) error
+	// DeleteUserAccount soft-deletes a user and their addresses, clears their cart and unlinks their provider identities.
+	// With anonymize the name, email and the personal parts of stored addresses are replaced too.
+	DeleteUserAccount(ctx context.Context, userID string, deletedAt time.Time, deletedBy string, anonymize bool) error
 }
 
 type authRepository struct {
@@ -21,9 +29,21 @@ type authRepository struct {
 
 func (r *authRepository) GetUserByEmail(ctx context.Context, email string) (*entity.User, error) {
 	// Implement your logic to get user by email from the database
-	row := r.db.QueryRowContext(ctx, "SELECT id, email, full_name, role_code, password, created_at FROM \"user\" WHERE email = $1 AND is_deleted = FALSE", email)
+	row := r.db.QueryRowContext(ctx, "SELECT id, email, full_name, role_code, password, email_verified, email_verified_at, created_at FROM \"user\" WHERE email = $1 AND is_deleted = FALSE", email)
 	var user entity.User
-	if err := row.Scan(&user.Id, &user.Email, &user.FullName, &user.RoleCode, &user.Password, &user.CreatedAt); err != nil {
+	if err := row.Scan(&user.Id, &user.Email, &user.FullName, &user.RoleCode, &user.Password, &user.EmailVerified, &user.EmailVerifiedAt, &user.CreatedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *authRepository) GetUserById(ctx context.Context, id string) (*entity.User, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT id, email, full_name, role_code, password, email_verified, email_verified_at, created_at FROM \"user\" WHERE id = $1 AND is_deleted = FALSE", id)
+	var user entity.User
+	if err := row.Scan(&user.Id, &user.Email, &user.FullName, &user.RoleCode, &user.Password, &user.EmailVerified, &user.EmailVerifiedAt, &user.CreatedAt); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -34,8 +54,8 @@ func (r *authRepository) GetUserByEmail(ctx context.Context, email string) (*ent
 
 func (r *authRepository) InsertUser(ctx context.Context, user *entity.User) error {
 	// Implement your logic to save user to the database
-	_, err := r.db.ExecContext(ctx, "INSERT INTO \"user\" (id, email, full_name, password, role_code, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
-		user.Id, user.Email, user.FullName, user.Password, user.RoleCode, user.CreatedAt, user.CreatedBy, user.UpdatedAt, user.UpdatedBy, user.DeletedAt, user.DeletedBy, user.IsDeleted)
+	_, err := r.db.ExecContext(ctx, "INSERT INTO \"user\" (id, email, full_name, password, role_code, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted, email_verified) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+		user.Id, user.Email, user.FullName, user.Password, user.RoleCode, user.CreatedAt, user.CreatedBy, user.UpdatedAt, user.UpdatedBy, user.DeletedAt, user.DeletedBy, user.IsDeleted, user.EmailVerified)
 	if err != nil {
 		return err
 	}
@@ -69,6 +89,77 @@ func (r *authRepository) UpdateUserPassword(ctx context.Context, userID string,
 	return nil
 }
 
+func (r *authRepository) MarkEmailVerified(ctx context.Context, userID string, verifiedAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE \"user\" SET email_verified = TRUE, email_verified_at = $1 WHERE id = $2 AND email_verified = FALSE", verifiedAt, userID)
+	return err
+}
+
+func (r *authRepository) UpdateUserProfile(ctx context.Context, user *entity.User) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE \"user\" SET full_name = $1, email = $2, email_verified = $3, email_verified_at = $4, updated_at = $5, updated_by = $6 WHERE id = $7 AND is_deleted = FALSE",
+		user.FullName, user.Email, user.EmailVerified, user.EmailVerifiedAt, user.UpdatedAt, user.UpdatedBy, user.Id)
+	return err
+}
+
+func (r *authRepository) DeleteUserAccount(ctx context.Context, userID string, deletedAt time.Time, deletedBy string, anonymize bool) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin delete account transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM public.user_cart WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("failed to clear user cart: %w", err)
+	}
+	// Akun provider dilepas supaya bisa dipakai mendaftar lagi
+	if _, err = tx.ExecContext(ctx, "DELETE FROM user_identity WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("failed to unlink user identities: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, "DELETE FROM user_recovery_code WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, "DELETE FROM user_totp WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("failed to delete totp: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, "UPDATE user_address SET is_deleted = TRUE, deleted_at = $1, is_default_shipping = FALSE, is_default_billing = FALSE WHERE user_id = $2 AND is_deleted = FALSE", deletedAt, userID); err != nil {
+		return fmt.Errorf("failed to delete addresses: %w", err)
+	}
+
+	if anonymize {
+		// Nama juga tersimpan di kolom audit (created_by dst.), jadi ikut diganti
+		var fullName string
+		if err = tx.QueryRowContext(ctx, "SELECT full_name FROM \"user\" WHERE id = $1 FOR UPDATE", userID).Scan(&fullName); err != nil {
+			return fmt.Errorf("failed to lock user: %w", err)
+		}
+		deletedBy = entity.AnonymizedUserName
+		_, err = tx.ExecContext(ctx, "UPDATE \"user\" SET full_name = $1, email = $2, password = '', email_verified = FALSE, email_verified_at = NULL, created_by = $1, updated_by = $1 WHERE id = $3",
+			entity.AnonymizedUserName, entity.AnonymizedUserEmail(userID), userID)
+		if err != nil {
+			return fmt.Errorf("failed to anonymize user: %w", err)
+		}
+		_, err = tx.ExecContext(ctx, "UPDATE \"order\" SET created_by = $1 WHERE user_id = $2 AND created_by = $3", entity.AnonymizedUserName, userID, fullName)
+		if err != nil {
+			return fmt.Errorf("failed to anonymize user orders: %w", err)
+		}
+		// Buku alamat dan snapshot alamat di order berisi data pribadi penerima
+		if _, err = tx.ExecContext(ctx, "DELETE FROM user_address WHERE user_id = $1", userID); err != nil {
+			return fmt.Errorf("failed to anonymize addresses: %w", err)
+		}
+		_, err = tx.ExecContext(ctx, "UPDATE order_address SET recipient_name = $1, phone = '', line1 = '', line2 = '' WHERE order_id IN (SELECT id FROM \"order\" WHERE user_id = $2)",
+			entity.AnonymizedUserName, userID)
+		if err != nil {
+			return fmt.Errorf("failed to anonymize order addresses: %w", err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE \"user\" SET is_deleted = TRUE, deleted_at = $1, deleted_by = $2 WHERE id = $3", deletedAt, deletedBy, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	return tx.Commit()
+}
+
 func NewAuthRepository(db *sql.DB) IAuthRepository {
 	return &authRepository{db: db}
 }
@@ -3,13 +3,14 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
 )
 
 type ICartRepository interface {
-	// FindByUserIDAndProductID retrieves a cart item by user ID and product ID.
-	FindByUserIDAndProductID(ctx context.Context, userID string, productID string) (*entity.CartItem, error)
+	// FindByUserIDAndProductID retrieves a cart item by user ID, product ID and variant ID (nil for products without variants).
+	FindByUserIDAndProductID(ctx context.Context, userID string, productID string, variantID *string) (*entity.CartItem, error)
 	// Update updates an existing cart item.
 	Update(ctx context.Context, item *entity.CartItem) error
 	// Insert inserts a new cart item.
@@ -24,27 +25,34 @@ type ICartRepository interface {
 
 
 // CartRepository implements ICartRepository for SQL database operations.
+// The same queries serve user carts and guest carts; only the table and owner column differ.
 type CartRepository struct {
-	db *sql.DB
+	db          *sql.DB
+	table       string
+	ownerColumn string
 }
 
 // NewCartRepository creates a new instance of CartRepository.
 func NewCartRepository(db *sql.DB) ICartRepository {
 	return &CartRepository{
-		db: db,
+		db:          db,
+		table:       "public.user_cart",
+		ownerColumn: "user_id",
 	}
 }
 
-// FindByUserIDAndProductID retrieves a cart item by user ID and product ID.
-func (r *CartRepository) FindByUserIDAndProductID(ctx context.Context, userID string, productID string) (*entity.CartItem, error) {
+// FindByUserIDAndProductID retrieves a cart item by user ID, product ID and variant ID.
+// The same product in two variants are two separate cart items.
+func (r *CartRepository) FindByUserIDAndProductID(ctx context.Context, userID string, productID string, variantID *string) (*entity.CartItem, error) {
 	var item entity.CartItem
-	query := `SELECT id, user_id, product_id, quantity, created_at, created_by, updated_at, updated_by
-			  FROM public.user_cart WHERE user_id = $1 AND product_id = $2`
-	row := r.db.QueryRowContext(ctx, query, userID, productID)
+	query := fmt.Sprintf(`SELECT id, %[2]s, product_id, variant_id, quantity, created_at, created_by, updated_at, updated_by
+			  FROM %[1]s WHERE %[2]s = $1 AND product_id = $2 AND variant_id IS NOT DISTINCT FROM $3`, r.table, r.ownerColumn)
+	row := r.db.QueryRowContext(ctx, query, userID, productID, variantID)
 	err := row.Scan(
 		&item.ID,
 		&item.UserID,
 		&item.ProductID,
+		&item.VariantID,
 		&item.Quantity,
 		&item.CreatedAt,
 		&item.CreatedBy,
@@ -62,21 +70,22 @@ func (r *CartRepository) FindByUserIDAndProductID(ctx context.Context, userID st
 
 // Update updates an existing cart item in the database.
 func (r *CartRepository) Update(ctx context.Context, item *entity.CartItem) error {
-	query := `UPDATE public.user_cart
+	query := fmt.Sprintf(`UPDATE %s
 			  SET quantity = $1, updated_at = $2, updated_by = $3
-			  WHERE id = $4`
+			  WHERE id = $4`, r.table)
 	_, err := r.db.ExecContext(ctx, query, item.Quantity, item.UpdatedAt, item.UpdatedBy, item.ID)
 	return err
 }
 
 // Insert inserts a new cart item into the database.
 func (r *CartRepository) Insert(ctx context.Context, item *entity.CartItem) error {
-	query := `INSERT INTO public.user_cart (id, user_id, product_id, quantity, created_at, created_by, updated_at, updated_by)
-			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	query := fmt.Sprintf(`INSERT INTO %s (id, %s, product_id, variant_id, quantity, created_at, created_by, updated_at, updated_by)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`, r.table, r.ownerColumn)
 	_, err := r.db.ExecContext(ctx, query,
 		item.ID,
 		item.UserID,
 		item.ProductID,
+		item.VariantID,
 		item.Quantity,
 		item.CreatedAt,
 		item.CreatedBy,
@@ -89,8 +98,8 @@ func (r *CartRepository) Insert(ctx context.Context, item *entity.CartItem) erro
 // FindByUserID retrieves all cart items for a given user ID.
 func (r *CartRepository) FindByUserID(ctx context.Context, userID string) ([]*entity.CartItem, error) {
 	var items []*entity.CartItem
-	query := `SELECT id, user_id, product_id, quantity, created_at, created_by, updated_at, updated_by
-			  FROM public.user_cart WHERE user_id = $1`
+	query := fmt.Sprintf(`SELECT id, %[2]s, product_id, variant_id, quantity, created_at, created_by, updated_at, updated_by
+			  FROM %[1]s WHERE %[2]s = $1`, r.table, r.ownerColumn)
 	rows, err := r.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, err
@@ -103,6 +112,7 @@ func (r *CartRepository) FindByUserID(ctx context.Context, userID string) ([]*en
 			&item.ID,
 			&item.UserID,
 			&item.ProductID,
+			&item.VariantID,
 			&item.Quantity,
 			&item.CreatedAt,
 			&item.CreatedBy,
@@ -125,13 +135,14 @@ func (r *CartRepository) FindByUserID(ctx context.Context, userID string) ([]*en
 // FindByID retrieves a single cart item by its ID.
 func (r *CartRepository) FindByID(ctx context.Context, cartID string) (*entity.CartItem, error) {
 	var item entity.CartItem
-	query := `SELECT id, user_id, product_id, quantity, created_at, created_by, updated_at, updated_by
-			  FROM public.user_cart WHERE id = $1`
+	query := fmt.Sprintf(`SELECT id, %s, product_id, variant_id, quantity, created_at, created_by, updated_at, updated_by
+			  FROM %s WHERE id = $1`, r.ownerColumn, r.table)
 	row := r.db.QueryRowContext(ctx, query, cartID)
 	err := row.Scan(
 		&item.ID,
 		&item.UserID,
 		&item.ProductID,
+		&item.VariantID,
 		&item.Quantity,
 		&item.CreatedAt,
 		&item.CreatedBy,
@@ -149,7 +160,7 @@ func (r *CartRepository) FindByID(ctx context.Context, cartID string) (*entity.C
 
 // Delete deletes a cart item from the database by its ID.
 func (r *CartRepository) Delete(ctx context.Context, cartID string) error {
-	query := `DELETE FROM public.user_cart WHERE id = $1`
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, r.table)
 	_, err := r.db.ExecContext(ctx, query, cartID)
 	return err
 }
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/lib/pq"
+)
+
+type ICategoryRepository interface {
+	CreateCategory(ctx context.Context, category *entity.Category) error
+	GetCategoryById(ctx context.Context, id string) (*entity.Category, error)
+	UpdateCategory(ctx context.Context, category *entity.Category) error
+	// DeleteCategory soft-deletes a category and removes its product assignments.
+	DeleteCategory(ctx context.Context, deletedAt time.Time, deletedBy string, id string) error
+	// ListCategories returns every active category as a flat list ordered by name.
+	ListCategories(ctx context.Context) ([]*entity.Category, error)
+	// HasChildCategories reports whether a category still has active sub-categories.
+	HasChildCategories(ctx context.Context, id string) (bool, error)
+	// IsCategoryInSubtree reports whether candidateID is rootID itself or one of its descendants.
+	IsCategoryInSubtree(ctx context.Context, rootID string, candidateID string) (bool, error)
+	// CountActiveCategories counts how many of the given IDs refer to active categories.
+	CountActiveCategories(ctx context.Context, ids []string) (int, error)
+}
+
+type categoryRepository struct {
+	db *sql.DB
+}
+
+// NewCategoryRepository creates a new instance of ICategoryRepository.
+func NewCategoryRepository(db *sql.DB) ICategoryRepository {
+	return &categoryRepository{db: db}
+}
+
+// categorySubtreeQuery mengembalikan id kategori $1 beserta seluruh keturunannya yang aktif.
+const categorySubtreeQuery = `WITH RECURSIVE subtree AS (
+		SELECT id FROM category WHERE id = $1 AND is_deleted = FALSE
+		UNION ALL
+		SELECT c.id FROM category c JOIN subtree s ON c.parent_id = s.id WHERE c.is_deleted = FALSE
+	)
+	SELECT id FROM subtree`
+
+func (r *categoryRepository) CreateCategory(ctx context.Context, category *entity.Category) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO category (id, parent_id, name, description, created_at, created_by, is_deleted)
+		VALUES ($1, $2, $3, $4, $5, $6, FALSE)`,
+		category.Id, category.ParentId, category.Name, category.Description, category.CreatedAt, category.CreatedBy)
+	return err
+}
+
+func (r *categoryRepository) GetCategoryById(ctx context.Context, id string) (*entity.Category, error) {
+	var c entity.Category
+	err := r.db.QueryRowContext(ctx, `SELECT id, parent_id, name, description, created_at, created_by, updated_at, updated_by
+		FROM category WHERE id = $1 AND is_deleted = FALSE`, id).
+		Scan(&c.Id, &c.ParentId, &c.Name, &c.Description, &c.CreatedAt, &c.CreatedBy, &c.UpdatedAt, &c.UpdatedBy)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *categoryRepository) UpdateCategory(ctx context.Context, category *entity.Category) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE category SET parent_id = $1, name = $2, description = $3, updated_at = $4, updated_by = $5 WHERE id = $6`,
+		category.ParentId, category.Name, category.Description, category.UpdatedAt, category.UpdatedBy, category.Id)
+	return err
+}
+
+func (r *categoryRepository) DeleteCategory(ctx context.Context, deletedAt time.Time, deletedBy string, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin category transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `UPDATE category SET is_deleted = TRUE, deleted_at = $1, deleted_by = $2 WHERE id = $3`, deletedAt, deletedBy, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, `DELETE FROM product_category WHERE category_id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to remove product assignments: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *categoryRepository) ListCategories(ctx context.Context) ([]*entity.Category, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, parent_id, name, description, created_at, created_by, updated_at, updated_by
+		FROM category WHERE is_deleted = FALSE ORDER BY name ASC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	categories := make([]*entity.Category, 0)
+	for rows.Next() {
+		var c entity.Category
+		if err := rows.Scan(&c.Id, &c.ParentId, &c.Name, &c.Description, &c.CreatedAt, &c.CreatedBy, &c.UpdatedAt, &c.UpdatedBy); err != nil {
+			return nil, err
+		}
+		categories = append(categories, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+func (r *categoryRepository) HasChildCategories(ctx context.Context, id string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM category WHERE parent_id = $1 AND is_deleted = FALSE)`, id).Scan(&exists)
+	return exists, err
+}
+
+func (r *categoryRepository) IsCategoryInSubtree(ctx context.Context, rootID string, candidateID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `SELECT EXISTS (`+categorySubtreeQuery+` WHERE id = $2)`, rootID, candidateID).Scan(&exists)
+	return exists, err
+}
+
+func (r *categoryRepository) CountActiveCategories(ctx context.Context, ids []string) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(id) FROM category WHERE id = ANY($1) AND is_deleted = FALSE`, pq.Array(ids)).Scan(&count)
+	return count, err
+}
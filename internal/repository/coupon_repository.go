@@ -0,0 +1,351 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/common"
+	"github.com/lib/pq"
+)
+
+// ErrCouponUsageLimitReached is returned by checkout when the coupon was redeemed up to its usage limit.
+var ErrCouponUsageLimitReached = errors.New("coupon usage limit has been reached")
+
+type ICouponRepository interface {
+	// CreateCoupon inserts a coupon together with its product and category scope.
+	CreateCoupon(ctx context.Context, coupon *entity.Coupon) error
+	// GetCouponById retrieves a coupon, or nil.
+	GetCouponById(ctx context.Context, id string) (*entity.Coupon, error)
+	// GetCouponByCode retrieves a coupon by its upper-case code, or nil.
+	GetCouponByCode(ctx context.Context, code string) (*entity.Coupon, error)
+	// ListCoupons retrieves a page of coupons, newest first by default.
+	ListCoupons(ctx context.Context, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Coupon, int32, error)
+	// DeactivateCoupon stops a coupon from being applied or redeemed.
+	DeactivateCoupon(ctx context.Context, id string, updatedAt time.Time, updatedBy string) error
+	// CountUserRedemptions returns how many times a user has redeemed a coupon.
+	CountUserRedemptions(ctx context.Context, couponID string, userID string) (int, error)
+	// SetCartCoupon applies a coupon to the user's cart, replacing the coupon applied before.
+	SetCartCoupon(ctx context.Context, userID string, couponID string, appliedAt time.Time) error
+	// RemoveCartCoupon removes the coupon applied to the user's cart, if any.
+	RemoveCartCoupon(ctx context.Context, userID string) error
+	// GetCartCoupon retrieves the coupon applied to the user's cart and which of productIDs it applies to, or nil.
+	GetCartCoupon(ctx context.Context, userID string, productIDs []string) (*entity.CartCoupon, error)
+}
+
+type couponRepository struct {
+	db *sql.DB
+}
+
+// NewCouponRepository creates a new instance of ICouponRepository.
+func NewCouponRepository(db *sql.DB) ICouponRepository {
+	return &couponRepository{db: db}
+}
+
+// rowQueryer is implemented by both *sql.DB and *sql.Tx.
+type rowQueryer interface {
+	queryer
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+const couponColumns = `c.id, c.code, c.description, c.discount_type, c.discount_value, c.currency, c.min_cart_value_minor,
+	c.valid_from, c.valid_to, c.usage_limit, c.per_user_limit, c.redemption_count, c.is_active,
+	c.created_at, c.created_by, c.updated_at, c.updated_by,
+	ARRAY(SELECT product_id FROM coupon_product WHERE coupon_id = c.id ORDER BY product_id),
+	ARRAY(SELECT category_id FROM coupon_category WHERE coupon_id = c.id ORDER BY category_id)`
+
+func scanCoupon(row interface{ Scan(dest ...any) error }) (*entity.Coupon, error) {
+	var c entity.Coupon
+	var discountValue, minCartValue int64
+	var currency string
+	err := row.Scan(&c.Id, &c.Code, &c.Description, &c.DiscountType, &discountValue, &currency, &minCartValue,
+		&c.ValidFrom, &c.ValidTo, &c.UsageLimit, &c.PerUserLimit, &c.RedemptionCount, &c.IsActive,
+		&c.CreatedAt, &c.CreatedBy, &c.UpdatedAt, &c.UpdatedBy,
+		pq.Array(&c.ProductIds), pq.Array(&c.CategoryIds))
+	if err != nil {
+		return nil, err
+	}
+	// discount_value menyimpan basis point atau minor unit, tergantung discount_type
+	if c.DiscountType == entity.CouponDiscountPercentage {
+		c.PercentOffBasisPoints = discountValue
+		c.AmountOff = entity.NewMoney(0, currency)
+	} else {
+		c.AmountOff = entity.NewMoney(discountValue, currency)
+	}
+	c.MinCartValue = entity.NewMoney(minCartValue, currency)
+	return &c, nil
+}
+
+func (r *couponRepository) CreateCoupon(ctx context.Context, coupon *entity.Coupon) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin coupon transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	discountValue := coupon.AmountOff.Amount
+	if coupon.DiscountType == entity.CouponDiscountPercentage {
+		discountValue = coupon.PercentOffBasisPoints
+	}
+	_, err = tx.ExecContext(ctx, `INSERT INTO coupon (id, code, description, discount_type, discount_value, currency, min_cart_value_minor,
+			valid_from, valid_to, usage_limit, per_user_limit, redemption_count, is_active, created_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, 0, TRUE, $12, $13)`,
+		coupon.Id, coupon.Code, coupon.Description, coupon.DiscountType, discountValue, coupon.MinCartValue.Currency, coupon.MinCartValue.Amount,
+		coupon.ValidFrom, coupon.ValidTo, coupon.UsageLimit, coupon.PerUserLimit, coupon.CreatedAt, coupon.CreatedBy)
+	if err != nil {
+		return fmt.Errorf("failed to insert coupon: %w", err)
+	}
+
+	if len(coupon.ProductIds) > 0 {
+		_, err = tx.ExecContext(ctx, `INSERT INTO coupon_product (coupon_id, product_id)
+			SELECT $1, product_id FROM UNNEST($2::varchar[]) AS product_id
+			ON CONFLICT DO NOTHING`, coupon.Id, pq.Array(coupon.ProductIds))
+		if err != nil {
+			return fmt.Errorf("failed to insert coupon products: %w", err)
+		}
+	}
+	if len(coupon.CategoryIds) > 0 {
+		_, err = tx.ExecContext(ctx, `INSERT INTO coupon_category (coupon_id, category_id)
+			SELECT $1, category_id FROM UNNEST($2::varchar[]) AS category_id
+			ON CONFLICT DO NOTHING`, coupon.Id, pq.Array(coupon.CategoryIds))
+		if err != nil {
+			return fmt.Errorf("failed to insert coupon categories: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *couponRepository) GetCouponById(ctx context.Context, id string) (*entity.Coupon, error) {
+	coupon, err := scanCoupon(r.db.QueryRowContext(ctx, `SELECT `+couponColumns+` FROM coupon c WHERE c.id = $1`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return coupon, nil
+}
+
+func (r *couponRepository) GetCouponByCode(ctx context.Context, code string) (*entity.Coupon, error) {
+	coupon, err := scanCoupon(r.db.QueryRowContext(ctx, `SELECT `+couponColumns+` FROM coupon c WHERE c.code = $1`, code))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return coupon, nil
+}
+
+func (r *couponRepository) ListCoupons(ctx context.Context, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Coupon, int32, error) {
+	offset := (page - 1) * limit
+
+	var totalElements int32
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(id) FROM coupon`).Scan(&totalElements); err != nil {
+		return nil, 0, fmt.Errorf("failed to count coupons: %w", err)
+	}
+	if totalElements == 0 {
+		return []*entity.Coupon{}, 0, nil
+	}
+
+	allowedSortFields := map[string]bool{
+		"created_at":       true,
+		"code":             true,
+		"valid_to":         true,
+		"redemption_count": true,
+	}
+	orderByClause, err := utils.BuildOrderByClause(sort, allowedSortFields, "ORDER BY created_at DESC")
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid sort parameter: %w", err)
+	}
+
+	dataQuery := fmt.Sprintf(`
+		SELECT %s
+		FROM coupon c
+		%s
+		LIMIT $1 OFFSET $2
+	`, couponColumns, orderByClause)
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, limit, offset)
+	if err != nil {
+		log.Printf("Error querying coupons with pagination: %v", err)
+		return nil, 0, fmt.Errorf("failed to fetch coupons: %w", err)
+	}
+	defer rows.Close()
+
+	var coupons []*entity.Coupon
+	for rows.Next() {
+		coupon, err := scanCoupon(rows)
+		if err != nil {
+			log.Printf("Error scanning coupon row: %v", err)
+			return nil, 0, fmt.Errorf("failed to scan coupon: %w", err)
+		}
+		coupons = append(coupons, coupon)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return coupons, totalElements, nil
+}
+
+func (r *couponRepository) DeactivateCoupon(ctx context.Context, id string, updatedAt time.Time, updatedBy string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE coupon SET is_active = FALSE, updated_at = $1, updated_by = $2 WHERE id = $3`,
+		updatedAt, updatedBy, id)
+	if err != nil {
+		return fmt.Errorf("failed to deactivate coupon: %w", err)
+	}
+	return nil
+}
+
+func (r *couponRepository) CountUserRedemptions(ctx context.Context, couponID string, userID string) (int, error) {
+	return countUserRedemptions(ctx, r.db, couponID, userID)
+}
+
+func (r *couponRepository) SetCartCoupon(ctx context.Context, userID string, couponID string, appliedAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO user_cart_coupon (user_id, coupon_id, applied_at) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET coupon_id = EXCLUDED.coupon_id, applied_at = EXCLUDED.applied_at`,
+		userID, couponID, appliedAt)
+	if err != nil {
+		return fmt.Errorf("failed to apply coupon to cart: %w", err)
+	}
+	return nil
+}
+
+func (r *couponRepository) RemoveCartCoupon(ctx context.Context, userID string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM user_cart_coupon WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to remove coupon from cart: %w", err)
+	}
+	return nil
+}
+
+func (r *couponRepository) GetCartCoupon(ctx context.Context, userID string, productIDs []string) (*entity.CartCoupon, error) {
+	return getCartCoupon(ctx, r.db, userID, productIDs, false)
+}
+
+func countUserRedemptions(ctx context.Context, q rowQueryer, couponID string, userID string) (int, error) {
+	var count int
+	err := q.QueryRowContext(ctx, `SELECT COUNT(id) FROM coupon_redemption WHERE coupon_id = $1 AND user_id = $2`, couponID, userID).
+		Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count coupon redemptions: %w", err)
+	}
+	return count, nil
+}
+
+// getCartCoupon reads the coupon applied to the user's cart. With lock, the coupon row stays locked until the
+// transaction ends, so concurrent checkouts with the same coupon are counted one after another.
+func getCartCoupon(ctx context.Context, q rowQueryer, userID string, productIDs []string, lock bool) (*entity.CartCoupon, error) {
+	query := `SELECT ` + couponColumns + ` FROM coupon c JOIN user_cart_coupon uc ON uc.coupon_id = c.id WHERE uc.user_id = $1`
+	if lock {
+		query += ` FOR UPDATE OF c`
+	}
+	coupon, err := scanCoupon(q.QueryRowContext(ctx, query, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read cart coupon: %w", err)
+	}
+
+	userRedemptions, err := countUserRedemptions(ctx, q, coupon.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	eligible, err := couponEligibleProducts(ctx, q, coupon, productIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.CartCoupon{
+		Coupon:             coupon,
+		EligibleProductIds: eligible,
+		UserRedemptions:    userRedemptions,
+	}, nil
+}
+
+// couponEligibleProducts returns which of productIDs the coupon applies to: products in its scope directly
+// or through one of its categories or their sub-categories.
+func couponEligibleProducts(ctx context.Context, q queryer, coupon *entity.Coupon, productIDs []string) (map[string]bool, error) {
+	eligible := make(map[string]bool, len(productIDs))
+	if len(coupon.ProductIds) == 0 && len(coupon.CategoryIds) == 0 {
+		for _, id := range productIDs {
+			eligible[id] = true
+		}
+		return eligible, nil
+	}
+
+	rows, err := q.QueryContext(ctx, `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM category WHERE id = ANY($3) AND is_deleted = FALSE
+			UNION
+			SELECT c.id FROM category c JOIN subtree s ON c.parent_id = s.id WHERE c.is_deleted = FALSE
+		)
+		SELECT p.id FROM UNNEST($1::varchar[]) AS p(id)
+		WHERE p.id = ANY($2)
+			OR EXISTS (SELECT 1 FROM product_category pc WHERE pc.product_id = p.id AND pc.category_id IN (SELECT id FROM subtree))`,
+		pq.Array(productIDs), pq.Array(coupon.ProductIds), pq.Array(coupon.CategoryIds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read coupon scope: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		eligible[id] = true
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return eligible, nil
+}
+
+// redeemCoupon counts one use of a coupon inside the checkout transaction. The usage limit is checked again
+// by the update itself, so the coupon cannot be over-redeemed.
+func redeemCoupon(ctx context.Context, tx *sql.Tx, redemption *entity.CouponRedemption) error {
+	result, err := tx.ExecContext(ctx, `UPDATE coupon SET redemption_count = redemption_count + 1
+		WHERE id = $1 AND (usage_limit IS NULL OR redemption_count < usage_limit)`, redemption.CouponId)
+	if err != nil {
+		return fmt.Errorf("failed to count coupon redemption: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrCouponUsageLimitReached
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO coupon_redemption (id, coupon_id, user_id, order_id, discount_minor, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		redemption.Id, redemption.CouponId, redemption.UserId, redemption.OrderId, redemption.Discount.Amount, redemption.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert coupon redemption: %w", err)
+	}
+	return nil
+}
+
+// releaseCouponRedemption gives back the coupon use of a cancelled order, if it used one.
+func releaseCouponRedemption(ctx context.Context, tx *sql.Tx, orderID string) error {
+	var couponID string
+	err := tx.QueryRowContext(ctx, `DELETE FROM coupon_redemption WHERE order_id = $1 RETURNING coupon_id`, orderID).Scan(&couponID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return fmt.Errorf("failed to delete coupon redemption: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, `UPDATE coupon SET redemption_count = redemption_count - 1 WHERE id = $1`, couponID); err != nil {
+		return fmt.Errorf("failed to release coupon redemption: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/google/uuid"
+)
+
+// createTestCoupon inserts an active fixed-amount coupon and removes it, with the orders and redemptions
+// that used it, when the test ends.
+func createTestCoupon(t *testing.T, db *sql.DB, usageLimit int) *entity.Coupon {
+	t.Helper()
+	coupon := &entity.Coupon{
+		Id:           "test-" + uuid.NewString(),
+		Code:         "TEST" + uuid.NewString()[:8],
+		DiscountType: entity.CouponDiscountFixedAmount,
+		AmountOff:    entity.NewMoney(1000, entity.DefaultCurrency),
+		MinCartValue: entity.NewMoney(0, entity.DefaultCurrency),
+		UsageLimit:   &usageLimit,
+		CreatedAt:    time.Now(),
+		CreatedBy:    "Test",
+	}
+	if err := NewCouponRepository(db).CreateCoupon(context.Background(), coupon); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM coupon_redemption WHERE coupon_id = $1`, coupon.Id)
+		db.Exec(`DELETE FROM "order" WHERE coupon_id = $1`, coupon.Id)
+		db.Exec(`DELETE FROM coupon WHERE id = $1`, coupon.Id)
+	})
+	return coupon
+}
+
+// checkoutWithCoupon checks out the (empty) cart of userID into an order that uses the cart coupon.
+// The coupon is not checked here, so only the repository guards its usage limit.
+func checkoutWithCoupon(repo IOrderRepository, userID string) (*entity.Order, error) {
+	return repo.Checkout(context.Background(), userID, func(lines []*entity.CartLine, coupon *entity.CartCoupon) (*entity.Order, error) {
+		if coupon == nil {
+			return nil, errors.New("cart coupon not found")
+		}
+		now := time.Now()
+		zero := entity.NewMoney(0, entity.DefaultCurrency)
+		return &entity.Order{
+			Id:            "test-" + uuid.NewString(),
+			UserId:        userID,
+			Status:        entity.OrderStatusPendingPayment,
+			TotalPrice:    zero,
+			Subtotal:      zero,
+			DiscountTotal: zero,
+			TaxTotal:      zero,
+			ShippingTotal: zero,
+			CouponId:      &coupon.Coupon.Id,
+			CouponCode:    &coupon.Coupon.Code,
+			CreatedAt:     now,
+			CreatedBy:     "Test",
+		}, nil
+	})
+}
+
+func getRedemptionCount(t *testing.T, db *sql.DB, couponID string) int {
+	t.Helper()
+	coupon, err := NewCouponRepository(db).GetCouponById(context.Background(), couponID)
+	if err != nil || coupon == nil {
+		t.Fatalf("failed to read coupon: %v", err)
+	}
+	return coupon.RedemptionCount
+}
+
+func TestCheckoutConcurrentSingleUseCoupon(t *testing.T) {
+	db := openTestDB(t)
+	coupon := createTestCoupon(t, db, 1)
+	couponRepo := NewCouponRepository(db)
+	orderRepo := NewOrderRepository(db)
+
+	const checkouts = 8
+	userIDs := make([]string, checkouts)
+	for i := range userIDs {
+		userIDs[i] = "test-" + uuid.NewString()
+		if err := couponRepo.SetCartCoupon(context.Background(), userIDs[i], coupon.Id, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	errs := make([]error, checkouts)
+	var wg sync.WaitGroup
+	for i := 0; i < checkouts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = checkoutWithCoupon(orderRepo, userIDs[i])
+		}(i)
+	}
+	wg.Wait()
+
+	var succeeded int
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case !errors.Is(err, ErrCouponUsageLimitReached):
+			t.Errorf("checkout %d: got %v, want ErrCouponUsageLimitReached", i, err)
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("got %d successful checkouts, want 1", succeeded)
+	}
+	if count := getRedemptionCount(t, db, coupon.Id); count != 1 {
+		t.Errorf("got redemption count %d, want 1", count)
+	}
+}
+
+func TestCancelOrderReleasesCouponRedemption(t *testing.T) {
+	db := openTestDB(t)
+	coupon := createTestCoupon(t, db, 1)
+	couponRepo := NewCouponRepository(db)
+	orderRepo := NewOrderRepository(db)
+
+	firstUser, secondUser := "test-"+uuid.NewString(), "test-"+uuid.NewString()
+	for _, userID := range []string{firstUser, secondUser} {
+		if err := couponRepo.SetCartCoupon(context.Background(), userID, coupon.Id, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	o, err := checkoutWithCoupon(orderRepo, firstUser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = checkoutWithCoupon(orderRepo, secondUser); !errors.Is(err, ErrCouponUsageLimitReached) {
+		t.Fatalf("got %v before cancelling, want ErrCouponUsageLimitReached", err)
+	}
+
+	updated, err := orderRepo.UpdateOrderStatus(context.Background(), o.Id, entity.OrderStatusPendingPayment, &entity.OrderStatusHistory{
+		Id:              uuid.NewString(),
+		OrderId:         o.Id,
+		FromStatus:      &o.Status,
+		ToStatus:        entity.OrderStatusCancelled,
+		Reason:          "Test",
+		ChangedByUserId: firstUser,
+		ChangedBy:       "Test",
+		CreatedAt:       time.Now(),
+	})
+	if err != nil || !updated {
+		t.Fatalf("failed to cancel order: %v (updated %v)", err, updated)
+	}
+	if count := getRedemptionCount(t, db, coupon.Id); count != 0 {
+		t.Fatalf("got redemption count %d after cancelling, want 0", count)
+	}
+	if redemptions, err := couponRepo.CountUserRedemptions(context.Background(), coupon.Id, firstUser); err != nil || redemptions != 0 {
+		t.Errorf("got %d redemptions of the cancelled order (%v), want 0", redemptions, err)
+	}
+
+	if _, err = checkoutWithCoupon(orderRepo, secondUser); err != nil {
+		t.Errorf("checkout after cancelling: %v", err)
+	}
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/google/uuid"
+)
+
+// IGuestCartRepository stores the carts of visitors who have not logged in.
+// Its cart item methods take the guest cart id where ICartRepository takes a user id.
+type IGuestCartRepository interface {
+	ICartRepository
+	// CreateGuestCart inserts a new guest cart.
+	CreateGuestCart(ctx context.Context, cart *entity.GuestCart) error
+	// PurgeExpiredGuestCarts deletes guest carts that expired at or before now, with their items, and returns how many were removed.
+	PurgeExpiredGuestCarts(ctx context.Context, now time.Time) (int64, error)
+	// GetGuestCartByTokenHash retrieves an unexpired guest cart, or nil.
+	GetGuestCartByTokenHash(ctx context.Context, tokenHash string, now time.Time) (*entity.GuestCart, error)
+	// MergeIntoUserCart moves the items of an unexpired guest cart into the user's cart, summing the quantities
+	// of items already there, and deletes the guest cart. It returns the number of merged items.
+	MergeIntoUserCart(ctx context.Context, tokenHash string, userID string, mergedBy string, now time.Time) (int, error)
+}
+
+type guestCartRepository struct {
+	*CartRepository
+}
+
+// NewGuestCartRepository creates a new instance of IGuestCartRepository.
+func NewGuestCartRepository(db *sql.DB) IGuestCartRepository {
+	return &guestCartRepository{
+		CartRepository: &CartRepository{
+			db:          db,
+			table:       "guest_cart_item",
+			ownerColumn: "guest_cart_id",
+		},
+	}
+}
+
+func (r *guestCartRepository) CreateGuestCart(ctx context.Context, cart *entity.GuestCart) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO guest_cart (id, token_hash, expires_at, created_at) VALUES ($1, $2, $3, $4)`,
+		cart.Id, cart.TokenHash, cart.ExpiresAt, cart.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert guest cart: %w", err)
+	}
+	return nil
+}
+
+func (r *guestCartRepository) PurgeExpiredGuestCarts(ctx context.Context, now time.Time) (int64, error) {
+	// Item ikut terhapus lewat ON DELETE CASCADE
+	result, err := r.db.ExecContext(ctx, `DELETE FROM guest_cart WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge expired guest carts: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+func (r *guestCartRepository) GetGuestCartByTokenHash(ctx context.Context, tokenHash string, now time.Time) (*entity.GuestCart, error) {
+	var cart entity.GuestCart
+	err := r.db.QueryRowContext(ctx, `SELECT id, token_hash, expires_at, created_at FROM guest_cart WHERE token_hash = $1 AND expires_at > $2`,
+		tokenHash, now).
+		Scan(&cart.Id, &cart.TokenHash, &cart.ExpiresAt, &cart.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &cart, nil
+}
+
+func (r *guestCartRepository) MergeIntoUserCart(ctx context.Context, tokenHash string, userID string, mergedBy string, now time.Time) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin guest cart merge transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Baris guest cart dikunci supaya dua login bersamaan dengan token yang sama tidak menggabungkan dua kali
+	var guestCartID string
+	err = tx.QueryRowContext(ctx, `SELECT id FROM guest_cart WHERE token_hash = $1 AND expires_at > $2 FOR UPDATE`, tokenHash, now).
+		Scan(&guestCartID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to lock guest cart: %w", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT product_id, variant_id, quantity FROM guest_cart_item WHERE guest_cart_id = $1 ORDER BY created_at`, guestCartID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read guest cart: %w", err)
+	}
+	var items []*entity.CartItem
+	for rows.Next() {
+		var item entity.CartItem
+		if err := rows.Scan(&item.ProductID, &item.VariantID, &item.Quantity); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan guest cart item: %w", err)
+		}
+		items = append(items, &item)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, fmt.Errorf("error during guest cart iteration: %w", err)
+	}
+
+	for _, item := range items {
+		// Produk yang sudah ada di cart user dijumlahkan; index unik user_cart membuat login bersamaan tidak
+		// menghasilkan baris ganda. Stok dicek lagi saat checkout
+		_, err = tx.ExecContext(ctx, `INSERT INTO public.user_cart (id, user_id, product_id, variant_id, quantity, created_at, created_by)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (user_id, product_id, (COALESCE(variant_id, ''))) DO UPDATE SET
+				quantity = user_cart.quantity + EXCLUDED.quantity, updated_at = EXCLUDED.created_at, updated_by = EXCLUDED.created_by`,
+			uuid.New(), userID, item.ProductID, item.VariantID, item.Quantity, now, mergedBy)
+		if err != nil {
+			return 0, fmt.Errorf("failed to merge cart item: %w", err)
+		}
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM guest_cart WHERE id = $1`, guestCartID); err != nil {
+		return 0, fmt.Errorf("failed to delete guest cart: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit guest cart merge: %w", err)
+	}
+	return len(items), nil
+}
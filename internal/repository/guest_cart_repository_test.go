@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/google/uuid"
+)
+
+// createTestGuestCart inserts a guest cart holding quantity of productID and returns its token hash.
+func createTestGuestCart(t *testing.T, repo IGuestCartRepository, productID string, quantity int, expiresAt time.Time) string {
+	t.Helper()
+	now := time.Now()
+	guestCart := &entity.GuestCart{Id: "test-" + uuid.NewString(), TokenHash: uuid.NewString(), ExpiresAt: expiresAt, CreatedAt: now}
+	if err := repo.CreateGuestCart(context.Background(), guestCart); err != nil {
+		t.Fatal(err)
+	}
+	err := repo.Insert(context.Background(), &entity.CartItem{
+		ID:        uuid.New(),
+		UserID:    guestCart.Id,
+		ProductID: productID,
+		Quantity:  quantity,
+		CreatedAt: now,
+		CreatedBy: entity.GuestCartActor,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return guestCart.TokenHash
+}
+
+func TestMergeIntoUserCartConcurrent(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewGuestCartRepository(db)
+	userID := "test-" + uuid.NewString()
+	productID := "test-" + uuid.NewString()
+	t.Cleanup(func() { db.Exec(`DELETE FROM public.user_cart WHERE user_id = $1`, userID) })
+
+	const merges = 8
+	tokenHashes := make([]string, merges)
+	for i := range tokenHashes {
+		tokenHashes[i] = createTestGuestCart(t, repo, productID, 1, time.Now().Add(time.Hour))
+	}
+
+	errs := make([]error, merges)
+	var wg sync.WaitGroup
+	for i := 0; i < merges; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = repo.MergeIntoUserCart(context.Background(), tokenHashes[i], userID, "Test", time.Now())
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("merge %d failed: %v", i, err)
+		}
+	}
+
+	items, err := NewCartRepository(db).FindByUserID(context.Background(), userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || items[0].Quantity != merges {
+		t.Fatalf("got %d cart rows, want one row with quantity %d", len(items), merges)
+	}
+}
+
+func TestPurgeExpiredGuestCarts(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewGuestCartRepository(db)
+	now := time.Now()
+	expired := createTestGuestCart(t, repo, "test-"+uuid.NewString(), 1, now.Add(-time.Minute))
+	active := createTestGuestCart(t, repo, "test-"+uuid.NewString(), 1, now.Add(time.Hour))
+	t.Cleanup(func() { db.Exec(`DELETE FROM guest_cart WHERE token_hash = $1`, active) })
+
+	if _, err := repo.PurgeExpiredGuestCarts(context.Background(), now); err != nil {
+		t.Fatal(err)
+	}
+
+	var remaining int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM guest_cart WHERE token_hash = ANY(ARRAY[$1, $2])`, expired, active).Scan(&remaining); err != nil {
+		t.Fatal(err)
+	}
+	if remaining != 1 {
+		t.Errorf("got %d guest carts, want only the active one left", remaining)
+	}
+	if guestCart, err := repo.GetGuestCartByTokenHash(context.Background(), active, now); err != nil || guestCart == nil {
+		t.Errorf("active guest cart missing after purge: %v", err)
+	}
+}
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+)
+
+var ErrInsufficientStock = errors.New("insufficient stock")
+
+type IInventoryRepository interface {
+	// GetStock retrieves the available stock of a product. Products without a stock row have 0 stock.
+	GetStock(ctx context.Context, productID string) (int, error)
+	// SetStock overwrites the available stock of a product.
+	SetStock(ctx context.Context, stock *entity.ProductStock) error
+	// AdjustStock adds delta (may be negative) to the available stock and returns the new quantity.
+	// It returns ErrInsufficientStock when the result would drop below zero.
+	AdjustStock(ctx context.Context, productID string, delta int, updatedBy string) (int, error)
+	// GetVariantStock retrieves the available stock of a product variant.
+	GetVariantStock(ctx context.Context, variantID string) (int, error)
+	// SetVariantStock overwrites the available stock of a product variant.
+	SetVariantStock(ctx context.Context, variantID string, quantity int, updatedBy string) error
+	// AdjustVariantStock adds delta (may be negative) to the stock of a product variant and returns the new quantity.
+	// It returns ErrInsufficientStock when the result would drop below zero.
+	AdjustVariantStock(ctx context.Context, variantID string, delta int, updatedBy string) (int, error)
+}
+
+type inventoryRepository struct {
+	db *sql.DB
+}
+
+// NewInventoryRepository creates a new instance of IInventoryRepository.
+func NewInventoryRepository(db *sql.DB) IInventoryRepository {
+	return &inventoryRepository{db: db}
+}
+
+func (r *inventoryRepository) GetStock(ctx context.Context, productID string) (int, error) {
+	var quantity int
+	err := r.db.QueryRowContext(ctx, `SELECT quantity FROM product_stock WHERE product_id = $1`, productID).Scan(&quantity)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return quantity, nil
+}
+
+func (r *inventoryRepository) SetStock(ctx context.Context, stock *entity.ProductStock) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO product_stock (product_id, quantity, updated_at, updated_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (product_id) DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = EXCLUDED.updated_at, updated_by = EXCLUDED.updated_by`,
+		stock.ProductId, stock.Quantity, stock.UpdatedAt, stock.UpdatedBy)
+	return err
+}
+
+func (r *inventoryRepository) AdjustStock(ctx context.Context, productID string, delta int, updatedBy string) (int, error) {
+	var quantity int
+	var err error
+	if delta > 0 {
+		err = r.db.QueryRowContext(ctx, `INSERT INTO product_stock (product_id, quantity, updated_at, updated_by)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (product_id) DO UPDATE SET quantity = product_stock.quantity + EXCLUDED.quantity, updated_at = EXCLUDED.updated_at, updated_by = EXCLUDED.updated_by
+			RETURNING quantity`,
+			productID, delta, time.Now(), updatedBy).Scan(&quantity)
+	} else {
+		// Pengurangan hanya berhasil jika stok mencukupi; tidak ada baris berarti stok 0
+		err = r.db.QueryRowContext(ctx, `UPDATE product_stock SET quantity = quantity + $1, updated_at = $2, updated_by = $3
+			WHERE product_id = $4 AND quantity + $1 >= 0
+			RETURNING quantity`,
+			delta, time.Now(), updatedBy, productID).Scan(&quantity)
+		if err == sql.ErrNoRows {
+			return 0, ErrInsufficientStock
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return quantity, nil
+}
+
+func (r *inventoryRepository) GetVariantStock(ctx context.Context, variantID string) (int, error) {
+	var quantity int
+	err := r.db.QueryRowContext(ctx, `SELECT stock FROM product_variant WHERE id = $1 AND is_deleted = FALSE`, variantID).Scan(&quantity)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return quantity, nil
+}
+
+func (r *inventoryRepository) SetVariantStock(ctx context.Context, variantID string, quantity int, updatedBy string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE product_variant SET stock = $1, updated_at = $2, updated_by = $3 WHERE id = $4`,
+		quantity, time.Now(), updatedBy, variantID)
+	return err
+}
+
+func (r *inventoryRepository) AdjustVariantStock(ctx context.Context, variantID string, delta int, updatedBy string) (int, error) {
+	var quantity int
+	err := r.db.QueryRowContext(ctx, `UPDATE product_variant SET stock = stock + $1, updated_at = $2, updated_by = $3
+		WHERE id = $4 AND stock + $1 >= 0
+		RETURNING stock`,
+		delta, time.Now(), updatedBy, variantID).Scan(&quantity)
+	if err == sql.ErrNoRows {
+		return 0, ErrInsufficientStock
+	}
+	if err != nil {
+		return 0, err
+	}
+	return quantity, nil
+}
+
+// stockKey identifies where stock is kept: the variant row when VariantID is set, otherwise product_stock.
+type stockKey struct {
+	ProductID string
+	VariantID string
+}
+
+// reserveStock locks the stock rows of the ordered products with SELECT ... FOR UPDATE and deducts
+// the ordered quantities, so concurrent checkouts cannot oversell the last unit.
+func reserveStock(ctx context.Context, tx *sql.Tx, items []*entity.OrderItem, updatedBy string) error {
+	keys, quantities, names := sumItemQuantities(items)
+
+	now := time.Now()
+	for _, key := range keys {
+		var available int
+		var err error
+		if key.VariantID != "" {
+			err = tx.QueryRowContext(ctx, `SELECT stock FROM product_variant WHERE id = $1 FOR UPDATE`, key.VariantID).Scan(&available)
+		} else {
+			err = tx.QueryRowContext(ctx, `SELECT quantity FROM product_stock WHERE product_id = $1 FOR UPDATE`, key.ProductID).Scan(&available)
+		}
+		if err != nil && err != sql.ErrNoRows {
+			return fmt.Errorf("failed to lock product stock: %w", err)
+		}
+		if available < quantities[key] {
+			return fmt.Errorf("%w for %s", ErrInsufficientStock, names[key])
+		}
+
+		if key.VariantID != "" {
+			_, err = tx.ExecContext(ctx, `UPDATE product_variant SET stock = stock - $1, updated_at = $2, updated_by = $3 WHERE id = $4`,
+				quantities[key], now, updatedBy, key.VariantID)
+		} else {
+			_, err = tx.ExecContext(ctx, `UPDATE product_stock SET quantity = quantity - $1, updated_at = $2, updated_by = $3 WHERE product_id = $4`,
+				quantities[key], now, updatedBy, key.ProductID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to reserve product stock: %w", err)
+		}
+	}
+	return nil
+}
+
+// releaseStock returns the quantities of the given order items to the available stock.
+func releaseStock(ctx context.Context, tx *sql.Tx, items []*entity.OrderItem, updatedBy string) error {
+	keys, quantities, _ := sumItemQuantities(items)
+
+	now := time.Now()
+	for _, key := range keys {
+		quantity := quantities[key]
+		var err error
+		if key.VariantID != "" {
+			_, err = tx.ExecContext(ctx, `UPDATE product_variant SET stock = stock + $1, updated_at = $2, updated_by = $3 WHERE id = $4`,
+				quantity, now, updatedBy, key.VariantID)
+		} else {
+			_, err = tx.ExecContext(ctx, `INSERT INTO product_stock (product_id, quantity, updated_at, updated_by)
+				VALUES ($1, $2, $3, $4)
+				ON CONFLICT (product_id) DO UPDATE SET quantity = product_stock.quantity + EXCLUDED.quantity, updated_at = EXCLUDED.updated_at, updated_by = EXCLUDED.updated_by`,
+				key.ProductID, quantity, now, updatedBy)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to release product stock: %w", err)
+		}
+	}
+	return nil
+}
+
+// sumItemQuantities groups order items per product variant. Keys are returned sorted so every
+// transaction locks stock rows in the same order, avoiding deadlocks between concurrent checkouts.
+func sumItemQuantities(items []*entity.OrderItem) ([]stockKey, map[stockKey]int, map[stockKey]string) {
+	quantities := make(map[stockKey]int)
+	names := make(map[stockKey]string)
+	for _, item := range items {
+		key := stockKey{ProductID: item.ProductId}
+		name := item.ProductName
+		if item.VariantId != nil {
+			key.VariantID = *item.VariantId
+			if item.VariantName != nil {
+				name = fmt.Sprintf("%s (%s)", item.ProductName, *item.VariantName)
+			}
+		}
+		quantities[key] += item.Quantity
+		names[key] = name
+	}
+
+	keys := make([]stockKey, 0, len(quantities))
+	for key := range quantities {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].ProductID != keys[j].ProductID {
+			return keys[i].ProductID < keys[j].ProductID
+		}
+		return keys[i].VariantID < keys[j].VariantID
+	})
+	return keys, quantities, names
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/google/uuid"
+)
+
+func TestSumItemQuantities(t *testing.T) {
+	red, blue, size := "v-red", "v-blue", "Red"
+	items := []*entity.OrderItem{
+		{ProductId: "p2", ProductName: "Hat", Quantity: 1},
+		{ProductId: "p1", ProductName: "Shirt", VariantId: &red, VariantName: &size, Quantity: 2},
+		{ProductId: "p1", ProductName: "Shirt", VariantId: &blue, Quantity: 1},
+		{ProductId: "p2", ProductName: "Hat", Quantity: 3},
+		{ProductId: "p1", ProductName: "Shirt", VariantId: &red, VariantName: &size, Quantity: 1},
+	}
+
+	keys, quantities, names := sumItemQuantities(items)
+
+	wantKeys := []stockKey{{ProductID: "p1", VariantID: "v-blue"}, {ProductID: "p1", VariantID: "v-red"}, {ProductID: "p2"}}
+	if !reflect.DeepEqual(keys, wantKeys) {
+		t.Fatalf("got keys %v, want %v", keys, wantKeys)
+	}
+	wantQuantities := map[stockKey]int{wantKeys[0]: 1, wantKeys[1]: 3, wantKeys[2]: 4}
+	if !reflect.DeepEqual(quantities, wantQuantities) {
+		t.Errorf("got quantities %v, want %v", quantities, wantQuantities)
+	}
+	wantNames := map[stockKey]string{wantKeys[0]: "Shirt", wantKeys[1]: "Shirt (Red)", wantKeys[2]: "Hat"}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Errorf("got names %v, want %v", names, wantNames)
+	}
+}
+
+func TestReserveStockConcurrentCheckouts(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewInventoryRepository(db)
+	productID := "test-" + uuid.NewString()
+	t.Cleanup(func() { db.Exec(`DELETE FROM product_stock WHERE product_id = $1`, productID) })
+	const stock = 3
+	err := repo.SetStock(context.Background(), &entity.ProductStock{ProductId: productID, Quantity: stock, UpdatedAt: time.Now(), UpdatedBy: "Test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const checkouts = 8
+	errs := make([]error, checkouts)
+	var wg sync.WaitGroup
+	for i := 0; i < checkouts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tx, err := db.BeginTx(context.Background(), nil)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			defer tx.Rollback()
+			items := []*entity.OrderItem{{ProductId: productID, ProductName: "Test", Quantity: 1}}
+			if errs[i] = reserveStock(context.Background(), tx, items, "Test"); errs[i] == nil {
+				errs[i] = tx.Commit()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	var reserved int
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			reserved++
+		case !errors.Is(err, ErrInsufficientStock):
+			t.Errorf("checkout %d: got %v, want ErrInsufficientStock", i, err)
+		}
+	}
+	left, err := repo.GetStock(context.Background(), productID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reserved != stock || left != 0 {
+		t.Errorf("got %d reservations and %d left, want %d and 0", reserved, left, stock)
+	}
+}
+
+func TestAdjustStockNeverNegative(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewInventoryRepository(db)
+	productID := "test-" + uuid.NewString()
+	t.Cleanup(func() { db.Exec(`DELETE FROM product_stock WHERE product_id = $1`, productID) })
+
+	if _, err := repo.AdjustStock(context.Background(), productID, -1, "Test"); !errors.Is(err, ErrInsufficientStock) {
+		t.Fatalf("got %v for a product without stock, want ErrInsufficientStock", err)
+	}
+	if quantity, err := repo.AdjustStock(context.Background(), productID, 2, "Test"); err != nil || quantity != 2 {
+		t.Fatalf("got %d, %v, want 2", quantity, err)
+	}
+	if _, err := repo.AdjustStock(context.Background(), productID, -3, "Test"); !errors.Is(err, ErrInsufficientStock) {
+		t.Fatalf("got %v, want ErrInsufficientStock", err)
+	}
+	if quantity, err := repo.AdjustStock(context.Background(), productID, -2, "Test"); err != nil || quantity != 0 {
+		t.Errorf("got %d, %v, want 0", quantity, err)
+	}
+}
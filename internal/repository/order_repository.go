@@ -0,0 +1,415 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/common"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// CheckoutFunc builds the order from the cart lines and the cart coupon (nil without one), both read inside the
+// checkout transaction. Returning an error aborts the checkout and rolls the transaction back.
+type CheckoutFunc func(lines []*entity.CartLine, coupon *entity.CartCoupon) (*entity.Order, error)
+
+type IOrderRepository interface {
+	// Checkout converts the user's cart into an order, redeems the cart coupon the order uses and clears the cart
+	// in a single transaction. It returns ErrCouponUsageLimitReached when the coupon is used up.
+	Checkout(ctx context.Context, userID string, build CheckoutFunc) (*entity.Order, error)
+	// GetOrderById retrieves an order together with its items and address snapshots.
+	GetOrderById(ctx context.Context, orderID string) (*entity.Order, error)
+	// ListOrdersByUserID retrieves a page of orders (without items) for a given user ID.
+	ListOrdersByUserID(ctx context.Context, userID string, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Order, int32, error)
+	// UpdateOrderStatus moves an order from fromStatus to history.ToStatus and records the history entry.
+	// It returns false when the order is no longer in fromStatus (changed concurrently).
+	UpdateOrderStatus(ctx context.Context, orderID string, fromStatus string, history *entity.OrderStatusHistory) (bool, error)
+	// GetOrderStatusHistory retrieves the status timeline of an order, oldest first.
+	GetOrderStatusHistory(ctx context.Context, orderID string) ([]*entity.OrderStatusHistory, error)
+}
+
+type orderRepository struct {
+	db *sql.DB
+}
+
+// NewOrderRepository creates a new instance of IOrderRepository.
+func NewOrderRepository(db *sql.DB) IOrderRepository {
+	return &orderRepository{db: db}
+}
+
+func (r *orderRepository) Checkout(ctx context.Context, userID string, build CheckoutFunc) (*entity.Order, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin checkout transaction: %w", err)
+	}
+	// Rollback tidak berpengaruh jika transaksi sudah di-commit
+	defer tx.Rollback()
+
+	// 1. Kunci baris cart user agar tidak berubah selama checkout berlangsung
+	rows, err := tx.QueryContext(ctx, `
+		SELECT c.id, c.product_id, p.name, c.variant_id, v.sku, v.name,
+			COALESCE(v.price_minor, p.price_minor), CASE WHEN v.price_minor IS NULL THEN p.currency ELSE v.currency END, c.quantity
+		FROM public.user_cart c
+		JOIN "product" p ON p.id = c.product_id
+		LEFT JOIN product_variant v ON v.id = c.variant_id AND v.is_deleted = FALSE
+		WHERE c.user_id = $1 AND p.is_deleted = FALSE AND (c.variant_id IS NULL OR v.id IS NOT NULL)
+		ORDER BY c.created_at
+		FOR UPDATE OF c
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cart: %w", err)
+	}
+
+	var lines []*entity.CartLine
+	for rows.Next() {
+		var line entity.CartLine
+		if err := rows.Scan(&line.CartID, &line.ProductID, &line.ProductName, &line.VariantID, &line.VariantSku, &line.VariantName, &line.Price.Amount, &line.Price.Currency, &line.Quantity); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan cart line: %w", err)
+		}
+		lines = append(lines, &line)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during cart iteration: %w", err)
+	}
+
+	// 2. Kupon di cart dibaca dengan baris kupon dikunci, sehingga checkout lain dengan kupon yang sama menunggu
+	productIDs := make([]string, 0, len(lines))
+	for _, line := range lines {
+		productIDs = append(productIDs, line.ProductID)
+	}
+	cartCoupon, err := getCartCoupon(ctx, tx, userID, productIDs, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Bangun order dari snapshot cart
+	order, err := build(lines, cartCoupon)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Reservasi stok; baris stok produk/varian dikunci sampai transaksi selesai
+	if err = reserveStock(ctx, tx, order.Items, order.CreatedBy); err != nil {
+		return nil, err
+	}
+
+	// 5. Simpan header order, item-item dan snapshot alamatnya
+	_, err = tx.ExecContext(ctx, `INSERT INTO "order" (id, user_id, status, currency, total_price_minor, subtotal_minor, discount_total_minor, tax_total_minor, shipping_total_minor, total_quantity, coupon_id, coupon_code, created_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+		order.Id, order.UserId, order.Status, order.TotalPrice.Currency, order.TotalPrice.Amount, order.Subtotal.Amount, order.DiscountTotal.Amount, order.TaxTotal.Amount, order.ShippingTotal.Amount, order.TotalQuantity, order.CouponId, order.CouponCode, order.CreatedAt, order.CreatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert order: %w", err)
+	}
+
+	for _, item := range order.Items {
+		_, err = tx.ExecContext(ctx, `INSERT INTO order_item (id, order_id, product_id, product_name, variant_id, sku, variant_name, price_minor, quantity, discount_minor, tax_minor, total_minor, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+			item.Id, item.OrderId, item.ProductId, item.ProductName, item.VariantId, item.Sku, item.VariantName, item.Price.Amount, item.Quantity, item.Discount.Amount, item.Tax.Amount, item.Total.Amount, item.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert order item: %w", err)
+		}
+	}
+
+	for _, address := range []*entity.OrderAddress{order.ShippingAddress, order.BillingAddress} {
+		if address == nil {
+			continue
+		}
+		_, err = tx.ExecContext(ctx, `INSERT INTO order_address (order_id, address_type, recipient_name, phone, line1, line2, city, region, postal_code, country_code)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			order.Id, address.AddressType, address.RecipientName, address.Phone, address.Line1, address.Line2, address.City, address.Region, address.PostalCode, address.CountryCode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert order address: %w", err)
+		}
+	}
+
+	err = insertOrderStatusHistory(ctx, tx, &entity.OrderStatusHistory{
+		Id:              uuid.NewString(),
+		OrderId:         order.Id,
+		ToStatus:        order.Status,
+		Reason:          "Order created",
+		ChangedByUserId: order.UserId,
+		ChangedBy:       order.CreatedBy,
+		CreatedAt:       order.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 6. Hitung pemakaian kupon
+	if order.CouponId != nil {
+		err = redeemCoupon(ctx, tx, &entity.CouponRedemption{
+			Id:        uuid.NewString(),
+			CouponId:  *order.CouponId,
+			UserId:    order.UserId,
+			OrderId:   order.Id,
+			Discount:  order.DiscountTotal,
+			CreatedAt: order.CreatedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 7. Kosongkan cart (beserta kupon yang dipasang) yang sudah dikonversi menjadi order
+	cartIDs := make([]string, 0, len(lines))
+	for _, line := range lines {
+		cartIDs = append(cartIDs, line.CartID.String())
+	}
+	_, err = tx.ExecContext(ctx, `DELETE FROM public.user_cart WHERE id = ANY($1)`, pq.Array(cartIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to clear cart: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, `DELETE FROM user_cart_coupon WHERE user_id = $1`, userID); err != nil {
+		return nil, fmt.Errorf("failed to clear cart coupon: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit checkout: %w", err)
+	}
+	return order, nil
+}
+
+const orderColumns = `id, user_id, status, currency, total_price_minor, subtotal_minor, discount_total_minor, tax_total_minor, shipping_total_minor, total_quantity, coupon_id, coupon_code, created_at, created_by, updated_at, updated_by`
+
+func scanOrder(row interface{ Scan(dest ...any) error }) (*entity.Order, error) {
+	var o entity.Order
+	var currency string
+	err := row.Scan(&o.Id, &o.UserId, &o.Status, &currency, &o.TotalPrice.Amount, &o.Subtotal.Amount, &o.DiscountTotal.Amount, &o.TaxTotal.Amount, &o.ShippingTotal.Amount, &o.TotalQuantity, &o.CouponId, &o.CouponCode, &o.CreatedAt, &o.CreatedBy, &o.UpdatedAt, &o.UpdatedBy)
+	if err != nil {
+		return nil, err
+	}
+	o.TotalPrice.Currency = currency
+	o.Subtotal.Currency = currency
+	o.DiscountTotal.Currency = currency
+	o.TaxTotal.Currency = currency
+	o.ShippingTotal.Currency = currency
+	return &o, nil
+}
+
+func (r *orderRepository) GetOrderById(ctx context.Context, orderID string) (*entity.Order, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+orderColumns+` FROM "order" WHERE id = $1`, orderID)
+	order, err := scanOrder(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	order.Items, err = getOrderItems(ctx, r.db, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := getOrderAddresses(ctx, r.db, orderID)
+	if err != nil {
+		return nil, err
+	}
+	for _, address := range addresses {
+		switch address.AddressType {
+		case entity.AddressTypeShipping:
+			order.ShippingAddress = address
+		case entity.AddressTypeBilling:
+			order.BillingAddress = address
+		}
+	}
+
+	return order, nil
+}
+
+func (r *orderRepository) ListOrdersByUserID(ctx context.Context, userID string, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Order, int32, error) {
+	offset := (page - 1) * limit
+
+	var totalElements int32
+	countQuery := `SELECT COUNT(id) FROM "order" WHERE user_id = $1`
+	if err := r.db.QueryRowContext(ctx, countQuery, userID).Scan(&totalElements); err != nil {
+		log.Printf("Error counting orders: %v", err)
+		return nil, 0, fmt.Errorf("failed to get total order count: %w", err)
+	}
+
+	if totalElements == 0 {
+		return nil, 0, nil
+	}
+
+	allowedSortFields := map[string]bool{
+		"created_at":  true,
+		"total_price": true,
+		"status":      true,
+	}
+	orderByClause, err := utils.BuildOrderByClause(sort, allowedSortFields, "ORDER BY created_at DESC")
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid sort parameter: %w", err)
+	}
+
+	dataQuery := fmt.Sprintf(`
+		SELECT %s
+		FROM "order"
+		WHERE user_id = $1
+		%s
+		LIMIT $2 OFFSET $3
+	`, orderColumns, orderByClause)
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, userID, limit, offset)
+	if err != nil {
+		log.Printf("Error querying orders with pagination: %v", err)
+		return nil, 0, fmt.Errorf("failed to fetch orders: %w", err)
+	}
+	defer rows.Close()
+
+	var orders []*entity.Order
+	for rows.Next() {
+		o, err := scanOrder(rows)
+		if err != nil {
+			log.Printf("Error scanning order row: %v", err)
+			return nil, 0, fmt.Errorf("failed to scan order: %w", err)
+		}
+		orders = append(orders, o)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return orders, totalElements, nil
+}
+
+func (r *orderRepository) UpdateOrderStatus(ctx context.Context, orderID string, fromStatus string, history *entity.OrderStatusHistory) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin order status transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Syarat status = fromStatus mencegah dua admin memindahkan order yang sama secara bersamaan
+	result, err := tx.ExecContext(ctx, `UPDATE "order" SET status = $1, updated_at = $2, updated_by = $3 WHERE id = $4 AND status = $5`,
+		history.ToStatus, history.CreatedAt, history.ChangedBy, orderID, fromStatus)
+	if err != nil {
+		return false, fmt.Errorf("failed to update order status: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	if err = insertOrderStatusHistory(ctx, tx, history); err != nil {
+		return false, err
+	}
+
+	// Order yang dibatalkan mengembalikan stok yang sudah direservasi dan pemakaian kuponnya saat checkout
+	if history.ToStatus == entity.OrderStatusCancelled {
+		items, err := getOrderItems(ctx, tx, orderID)
+		if err != nil {
+			return false, err
+		}
+		if err = releaseStock(ctx, tx, items, history.ChangedBy); err != nil {
+			return false, err
+		}
+		if err = releaseCouponRedemption(ctx, tx, orderID); err != nil {
+			return false, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit order status: %w", err)
+	}
+	return true, nil
+}
+
+func (r *orderRepository) GetOrderStatusHistory(ctx context.Context, orderID string) ([]*entity.OrderStatusHistory, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, order_id, from_status, to_status, reason, changed_by_user_id, changed_by, created_at
+		FROM order_status_history WHERE order_id = $1 ORDER BY created_at`, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var histories []*entity.OrderStatusHistory
+	for rows.Next() {
+		var h entity.OrderStatusHistory
+		if err := rows.Scan(&h.Id, &h.OrderId, &h.FromStatus, &h.ToStatus, &h.Reason, &h.ChangedByUserId, &h.ChangedBy, &h.CreatedAt); err != nil {
+			return nil, err
+		}
+		histories = append(histories, &h)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return histories, nil
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func getOrderItems(ctx context.Context, q queryer, orderID string) ([]*entity.OrderItem, error) {
+	rows, err := q.QueryContext(ctx, `SELECT i.id, i.order_id, i.product_id, i.product_name, i.variant_id, i.sku, i.variant_name, o.currency,
+			i.price_minor, i.quantity, i.discount_minor, i.tax_minor, i.total_minor, i.created_at
+		FROM order_item i
+		JOIN "order" o ON o.id = i.order_id
+		WHERE i.order_id = $1 ORDER BY i.created_at`, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*entity.OrderItem
+	for rows.Next() {
+		var item entity.OrderItem
+		var currency string
+		if err := rows.Scan(&item.Id, &item.OrderId, &item.ProductId, &item.ProductName, &item.VariantId, &item.Sku, &item.VariantName, &currency,
+			&item.Price.Amount, &item.Quantity, &item.Discount.Amount, &item.Tax.Amount, &item.Total.Amount, &item.CreatedAt); err != nil {
+			return nil, err
+		}
+		item.Price.Currency = currency
+		item.Discount.Currency = currency
+		item.Tax.Currency = currency
+		item.Total.Currency = currency
+		items = append(items, &item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func getOrderAddresses(ctx context.Context, q queryer, orderID string) ([]*entity.OrderAddress, error) {
+	rows, err := q.QueryContext(ctx, `SELECT order_id, address_type, recipient_name, phone, line1, line2, city, region, postal_code, country_code
+		FROM order_address WHERE order_id = $1`, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var addresses []*entity.OrderAddress
+	for rows.Next() {
+		var a entity.OrderAddress
+		if err := rows.Scan(&a.OrderId, &a.AddressType, &a.RecipientName, &a.Phone, &a.Line1, &a.Line2, &a.City, &a.Region, &a.PostalCode, &a.CountryCode); err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, &a)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+func insertOrderStatusHistory(ctx context.Context, tx *sql.Tx, h *entity.OrderStatusHistory) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO order_status_history (id, order_id, from_status, to_status, reason, changed_by_user_id, changed_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		h.Id, h.OrderId, h.FromStatus, h.ToStatus, h.Reason, h.ChangedByUserId, h.ChangedBy, h.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert order status history: %w", err)
+	}
+	return nil
+}
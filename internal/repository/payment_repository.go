@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+)
+
+// ErrOrderNotPendingPayment is returned when a payment is captured for an order that is no longer awaiting payment.
+var ErrOrderNotPendingPayment = errors.New("order is not awaiting payment")
+
+// CaptureFunc captures the payment at the provider. It runs inside the capture transaction after the order and payment
+// rows are locked and checked, so an order cancelled concurrently is never charged. Returning an error aborts the capture.
+type CaptureFunc func() error
+
+type IPaymentRepository interface {
+	// InsertPayment inserts a new payment attempt.
+	InsertPayment(ctx context.Context, payment *entity.Payment) error
+	// GetLatestPaymentByOrderId retrieves the most recent payment attempt of an order.
+	GetLatestPaymentByOrderId(ctx context.Context, orderID string) (*entity.Payment, error)
+	// GetPaymentByIntentId retrieves a payment by the provider's intent ID.
+	GetPaymentByIntentId(ctx context.Context, provider string, intentID string) (*entity.Payment, error)
+	// MarkPaymentCaptured calls capture (when not nil), marks a pending payment as captured and moves its order to PAID
+	// in one transaction. It returns false when the payment was not pending anymore (already processed), and
+	// ErrOrderNotPendingPayment, leaving the payment pending without calling capture, when the order is no longer
+	// awaiting payment.
+	MarkPaymentCaptured(ctx context.Context, payment *entity.Payment, history *entity.OrderStatusHistory, capture CaptureFunc) (bool, error)
+	// MarkPaymentFailed marks a pending payment as failed.
+	MarkPaymentFailed(ctx context.Context, paymentID string, updatedBy string) (bool, error)
+	// MarkPaymentVoided marks a pending payment as voided after the provider released it uncaptured.
+	MarkPaymentVoided(ctx context.Context, paymentID string, updatedBy string) (bool, error)
+	// MarkPendingPaymentRefunded marks a pending payment as refunded without touching its order, for money the
+	// provider collected on an order that could no longer be paid.
+	MarkPendingPaymentRefunded(ctx context.Context, paymentID string, refundID string, updatedBy string) (bool, error)
+	// MarkPaymentRefunded marks a captured payment as refunded and moves its order to REFUNDED in one transaction,
+	// releasing the reserved stock when the order had not been shipped yet.
+	MarkPaymentRefunded(ctx context.Context, payment *entity.Payment, refundID string, history *entity.OrderStatusHistory) (bool, error)
+}
+
+type paymentRepository struct {
+	db *sql.DB
+}
+
+// NewPaymentRepository creates a new instance of IPaymentRepository.
+func NewPaymentRepository(db *sql.DB) IPaymentRepository {
+	return &paymentRepository{db: db}
+}
+
+const paymentColumns = `id, order_id, provider, provider_intent_id, provider_refund_id, amount_minor, currency, status, created_at, created_by, updated_at, updated_by`
+
+func scanPayment(row interface{ Scan(dest ...any) error }) (*entity.Payment, error) {
+	var p entity.Payment
+	err := row.Scan(&p.Id, &p.OrderId, &p.Provider, &p.ProviderIntentId, &p.ProviderRefundId, &p.Amount.Amount, &p.Amount.Currency, &p.Status, &p.CreatedAt, &p.CreatedBy, &p.UpdatedAt, &p.UpdatedBy)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *paymentRepository) InsertPayment(ctx context.Context, payment *entity.Payment) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO payment (id, order_id, provider, provider_intent_id, amount_minor, currency, status, created_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		payment.Id, payment.OrderId, payment.Provider, payment.ProviderIntentId, payment.Amount.Amount, payment.Amount.Currency, payment.Status, payment.CreatedAt, payment.CreatedBy)
+	return err
+}
+
+func (r *paymentRepository) GetLatestPaymentByOrderId(ctx context.Context, orderID string) (*entity.Payment, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+paymentColumns+` FROM payment WHERE order_id = $1 ORDER BY created_at DESC LIMIT 1`, orderID)
+	return scanPayment(row)
+}
+
+func (r *paymentRepository) GetPaymentByIntentId(ctx context.Context, provider string, intentID string) (*entity.Payment, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+paymentColumns+` FROM payment WHERE provider = $1 AND provider_intent_id = $2`, provider, intentID)
+	return scanPayment(row)
+}
+
+func (r *paymentRepository) MarkPaymentCaptured(ctx context.Context, payment *entity.Payment, history *entity.OrderStatusHistory, capture CaptureFunc) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin payment transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Order dikunci lebih dulu, sehingga pembatalan order menunggu sampai capture selesai
+	var orderStatus string
+	err = tx.QueryRowContext(ctx, `SELECT status FROM "order" WHERE id = $1 FOR UPDATE`, payment.OrderId).Scan(&orderStatus)
+	if err != nil {
+		return false, fmt.Errorf("failed to lock order: %w", err)
+	}
+
+	// Hanya payment berstatus PENDING yang bisa di-capture, sehingga webhook ganda tidak diproses dua kali
+	var paymentStatus string
+	err = tx.QueryRowContext(ctx, `SELECT status FROM payment WHERE id = $1 FOR UPDATE`, payment.Id).Scan(&paymentStatus)
+	if err != nil {
+		return false, fmt.Errorf("failed to lock payment: %w", err)
+	}
+	if paymentStatus != entity.PaymentStatusPending {
+		return false, nil
+	}
+
+	// Payment hanya di-capture selama order masih PENDING_PAYMENT, mis. bukan order yang sudah dibatalkan
+	if orderStatus != entity.OrderStatusPendingPayment {
+		return false, ErrOrderNotPendingPayment
+	}
+
+	if capture != nil {
+		if err = capture(); err != nil {
+			return false, err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE payment SET status = $1, updated_at = $2, updated_by = $3 WHERE id = $4`,
+		entity.PaymentStatusCaptured, history.CreatedAt, history.ChangedBy, payment.Id)
+	if err != nil {
+		return false, fmt.Errorf("failed to update payment: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, `UPDATE "order" SET status = $1, updated_at = $2, updated_by = $3 WHERE id = $4`,
+		entity.OrderStatusPaid, history.CreatedAt, history.ChangedBy, payment.OrderId)
+	if err != nil {
+		return false, fmt.Errorf("failed to update order status: %w", err)
+	}
+	if err = insertOrderStatusHistory(ctx, tx, history); err != nil {
+		return false, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit payment capture: %w", err)
+	}
+	return true, nil
+}
+
+func (r *paymentRepository) MarkPaymentFailed(ctx context.Context, paymentID string, updatedBy string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `UPDATE payment SET status = $1, updated_at = $2, updated_by = $3 WHERE id = $4 AND status = $5`,
+		entity.PaymentStatusFailed, time.Now(), updatedBy, paymentID, entity.PaymentStatusPending)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *paymentRepository) MarkPaymentVoided(ctx context.Context, paymentID string, updatedBy string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `UPDATE payment SET status = $1, updated_at = $2, updated_by = $3 WHERE id = $4 AND status = $5`,
+		entity.PaymentStatusVoided, time.Now(), updatedBy, paymentID, entity.PaymentStatusPending)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *paymentRepository) MarkPendingPaymentRefunded(ctx context.Context, paymentID string, refundID string, updatedBy string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `UPDATE payment SET status = $1, provider_refund_id = $2, updated_at = $3, updated_by = $4 WHERE id = $5 AND status = $6`,
+		entity.PaymentStatusRefunded, refundID, time.Now(), updatedBy, paymentID, entity.PaymentStatusPending)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *paymentRepository) MarkPaymentRefunded(ctx context.Context, payment *entity.Payment, refundID string, history *entity.OrderStatusHistory) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin refund transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `UPDATE payment SET status = $1, provider_refund_id = $2, updated_at = $3, updated_by = $4 WHERE id = $5 AND status = $6`,
+		entity.PaymentStatusRefunded, refundID, history.CreatedAt, history.ChangedBy, payment.Id, entity.PaymentStatusCaptured)
+	if err != nil {
+		return false, fmt.Errorf("failed to update payment: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	result, err = tx.ExecContext(ctx, `UPDATE "order" SET status = $1, updated_at = $2, updated_by = $3 WHERE id = $4 AND status = $5`,
+		history.ToStatus, history.CreatedAt, history.ChangedBy, payment.OrderId, *history.FromStatus)
+	if err != nil {
+		return false, fmt.Errorf("failed to update order status: %w", err)
+	}
+	rowsAffected, err = result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+	if err = insertOrderStatusHistory(ctx, tx, history); err != nil {
+		return false, err
+	}
+
+	// Barang dari order yang belum dikirim masih di gudang, jadi stok yang direservasi dikembalikan
+	if *history.FromStatus == entity.OrderStatusPaid || *history.FromStatus == entity.OrderStatusProcessing {
+		items, err := getOrderItems(ctx, tx, payment.OrderId)
+		if err != nil {
+			return false, err
+		}
+		if err = releaseStock(ctx, tx, items, history.ChangedBy); err != nil {
+			return false, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit payment refund: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/google/uuid"
+)
+
+type IProductImageRepository interface {
+	// ListImagesByProductId retrieves the gallery of a product in display order.
+	ListImagesByProductId(ctx context.Context, productID string) ([]*entity.ProductImage, error)
+	GetImageById(ctx context.Context, id string) (*entity.ProductImage, error)
+	// AttachImage appends an image to the end of the gallery. A primary image also becomes the product's main image.
+	AttachImage(ctx context.Context, image *entity.ProductImage) error
+	// SetPrimaryImage makes an image the primary one and copies it to product.image_file_name.
+	SetPrimaryImage(ctx context.Context, image *entity.ProductImage, updatedBy string) error
+	// ReorderImages sets the sort order of the gallery to the order of imageIDs.
+	ReorderImages(ctx context.Context, productID string, imageIDs []string) error
+	// DetachImage removes an image from the gallery. When it was primary, the next image in order is promoted.
+	DetachImage(ctx context.Context, image *entity.ProductImage, updatedBy string) error
+	// IsImageFileReferenced reports whether a product, gallery image or variant that is not deleted still uses the storage object.
+	IsImageFileReferenced(ctx context.Context, imageFileName string) (bool, error)
+}
+
+type productImageRepository struct {
+	db *sql.DB
+}
+
+// NewProductImageRepository creates a new instance of IProductImageRepository.
+func NewProductImageRepository(db *sql.DB) IProductImageRepository {
+	return &productImageRepository{db: db}
+}
+
+const productImageColumns = `id, product_id, image_file_name, sort_order, is_primary, created_at, created_by`
+
+func (r *productImageRepository) ListImagesByProductId(ctx context.Context, productID string) ([]*entity.ProductImage, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+productImageColumns+`
+		FROM product_image WHERE product_id = $1 ORDER BY sort_order, created_at`, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	images := make([]*entity.ProductImage, 0)
+	for rows.Next() {
+		var i entity.ProductImage
+		if err := rows.Scan(&i.Id, &i.ProductId, &i.ImageFileName, &i.SortOrder, &i.IsPrimary, &i.CreatedAt, &i.CreatedBy); err != nil {
+			return nil, err
+		}
+		images = append(images, &i)
+	}
+	return images, rows.Err()
+}
+
+func (r *productImageRepository) GetImageById(ctx context.Context, id string) (*entity.ProductImage, error) {
+	var i entity.ProductImage
+	err := r.db.QueryRowContext(ctx, `SELECT `+productImageColumns+` FROM product_image WHERE id = $1`, id).
+		Scan(&i.Id, &i.ProductId, &i.ImageFileName, &i.SortOrder, &i.IsPrimary, &i.CreatedAt, &i.CreatedBy)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &i, nil
+}
+
+func (r *productImageRepository) AttachImage(ctx context.Context, image *entity.ProductImage) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin product image transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(sort_order) + 1, 0) FROM product_image WHERE product_id = $1`, image.ProductId).Scan(&image.SortOrder)
+	if err != nil {
+		return fmt.Errorf("failed to get image sort order: %w", err)
+	}
+
+	if image.IsPrimary {
+		if _, err = tx.ExecContext(ctx, `UPDATE product_image SET is_primary = FALSE WHERE product_id = $1 AND is_primary = TRUE`, image.ProductId); err != nil {
+			return fmt.Errorf("failed to unset primary image: %w", err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO product_image (id, product_id, image_file_name, sort_order, is_primary, created_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		image.Id, image.ProductId, image.ImageFileName, image.SortOrder, image.IsPrimary, image.CreatedAt, image.CreatedBy)
+	if err != nil {
+		return fmt.Errorf("failed to insert product image: %w", err)
+	}
+
+	if image.IsPrimary {
+		if err = setProductMainImage(ctx, tx, image.ProductId, image.ImageFileName, image.CreatedBy); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *productImageRepository) SetPrimaryImage(ctx context.Context, image *entity.ProductImage, updatedBy string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin product image transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err = markPrimaryImage(ctx, tx, image, updatedBy); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *productImageRepository) ReorderImages(ctx context.Context, productID string, imageIDs []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin product image transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for i, imageID := range imageIDs {
+		_, err = tx.ExecContext(ctx, `UPDATE product_image SET sort_order = $1 WHERE id = $2 AND product_id = $3`, i, imageID, productID)
+		if err != nil {
+			return fmt.Errorf("failed to reorder product image: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *productImageRepository) DetachImage(ctx context.Context, image *entity.ProductImage, updatedBy string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin product image transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM product_image WHERE id = $1`, image.Id); err != nil {
+		return fmt.Errorf("failed to delete product image: %w", err)
+	}
+
+	if image.IsPrimary {
+		var next entity.ProductImage
+		err = tx.QueryRowContext(ctx, `SELECT `+productImageColumns+` FROM product_image
+			WHERE product_id = $1 ORDER BY sort_order, created_at LIMIT 1`, image.ProductId).
+			Scan(&next.Id, &next.ProductId, &next.ImageFileName, &next.SortOrder, &next.IsPrimary, &next.CreatedAt, &next.CreatedBy)
+		if err != nil && err != sql.ErrNoRows {
+			return fmt.Errorf("failed to find next primary image: %w", err)
+		}
+		if err == nil {
+			if err = markPrimaryImage(ctx, tx, &next, updatedBy); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *productImageRepository) IsImageFileReferenced(ctx context.Context, imageFileName string) (bool, error) {
+	var referenced bool
+	err := r.db.QueryRowContext(ctx, `SELECT
+		EXISTS (SELECT 1 FROM "product" WHERE image_file_name = $1 AND is_deleted = FALSE)
+		OR EXISTS (SELECT 1 FROM product_image WHERE image_file_name = $1)
+		OR EXISTS (SELECT 1 FROM product_variant WHERE image_file_name = $1 AND is_deleted = FALSE)`, imageFileName).Scan(&referenced)
+	if err != nil {
+		return false, fmt.Errorf("failed to check image references: %w", err)
+	}
+	return referenced, nil
+}
+
+func markPrimaryImage(ctx context.Context, tx *sql.Tx, image *entity.ProductImage, updatedBy string) error {
+	_, err := tx.ExecContext(ctx, `UPDATE product_image SET is_primary = FALSE WHERE product_id = $1 AND is_primary = TRUE`, image.ProductId)
+	if err != nil {
+		return fmt.Errorf("failed to unset primary image: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, `UPDATE product_image SET is_primary = TRUE WHERE id = $1`, image.Id)
+	if err != nil {
+		return fmt.Errorf("failed to set primary image: %w", err)
+	}
+	return setProductMainImage(ctx, tx, image.ProductId, image.ImageFileName, updatedBy)
+}
+
+func setProductMainImage(ctx context.Context, tx *sql.Tx, productID string, imageFileName string, updatedBy string) error {
+	_, err := tx.ExecContext(ctx, `UPDATE "product" SET image_file_name = $1, updated_at = $2, updated_by = $3 WHERE id = $4`,
+		imageFileName, time.Now(), updatedBy, productID)
+	if err != nil {
+		return fmt.Errorf("failed to update product image: %w", err)
+	}
+	return nil
+}
+
+// replacePrimaryImage points the primary gallery image at imageFileName, creating it when the product has none.
+// It keeps product_image in sync with CreateProduct/UpdateProduct, which still write product.image_file_name directly.
+func replacePrimaryImage(ctx context.Context, tx *sql.Tx, productID string, imageFileName string, createdBy string) error {
+	result, err := tx.ExecContext(ctx, `UPDATE product_image SET image_file_name = $1 WHERE product_id = $2 AND is_primary = TRUE`, imageFileName, productID)
+	if err != nil {
+		return fmt.Errorf("failed to update primary image: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO product_image (id, product_id, image_file_name, sort_order, is_primary, created_at, created_by)
+		VALUES ($1, $2, $3, 0, TRUE, $4, $5)`,
+		uuid.NewString(), productID, imageFileName, time.Now(), createdBy)
+	if err != nil {
+		return fmt.Errorf("failed to insert primary image: %w", err)
+	}
+	return nil
+}
@@ -5,21 +5,30 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
 	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
 	"github.com/daiyanuthsa/grpc-ecom-be/pb/common"
+	"github.com/lib/pq"
 )
 
 type IProductRepository interface {
 	CreateProduct(ctx context.Context, product *entity.Product) error
 	GetProductById(ctx context.Context, id string) (*entity.Product, error)
+	// UpdateProduct updates a product. Its categories are replaced only when CategoryIds is not nil.
 	UpdateProduct(ctx context.Context, product *entity.Product) error
+	// DeleteProduct soft-deletes a product and removes its gallery images.
 	DeleteProduct(ctx context.Context, DeletedAt time.Time, DeletedBy string, productId string) error
-	ListProducts(ctx context.Context, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Product, int32, error)
-	ListProductsAdmin(ctx context.Context, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Product, int32, error)
+	ListProducts(ctx context.Context, filter *entity.ProductFilter, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Product, int32, error)
+	ListProductsAdmin(ctx context.Context, filter *entity.ProductFilter, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Product, int32, error)
+	GetProductFacets(ctx context.Context, filter *entity.ProductFilter) (*entity.ProductFacets, error)
+	GetProductCategoryIds(ctx context.Context, productID string) ([]string, error)
+	// CountActiveProducts counts how many of the given IDs refer to products that are not deleted.
+	CountActiveProducts(ctx context.Context, ids []string) (int, error)
 	HighlightProducts(ctx context.Context) ([]*entity.Product, error)
+	SearchProducts(ctx context.Context, tsQuery string, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Product, int32, error)
 }
 
 
@@ -28,18 +37,33 @@ type productRepository struct {
 }
 
 func (r *productRepository) CreateProduct(ctx context.Context, product *entity.Product) error {
-	_, err := r.db.ExecContext(ctx, "INSERT INTO \"product\" (id, name, description, price, image_file_name, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
-		product.Id, product.Name, product.Description, product.Price, product.ImageFileName, product.CreatedAt, product.CreatedBy, product.UpdatedAt, product.UpdatedBy, product.DeletedAt, product.DeletedBy, product.IsDeleted)
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
-	return nil
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO \"product\" (id, name, description, price_minor, currency, image_file_name, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+		product.Id, product.Name, product.Description, product.Price.Amount, product.Price.Currency, product.ImageFileName, product.CreatedAt, product.CreatedBy, product.UpdatedAt, product.UpdatedBy, product.DeletedAt, product.DeletedBy, product.IsDeleted)
+	if err != nil {
+		return err
+	}
+	// CategoryIds nil berarti kategori produk tidak diubah
+	if product.CategoryIds != nil {
+		if err = replaceProductCategories(ctx, tx, product.Id, product.CategoryIds); err != nil {
+			return err
+		}
+	}
+	if err = replacePrimaryImage(ctx, tx, product.Id, product.ImageFileName, utils.SafeDerefString(product.CreatedBy)); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 func (r *productRepository) GetProductById(ctx context.Context, id string) (*entity.Product, error) {
-	row := r.db.QueryRowContext(ctx, "SELECT id, name, description, price, image_file_name FROM \"product\" WHERE id = $1 AND is_deleted = FALSE", id)
+	row := r.db.QueryRowContext(ctx, "SELECT id, name, description, price_minor, currency, image_file_name FROM \"product\" WHERE id = $1 AND is_deleted = FALSE", id)
 	var product entity.Product
-	if err := row.Scan(&product.Id, &product.Name, &product.Description, &product.Price, &product.ImageFileName); err != nil {
+	if err := row.Scan(&product.Id, &product.Name, &product.Description, &product.Price.Amount, &product.Price.Currency, &product.ImageFileName); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -49,31 +73,105 @@ func (r *productRepository) GetProductById(ctx context.Context, id string) (*ent
 }
 
 func (r *productRepository) UpdateProduct(ctx context.Context, product *entity.Product) error {
-	_, err := r.db.ExecContext(ctx, "UPDATE \"product\" SET name = $1, description = $2, price = $3, image_file_name = $4, updated_at = $5, updated_by = $6 WHERE id = $7",
-		product.Name, product.Description, product.Price, product.ImageFileName, product.UpdatedAt, product.UpdatedBy, product.Id)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "UPDATE \"product\" SET name = $1, description = $2, price_minor = $3, currency = $4, image_file_name = $5, updated_at = $6, updated_by = $7 WHERE id = $8",
+		product.Name, product.Description, product.Price.Amount, product.Price.Currency, product.ImageFileName, product.UpdatedAt, product.UpdatedBy, product.Id)
 	if err != nil {
 		return err
 	}
+	// CategoryIds nil berarti kategori produk tidak diubah
+	if product.CategoryIds != nil {
+		if err = replaceProductCategories(ctx, tx, product.Id, product.CategoryIds); err != nil {
+			return err
+		}
+	}
+	if err = replacePrimaryImage(ctx, tx, product.Id, product.ImageFileName, utils.SafeDerefString(product.UpdatedBy)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *productRepository) CountActiveProducts(ctx context.Context, ids []string) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(id) FROM "product" WHERE id = ANY($1) AND is_deleted = FALSE`, pq.Array(ids)).Scan(&count)
+	return count, err
+}
+
+func (r *productRepository) GetProductCategoryIds(ctx context.Context, productID string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT pc.category_id FROM product_category pc
+		JOIN category c ON c.id = pc.category_id AND c.is_deleted = FALSE
+		WHERE pc.product_id = $1 ORDER BY c.name ASC`, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	categoryIDs := make([]string, 0)
+	for rows.Next() {
+		var categoryID string
+		if err := rows.Scan(&categoryID); err != nil {
+			return nil, err
+		}
+		categoryIDs = append(categoryIDs, categoryID)
+	}
+	return categoryIDs, rows.Err()
+}
+
+// replaceProductCategories mengganti seluruh kategori produk dengan categoryIDs.
+func replaceProductCategories(ctx context.Context, tx *sql.Tx, productID string, categoryIDs []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_category WHERE product_id = $1`, productID); err != nil {
+		return fmt.Errorf("failed to clear product categories: %w", err)
+	}
+	if len(categoryIDs) == 0 {
+		return nil
+	}
+	_, err := tx.ExecContext(ctx, `INSERT INTO product_category (product_id, category_id)
+		SELECT $1, category_id FROM UNNEST($2::varchar[]) AS category_id
+		ON CONFLICT DO NOTHING`, productID, pq.Array(categoryIDs))
+	if err != nil {
+		return fmt.Errorf("failed to assign product categories: %w", err)
+	}
 	return nil
 }
 
 func (r *productRepository) DeleteProduct(ctx context.Context, DeletedAt time.Time, DeletedBy string, productId string) error {
-	_, err := r.db.ExecContext(ctx, "UPDATE \"product\" SET is_deleted = $1, deleted_at = $2, deleted_by = $3 WHERE id = $4",
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "UPDATE \"product\" SET is_deleted = $1, deleted_at = $2, deleted_by = $3 WHERE id = $4",
 		true, DeletedAt, DeletedBy, productId)
 	if err != nil {
 		return err
 	}
-	return nil
+	// Galeri ikut dihapus agar file-nya tidak dianggap masih dipakai
+	if _, err = tx.ExecContext(ctx, `DELETE FROM product_image WHERE product_id = $1`, productId); err != nil {
+		return fmt.Errorf("failed to delete product images: %w", err)
+	}
+	return tx.Commit()
 }
 
-func (r *productRepository) ListProducts(ctx context.Context, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Product, int32, error) {
+func (r *productRepository) ListProducts(ctx context.Context, filter *entity.ProductFilter, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Product, int32, error) {
 	// 1. Hitung OFFSET
 	offset := (page - 1) * limit
 
+	// Endpoint publik tidak pernah menampilkan produk yang sudah dihapus
+	notDeleted := false
+	publicFilter := *filter
+	publicFilter.IsDeleted = &notDeleted
+	whereClause, args := buildProductWhere(&publicFilter).Build()
+
 	// 2. Query untuk Menghitung Total Elemen
 	var totalElements int32
-	countQuery := `SELECT COUNT(id) FROM "product" WHERE is_deleted = FALSE`
-	if err := r.db.QueryRowContext(ctx, countQuery).Scan(&totalElements); err != nil {
+	countQuery := `SELECT COUNT(id) FROM "product" ` + whereClause
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalElements); err != nil {
 		log.Printf("Error counting products: %v", err)
 		return nil, 0, fmt.Errorf("failed to get total product count: %w", err)
 	}
@@ -87,6 +185,7 @@ func (r *productRepository) ListProducts(ctx context.Context, page int32, limit
 		"id":         true,
 		"name":       true,
 		"price":      true,
+		"created_at": true,
 	}
 	orderByClause, err := utils.BuildOrderByClause(sort, allowedSortFields, "ORDER BY created_at DESC")
 	if err != nil {
@@ -97,14 +196,14 @@ func (r *productRepository) ListProducts(ctx context.Context, page int32, limit
 
 	// 4. Query untuk Mengambil Data Produk dengan LIMIT, OFFSET, dan ORDER BY dinamis
 	dataQuery := fmt.Sprintf(`
-		SELECT id, name, description, price, image_file_name
+		SELECT id, name, description, price_minor, currency, image_file_name
 		FROM "product" 
-		WHERE is_deleted = FALSE
+		%s
 		%s 
-		LIMIT $1 OFFSET $2
-	`, orderByClause)
+		LIMIT $%d OFFSET $%d
+	`, whereClause, orderByClause, len(args)+1, len(args)+2)
 
-	rows, err := r.db.QueryContext(ctx, dataQuery, limit, offset)
+	rows, err := r.db.QueryContext(ctx, dataQuery, append(args, limit, offset)...)
 	if err != nil {
 		log.Printf("Error querying products with pagination: %v", err)
 		return nil, 0, fmt.Errorf("failed to fetch products: %w", err)
@@ -115,7 +214,7 @@ func (r *productRepository) ListProducts(ctx context.Context, page int32, limit
 	var products []*entity.Product
 	for rows.Next() {
 		var p entity.Product
-		if err := rows.Scan(&p.Id, &p.Name, &p.Description, &p.Price, &p.ImageFileName); err != nil {
+		if err := rows.Scan(&p.Id, &p.Name, &p.Description, &p.Price.Amount, &p.Price.Currency, &p.ImageFileName); err != nil {
 			log.Printf("Error scanning product row: %v", err)
 			return nil, 0, fmt.Errorf("failed to scan product: %w", err)
 		}
@@ -130,14 +229,16 @@ func (r *productRepository) ListProducts(ctx context.Context, page int32, limit
 	return products, totalElements, nil
 }
 
-func (r *productRepository) ListProductsAdmin(ctx context.Context, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Product, int32, error) {
+func (r *productRepository) ListProductsAdmin(ctx context.Context, filter *entity.ProductFilter, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Product, int32, error) {
 	// 1. Hitung OFFSET
 	offset := (page - 1) * limit
 
+	whereClause, args := buildProductWhere(filter).Build()
+
 	// 2. Query untuk Menghitung Total Elemen
 	var totalElements int32
-	countQuery := `SELECT COUNT(id) FROM "product"`
-	if err := r.db.QueryRowContext(ctx, countQuery).Scan(&totalElements); err != nil {
+	countQuery := `SELECT COUNT(id) FROM "product" ` + whereClause
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalElements); err != nil {
 		log.Printf("Error counting products: %v", err)
 		return nil, 0, fmt.Errorf("failed to get total product count: %w", err)
 	}
@@ -168,13 +269,14 @@ func (r *productRepository) ListProductsAdmin(ctx context.Context, page int32, l
 
 	// 4. Query untuk Mengambil Data Produk dengan LIMIT, OFFSET, dan ORDER BY dinamis
 	dataQuery := fmt.Sprintf(`
-		SELECT id, name, description, price, image_file_name, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted
+		SELECT id, name, description, price_minor, currency, image_file_name, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted
 		FROM "product" 
+		%s
 		%s 
-		LIMIT $1 OFFSET $2
-	`, orderByClause)
+		LIMIT $%d OFFSET $%d
+	`, whereClause, orderByClause, len(args)+1, len(args)+2)
 
-	rows, err := r.db.QueryContext(ctx, dataQuery, limit, offset)
+	rows, err := r.db.QueryContext(ctx, dataQuery, append(args, limit, offset)...)
 	if err != nil {
 		log.Printf("Error querying products with pagination: %v", err)
 		return nil, 0, fmt.Errorf("failed to fetch products: %w", err)
@@ -185,7 +287,7 @@ func (r *productRepository) ListProductsAdmin(ctx context.Context, page int32, l
 	var products []*entity.Product
 	for rows.Next() {
 		var p entity.Product
-		if err := rows.Scan(&p.Id, &p.Name, &p.Description, &p.Price, &p.ImageFileName, &p.CreatedAt, &p.CreatedBy, &p.UpdatedAt, &p.UpdatedBy, &p.DeletedAt, &p.DeletedBy, &p.IsDeleted); err != nil {
+		if err := rows.Scan(&p.Id, &p.Name, &p.Description, &p.Price.Amount, &p.Price.Currency, &p.ImageFileName, &p.CreatedAt, &p.CreatedBy, &p.UpdatedAt, &p.UpdatedBy, &p.DeletedAt, &p.DeletedBy, &p.IsDeleted); err != nil {
 			log.Printf("Error scanning product row: %v", err)
 			return nil, 0, fmt.Errorf("failed to scan product: %w", err)
 		}
@@ -209,7 +311,7 @@ func (r *productRepository) HighlightProducts(ctx context.Context) ([]*entity.Pr
     // Ini berfungsi sebagai produk 'Highlight' sementara.
 
 	query := `
-        SELECT id, name, description, price, image_file_name
+        SELECT id, name, description, price_minor, currency, image_file_name
         FROM "product" 
         WHERE is_deleted = FALSE
         ORDER BY created_at DESC 
@@ -232,7 +334,8 @@ func (r *productRepository) HighlightProducts(ctx context.Context) ([]*entity.Pr
             &p.Id, 
             &p.Name, 
             &p.Description, 
-            &p.Price, 
+            &p.Price.Amount,
+            &p.Price.Currency,
             &p.ImageFileName,
         )
         
@@ -251,6 +354,183 @@ func (r *productRepository) HighlightProducts(ctx context.Context) ([]*entity.Pr
     return products, nil
 }
 
+func (r *productRepository) SearchProducts(ctx context.Context, tsQuery string, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Product, int32, error) {
+	offset := (page - 1) * limit
+
+	var totalElements int32
+	countQuery := `SELECT COUNT(id) FROM "product" WHERE is_deleted = FALSE AND search_vector @@ to_tsquery('simple', $1)`
+	if err := r.db.QueryRowContext(ctx, countQuery, tsQuery).Scan(&totalElements); err != nil {
+		log.Printf("Error counting searched products: %v", err)
+		return nil, 0, fmt.Errorf("failed to get total product count: %w", err)
+	}
+
+	if totalElements == 0 {
+		return nil, 0, nil
+	}
+
+	// Tanpa sort eksplisit, hasil diurutkan berdasarkan relevansi (ts_rank)
+	allowedSortFields := map[string]bool{
+		"name":       true,
+		"price":      true,
+		"created_at": true,
+	}
+	orderByClause, err := utils.BuildOrderByClause(sort, allowedSortFields, "ORDER BY rank DESC, created_at DESC")
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid sort parameter: %w", err)
+	}
+
+	dataQuery := fmt.Sprintf(`
+		SELECT id, name, description, price_minor, currency, image_file_name, ts_rank(search_vector, to_tsquery('simple', $1)) AS rank
+		FROM "product"
+		WHERE is_deleted = FALSE AND search_vector @@ to_tsquery('simple', $1)
+		%s
+		LIMIT $2 OFFSET $3
+	`, orderByClause)
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, tsQuery, limit, offset)
+	if err != nil {
+		log.Printf("Error searching products: %v", err)
+		return nil, 0, fmt.Errorf("failed to search products: %w", err)
+	}
+	defer rows.Close()
+
+	var products []*entity.Product
+	for rows.Next() {
+		var p entity.Product
+		var rank float64
+		if err := rows.Scan(&p.Id, &p.Name, &p.Description, &p.Price.Amount, &p.Price.Currency, &p.ImageFileName, &rank); err != nil {
+			log.Printf("Error scanning searched product row: %v", err)
+			return nil, 0, fmt.Errorf("failed to scan product: %w", err)
+		}
+		products = append(products, &p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return products, totalElements, nil
+}
+
+// productFacetBucket mendefinisikan satu bucket facet. Condition berupa SQL statis (bukan input user).
+type productFacetBucket struct {
+	Key       string
+	Label     string
+	Condition string
+}
+
+var productPriceBuckets = []productFacetBucket{
+	{Key: "lt_50000", Label: "Under 50,000", Condition: "price < 50000"},
+	{Key: "50000_100000", Label: "50,000 - 100,000", Condition: "price >= 50000 AND price < 100000"},
+	{Key: "100000_250000", Label: "100,000 - 250,000", Condition: "price >= 100000 AND price < 250000"},
+	{Key: "250000_500000", Label: "250,000 - 500,000", Condition: "price >= 250000 AND price < 500000"},
+	{Key: "500000_1000000", Label: "500,000 - 1,000,000", Condition: "price >= 500000 AND price < 1000000"},
+	{Key: "gte_1000000", Label: "1,000,000 and above", Condition: "price >= 1000000"},
+}
+
+var productCreatedAtBuckets = []productFacetBucket{
+	{Key: "last_7_days", Label: "Last 7 days", Condition: "created_at >= NOW() - INTERVAL '7 days'"},
+	{Key: "last_30_days", Label: "Last 30 days", Condition: "created_at >= NOW() - INTERVAL '30 days'"},
+	{Key: "last_90_days", Label: "Last 90 days", Condition: "created_at >= NOW() - INTERVAL '90 days'"},
+	{Key: "older_than_90_days", Label: "Older than 90 days", Condition: "created_at < NOW() - INTERVAL '90 days'"},
+}
+
+// buildProductWhere menerjemahkan ProductFilter menjadi kondisi WHERE per dimensi.
+// Dimensi baru (mis. stok) cukup ditambahkan di sini.
+func buildProductWhere(filter *entity.ProductFilter) *utils.WhereBuilder {
+	where := utils.NewWhereBuilder()
+	if filter == nil {
+		return where
+	}
+	if filter.IsDeleted != nil {
+		where.Add("is_deleted", "is_deleted = ?", *filter.IsDeleted)
+	}
+	if filter.MinPrice != nil {
+		where.Add("price", "price >= ?", *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		where.Add("price", "price <= ?", *filter.MaxPrice)
+	}
+	if filter.NameContains != "" {
+		where.Add("name", "name ILIKE ?", "%"+utils.EscapeLikePattern(filter.NameContains)+"%")
+	}
+	if filter.CreatedFrom != nil {
+		where.Add("created_at", "created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		where.Add("created_at", "created_at <= ?", *filter.CreatedTo)
+	}
+	if filter.UpdatedFrom != nil {
+		where.Add("updated_at", "updated_at >= ?", *filter.UpdatedFrom)
+	}
+	if filter.UpdatedTo != nil {
+		where.Add("updated_at", "updated_at <= ?", *filter.UpdatedTo)
+	}
+	if filter.CategoryId != "" {
+		where.Add("category", `id IN (
+			SELECT pc.product_id FROM product_category pc
+			WHERE pc.category_id IN (
+				WITH RECURSIVE subtree AS (
+					SELECT id FROM category WHERE id = ? AND is_deleted = FALSE
+					UNION ALL
+					SELECT c.id FROM category c JOIN subtree s ON c.parent_id = s.id WHERE c.is_deleted = FALSE
+				)
+				SELECT id FROM subtree
+			)
+		)`, filter.CategoryId)
+	}
+	return where
+}
+
+func (r *productRepository) GetProductFacets(ctx context.Context, filter *entity.ProductFilter) (*entity.ProductFacets, error) {
+	where := buildProductWhere(filter)
+
+	// Facet dihitung tanpa filter dimensinya sendiri, agar UI tetap bisa menampilkan pilihan lain beserta jumlahnya
+	price, err := r.countFacetBuckets(ctx, where, "price", productPriceBuckets)
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := r.countFacetBuckets(ctx, where, "created_at", productCreatedAtBuckets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.ProductFacets{
+		Price:     price,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+func (r *productRepository) countFacetBuckets(ctx context.Context, where *utils.WhereBuilder, dimension string, buckets []productFacetBucket) ([]*entity.FacetBucket, error) {
+	whereClause, args := where.Build(dimension)
+
+	selects := make([]string, 0, len(buckets))
+	for _, b := range buckets {
+		selects = append(selects, fmt.Sprintf("COUNT(id) FILTER (WHERE %s)", b.Condition))
+	}
+	query := fmt.Sprintf(`SELECT %s FROM "product" %s`, strings.Join(selects, ", "), whereClause)
+
+	counts := make([]int32, len(buckets))
+	dest := make([]any, len(buckets))
+	for i := range counts {
+		dest[i] = &counts[i]
+	}
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(dest...); err != nil {
+		log.Printf("Error counting %s facets: %v", dimension, err)
+		return nil, fmt.Errorf("failed to count %s facets: %w", dimension, err)
+	}
+
+	result := make([]*entity.FacetBucket, 0, len(buckets))
+	for i, b := range buckets {
+		result = append(result, &entity.FacetBucket{
+			Key:   b.Key,
+			Label: b.Label,
+			Count: counts[i],
+		})
+	}
+	return result, nil
+}
+
 func NewProductRepository(db *sql.DB) IProductRepository {
 	return &productRepository{db: db}
 }
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+)
+
+func TestBuildProductWhere(t *testing.T) {
+	minPrice, maxPrice, deleted := 10000.0, 50000.0, false
+	createdFrom := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter := &entity.ProductFilter{
+		IsDeleted:    &deleted,
+		MinPrice:     &minPrice,
+		MaxPrice:     &maxPrice,
+		NameContains: "50%_off",
+		CreatedFrom:  &createdFrom,
+	}
+
+	clause, args := buildProductWhere(filter).Build()
+	wantClause := "WHERE (is_deleted = $1) AND (price >= $2) AND (price <= $3) AND (name ILIKE $4) AND (created_at >= $5)"
+	wantArgs := []any{false, 10000.0, 50000.0, `%50\%\_off%`, createdFrom}
+	if clause != wantClause || !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("got %q %v, want %q %v", clause, args, wantClause, wantArgs)
+	}
+
+	clause, args = buildProductWhere(filter).Build("price")
+	wantClause = "WHERE (is_deleted = $1) AND (name ILIKE $2) AND (created_at >= $3)"
+	if clause != wantClause || len(args) != 3 {
+		t.Errorf("got %q %v without the price dimension, want %q", clause, args, wantClause)
+	}
+
+	if clause, args = buildProductWhere(nil).Build(); clause != "" || len(args) != 0 {
+		t.Errorf("got %q %v for no filter", clause, args)
+	}
+}
+
+func TestBuildProductWhereCategory(t *testing.T) {
+	clause, args := buildProductWhere(&entity.ProductFilter{CategoryId: "fashion"}).Build()
+	if !strings.HasPrefix(clause, "WHERE (id IN (") || !strings.Contains(clause, "WHERE id = $1 AND is_deleted = FALSE") {
+		t.Errorf("got %q, want a sub-tree condition on $1", clause)
+	}
+	if len(args) != 1 || args[0] != "fashion" {
+		t.Errorf("got args %v", args)
+	}
+}
@@ -0,0 +1,268 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/lib/pq"
+)
+
+type IProductVariantRepository interface {
+	// GetProductOptions retrieves the option types of a product ordered by position.
+	GetProductOptions(ctx context.Context, productID string) ([]*entity.ProductOption, error)
+	// SetProductOptions replaces the option types of a product. Options are matched by name so existing IDs are kept.
+	SetProductOptions(ctx context.Context, productID string, options []*entity.ProductOption) error
+	// ListVariantsByProductId retrieves the active variants of a product together with their option values.
+	ListVariantsByProductId(ctx context.Context, productID string) ([]*entity.ProductVariant, error)
+	// GetVariantById retrieves an active variant together with its option values.
+	GetVariantById(ctx context.Context, id string) (*entity.ProductVariant, error)
+	// HasVariants reports whether a product has at least one active variant.
+	HasVariants(ctx context.Context, productID string) (bool, error)
+	// IsSkuTaken reports whether an active variant other than excludeID already uses the SKU.
+	IsSkuTaken(ctx context.Context, sku string, excludeID string) (bool, error)
+	CreateVariant(ctx context.Context, variant *entity.ProductVariant) error
+	// UpdateVariant updates a variant and replaces its option values. Stock is managed through the inventory repository.
+	UpdateVariant(ctx context.Context, variant *entity.ProductVariant) error
+	DeleteVariant(ctx context.Context, deletedAt time.Time, deletedBy string, id string) error
+}
+
+type productVariantRepository struct {
+	db *sql.DB
+}
+
+// NewProductVariantRepository creates a new instance of IProductVariantRepository.
+func NewProductVariantRepository(db *sql.DB) IProductVariantRepository {
+	return &productVariantRepository{db: db}
+}
+
+const productVariantColumns = `id, product_id, sku, name, price_minor, currency, image_file_name, stock, created_at, created_by, updated_at, updated_by`
+
+func scanProductVariant(row interface{ Scan(dest ...any) error }) (*entity.ProductVariant, error) {
+	var v entity.ProductVariant
+	var priceAmount sql.NullInt64
+	var priceCurrency string
+	err := row.Scan(&v.Id, &v.ProductId, &v.Sku, &v.Name, &priceAmount, &priceCurrency, &v.ImageFileName, &v.Stock, &v.CreatedAt, &v.CreatedBy, &v.UpdatedAt, &v.UpdatedBy)
+	if err != nil {
+		return nil, err
+	}
+	if priceAmount.Valid {
+		price := entity.NewMoney(priceAmount.Int64, priceCurrency)
+		v.Price = &price
+	}
+	return &v, nil
+}
+
+// variantPriceColumns returns the price_minor and currency values of a variant. price_minor is NULL when the variant
+// uses the product price.
+func variantPriceColumns(v *entity.ProductVariant) (sql.NullInt64, string) {
+	if v.Price == nil {
+		return sql.NullInt64{}, entity.DefaultCurrency
+	}
+	return sql.NullInt64{Int64: v.Price.Amount, Valid: true}, v.Price.Currency
+}
+
+func (r *productVariantRepository) GetProductOptions(ctx context.Context, productID string) ([]*entity.ProductOption, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, product_id, name, position, option_values
+		FROM product_option WHERE product_id = $1 ORDER BY position`, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	options := make([]*entity.ProductOption, 0)
+	for rows.Next() {
+		var o entity.ProductOption
+		if err := rows.Scan(&o.Id, &o.ProductId, &o.Name, &o.Position, pq.Array(&o.Values)); err != nil {
+			return nil, err
+		}
+		options = append(options, &o)
+	}
+	return options, rows.Err()
+}
+
+func (r *productVariantRepository) SetProductOptions(ctx context.Context, productID string, options []*entity.ProductOption) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin product option transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	names := make([]string, 0, len(options))
+	for _, o := range options {
+		names = append(names, o.Name)
+		_, err = tx.ExecContext(ctx, `INSERT INTO product_option (id, product_id, name, position, option_values)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (product_id, name) DO UPDATE SET position = EXCLUDED.position, option_values = EXCLUDED.option_values`,
+			o.Id, productID, o.Name, o.Position, pq.Array(o.Values))
+		if err != nil {
+			return fmt.Errorf("failed to save product option: %w", err)
+		}
+	}
+
+	// Opsi yang tidak lagi dikirim dihapus; nilai opsi varian ikut terhapus lewat ON DELETE CASCADE
+	_, err = tx.ExecContext(ctx, `DELETE FROM product_option WHERE product_id = $1 AND NOT (name = ANY($2))`, productID, pq.Array(names))
+	if err != nil {
+		return fmt.Errorf("failed to remove product options: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *productVariantRepository) ListVariantsByProductId(ctx context.Context, productID string) ([]*entity.ProductVariant, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+productVariantColumns+`
+		FROM product_variant WHERE product_id = $1 AND is_deleted = FALSE ORDER BY created_at`, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	variants := make([]*entity.ProductVariant, 0)
+	byID := make(map[string]*entity.ProductVariant)
+	for rows.Next() {
+		v, err := scanProductVariant(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		variants = append(variants, v)
+		byID[v.Id] = v
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(variants) == 0 {
+		return variants, nil
+	}
+
+	ids := make([]string, 0, len(variants))
+	for _, v := range variants {
+		ids = append(ids, v.Id)
+	}
+	options, err := r.getVariantOptions(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for variantID, opts := range options {
+		if v, ok := byID[variantID]; ok {
+			v.Options = opts
+		}
+	}
+	return variants, nil
+}
+
+func (r *productVariantRepository) GetVariantById(ctx context.Context, id string) (*entity.ProductVariant, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+productVariantColumns+` FROM product_variant WHERE id = $1 AND is_deleted = FALSE`, id)
+	v, err := scanProductVariant(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	options, err := r.getVariantOptions(ctx, []string{v.Id})
+	if err != nil {
+		return nil, err
+	}
+	v.Options = options[v.Id]
+	return v, nil
+}
+
+func (r *productVariantRepository) HasVariants(ctx context.Context, productID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM product_variant WHERE product_id = $1 AND is_deleted = FALSE)`, productID).Scan(&exists)
+	return exists, err
+}
+
+func (r *productVariantRepository) IsSkuTaken(ctx context.Context, sku string, excludeID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM product_variant WHERE sku = $1 AND id <> $2 AND is_deleted = FALSE)`, sku, excludeID).Scan(&exists)
+	return exists, err
+}
+
+func (r *productVariantRepository) CreateVariant(ctx context.Context, variant *entity.ProductVariant) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin product variant transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	priceAmount, priceCurrency := variantPriceColumns(variant)
+	_, err = tx.ExecContext(ctx, `INSERT INTO product_variant (id, product_id, sku, name, price_minor, currency, image_file_name, stock, created_at, created_by, is_deleted)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, FALSE)`,
+		variant.Id, variant.ProductId, variant.Sku, variant.Name, priceAmount, priceCurrency, variant.ImageFileName, variant.Stock, variant.CreatedAt, variant.CreatedBy)
+	if err != nil {
+		return fmt.Errorf("failed to insert product variant: %w", err)
+	}
+	if err = replaceVariantOptions(ctx, tx, variant); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *productVariantRepository) UpdateVariant(ctx context.Context, variant *entity.ProductVariant) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin product variant transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	priceAmount, priceCurrency := variantPriceColumns(variant)
+	_, err = tx.ExecContext(ctx, `UPDATE product_variant SET sku = $1, name = $2, price_minor = $3, currency = $4, image_file_name = $5, updated_at = $6, updated_by = $7 WHERE id = $8`,
+		variant.Sku, variant.Name, priceAmount, priceCurrency, variant.ImageFileName, variant.UpdatedAt, variant.UpdatedBy, variant.Id)
+	if err != nil {
+		return fmt.Errorf("failed to update product variant: %w", err)
+	}
+	if err = replaceVariantOptions(ctx, tx, variant); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *productVariantRepository) DeleteVariant(ctx context.Context, deletedAt time.Time, deletedBy string, id string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE product_variant SET is_deleted = TRUE, deleted_at = $1, deleted_by = $2 WHERE id = $3`, deletedAt, deletedBy, id)
+	return err
+}
+
+// getVariantOptions retrieves the option values of the given variants, keyed by variant ID and ordered by option position.
+func (r *productVariantRepository) getVariantOptions(ctx context.Context, variantIDs []string) (map[string][]*entity.ProductVariantOption, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT vo.variant_id, vo.option_id, o.name, vo.value
+		FROM product_variant_option vo
+		JOIN product_option o ON o.id = vo.option_id
+		WHERE vo.variant_id = ANY($1)
+		ORDER BY o.position`, pq.Array(variantIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	options := make(map[string][]*entity.ProductVariantOption)
+	for rows.Next() {
+		var variantID string
+		var o entity.ProductVariantOption
+		if err := rows.Scan(&variantID, &o.OptionId, &o.Name, &o.Value); err != nil {
+			return nil, err
+		}
+		options[variantID] = append(options[variantID], &o)
+	}
+	return options, rows.Err()
+}
+
+func replaceVariantOptions(ctx context.Context, tx *sql.Tx, variant *entity.ProductVariant) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_variant_option WHERE variant_id = $1`, variant.Id); err != nil {
+		return fmt.Errorf("failed to clear variant options: %w", err)
+	}
+	for _, o := range variant.Options {
+		_, err := tx.ExecContext(ctx, `INSERT INTO product_variant_option (variant_id, option_id, value) VALUES ($1, $2, $3)`,
+			variant.Id, o.OptionId, o.Value)
+		if err != nil {
+			return fmt.Errorf("failed to insert variant option: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type IRateLimitRepository interface {
+	// GetLockedUntil returns the latest lockout among keys that is still in effect at now, or nil when none is locked.
+	GetLockedUntil(ctx context.Context, keys []string, now time.Time) (*time.Time, error)
+	// RecordHit increments the hit counter of key and returns the new count.
+	// The counter restarts when the previous hit is older than window.
+	RecordHit(ctx context.Context, key string, now time.Time, window time.Duration) (int, error)
+	LockKey(ctx context.Context, key string, lockedUntil time.Time) error
+	// ClearKey forgets the hits and lockout of key.
+	ClearKey(ctx context.Context, key string) error
+	// PurgeExpiredRateLimits deletes keys whose counting window and lockout ended before now.
+	PurgeExpiredRateLimits(ctx context.Context, now time.Time) (int64, error)
+}
+
+type rateLimitRepository struct {
+	db *sql.DB
+}
+
+// NewRateLimitRepository creates a new instance of IRateLimitRepository.
+func NewRateLimitRepository(db *sql.DB) IRateLimitRepository {
+	return &rateLimitRepository{db: db}
+}
+
+func (r *rateLimitRepository) GetLockedUntil(ctx context.Context, keys []string, now time.Time) (*time.Time, error) {
+	var lockedUntil sql.NullTime
+	err := r.db.QueryRowContext(ctx, `SELECT MAX(locked_until) FROM rate_limit WHERE limit_key = ANY($1) AND locked_until > $2`,
+		pq.Array(keys), now).Scan(&lockedUntil)
+	if err != nil {
+		return nil, err
+	}
+	if !lockedUntil.Valid {
+		return nil, nil
+	}
+	return &lockedUntil.Time, nil
+}
+
+func (r *rateLimitRepository) RecordHit(ctx context.Context, key string, now time.Time, window time.Duration) (int, error) {
+	var hitCount int
+	// Baris baru berlaku sampai jendela hitungan lewat; lockout yang lebih lama tetap memperpanjangnya
+	err := r.db.QueryRowContext(ctx, `INSERT INTO rate_limit (limit_key, hit_count, last_hit_at, expires_at) VALUES ($1, 1, $2, $4)
+		ON CONFLICT (limit_key) DO UPDATE SET
+			hit_count = CASE WHEN rate_limit.last_hit_at < $3 THEN 1 ELSE rate_limit.hit_count + 1 END,
+			last_hit_at = EXCLUDED.last_hit_at,
+			expires_at = GREATEST(rate_limit.expires_at, EXCLUDED.expires_at)
+		RETURNING hit_count`,
+		key, now, now.Add(-window), now.Add(window)).Scan(&hitCount)
+	return hitCount, err
+}
+
+func (r *rateLimitRepository) LockKey(ctx context.Context, key string, lockedUntil time.Time) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE rate_limit SET locked_until = $1, expires_at = GREATEST(expires_at, $1) WHERE limit_key = $2`, lockedUntil, key)
+	return err
+}
+
+func (r *rateLimitRepository) ClearKey(ctx context.Context, key string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM rate_limit WHERE limit_key = $1`, key)
+	return err
+}
+
+func (r *rateLimitRepository) PurgeExpiredRateLimits(ctx context.Context, now time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM rate_limit WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge expired rate limits: %w", err)
+	}
+	return result.RowsAffected()
+}
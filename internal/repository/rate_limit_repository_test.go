@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+func TestPurgeExpiredRateLimits(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewRateLimitRepository(db)
+	staleKey := "test-" + uuid.NewString()
+	lockedKey := "test-" + uuid.NewString()
+	recentKey := "test-" + uuid.NewString()
+	keys := []string{staleKey, lockedKey, recentKey}
+	t.Cleanup(func() { db.Exec(`DELETE FROM rate_limit WHERE limit_key = ANY($1)`, pq.Array(keys)) })
+
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	window := 15 * time.Minute
+	for _, key := range []string{staleKey, lockedKey} {
+		if _, err := repo.RecordHit(ctx, key, now.Add(-2*time.Hour), window); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Lockout yang masih berlaku menahan baris walau jendela hitungannya sudah lewat
+	if err := repo.LockKey(ctx, lockedKey, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.RecordHit(ctx, recentKey, now, window); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.PurgeExpiredRateLimits(ctx, now); err != nil {
+		t.Fatal(err)
+	}
+	remaining := map[string]bool{}
+	rows, err := db.QueryContext(ctx, `SELECT limit_key FROM rate_limit WHERE limit_key = ANY($1)`, pq.Array(keys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			t.Fatal(err)
+		}
+		remaining[key] = true
+	}
+	if remaining[staleKey] || !remaining[lockedKey] || !remaining[recentKey] {
+		t.Errorf("got remaining keys %v, want only the locked and the recent key", remaining)
+	}
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+)
+
+type IRefreshTokenRepository interface {
+	InsertRefreshToken(ctx context.Context, token *entity.RefreshToken) error
+	// GetRefreshTokenByHash retrieves a refresh token by its hash, including revoked and expired ones.
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error)
+	// RotateRefreshToken revokes oldID and inserts next in one transaction.
+	// It returns false when oldID was already revoked, i.e. the token has been used before.
+	RotateRefreshToken(ctx context.Context, oldID string, next *entity.RefreshToken) (bool, error)
+	// RevokeRefreshTokenFamily revokes every active token of a family.
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+	// RevokeUserRefreshTokens revokes every active token of a user.
+	RevokeUserRefreshTokens(ctx context.Context, userID string) error
+}
+
+type refreshTokenRepository struct {
+	db *sql.DB
+}
+
+// NewRefreshTokenRepository creates a new instance of IRefreshTokenRepository.
+func NewRefreshTokenRepository(db *sql.DB) IRefreshTokenRepository {
+	return &refreshTokenRepository{db: db}
+}
+
+func (r *refreshTokenRepository) InsertRefreshToken(ctx context.Context, token *entity.RefreshToken) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO refresh_token (id, user_id, family_id, token_hash, expires_at, created_at, mfa)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		token.Id, token.UserId, token.FamilyId, token.TokenHash, token.ExpiresAt, token.CreatedAt, token.MFA)
+	return err
+}
+
+func (r *refreshTokenRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error) {
+	var t entity.RefreshToken
+	err := r.db.QueryRowContext(ctx, `SELECT id, user_id, family_id, token_hash, expires_at, created_at, revoked_at, replaced_by_id, mfa
+		FROM refresh_token WHERE token_hash = $1`, tokenHash).
+		Scan(&t.Id, &t.UserId, &t.FamilyId, &t.TokenHash, &t.ExpiresAt, &t.CreatedAt, &t.RevokedAt, &t.ReplacedById, &t.MFA)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *refreshTokenRepository) RotateRefreshToken(ctx context.Context, oldID string, next *entity.RefreshToken) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin refresh token transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Hanya token yang belum dicabut yang boleh dirotasi; dua request paralel dengan token yang sama tidak bisa sama-sama lolos
+	result, err := tx.ExecContext(ctx, `UPDATE refresh_token SET revoked_at = $1, replaced_by_id = $2 WHERE id = $3 AND revoked_at IS NULL`,
+		next.CreatedAt, next.Id, oldID)
+	if err != nil {
+		return false, fmt.Errorf("failed to revoke refresh token: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO refresh_token (id, user_id, family_id, token_hash, expires_at, created_at, mfa)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		next.Id, next.UserId, next.FamilyId, next.TokenHash, next.ExpiresAt, next.CreatedAt, next.MFA)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert refresh token: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit refresh token rotation: %w", err)
+	}
+	return true, nil
+}
+
+func (r *refreshTokenRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE refresh_token SET revoked_at = $1 WHERE family_id = $2 AND revoked_at IS NULL`, time.Now(), familyID)
+	return err
+}
+
+func (r *refreshTokenRepository) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE refresh_token SET revoked_at = $1 WHERE user_id = $2 AND revoked_at IS NULL`, time.Now(), userID)
+	return err
+}
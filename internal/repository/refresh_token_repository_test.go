@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/google/uuid"
+)
+
+func newTestRefreshToken(userID string, familyID string, now time.Time) *entity.RefreshToken {
+	return &entity.RefreshToken{
+		Id:        "test-" + uuid.NewString(),
+		UserId:    userID,
+		FamilyId:  familyID,
+		TokenHash: uuid.NewString(),
+		ExpiresAt: now.Add(time.Hour),
+		CreatedAt: now,
+	}
+}
+
+func TestRotateRefreshTokenConcurrent(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewRefreshTokenRepository(db)
+	userID := "test-" + uuid.NewString()
+	familyID := "test-" + uuid.NewString()
+	t.Cleanup(func() { db.Exec(`DELETE FROM refresh_token WHERE family_id = $1`, familyID) })
+
+	now := time.Now()
+	old := newTestRefreshToken(userID, familyID, now)
+	if err := repo.InsertRefreshToken(context.Background(), old); err != nil {
+		t.Fatal(err)
+	}
+
+	const rotations = 8
+	var wg sync.WaitGroup
+	results := make([]bool, rotations)
+	errs := make([]error, rotations)
+	for i := 0; i < rotations; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = repo.RotateRefreshToken(context.Background(), old.Id, newTestRefreshToken(userID, familyID, now))
+		}(i)
+	}
+	wg.Wait()
+
+	rotated := 0
+	for i := range results {
+		if errs[i] != nil {
+			t.Fatalf("RotateRefreshToken: %v", errs[i])
+		}
+		if results[i] {
+			rotated++
+		}
+	}
+	if rotated != 1 {
+		t.Fatalf("got %d successful rotations of the same token, want 1", rotated)
+	}
+
+	var tokens int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM refresh_token WHERE family_id = $1`, familyID).Scan(&tokens); err != nil {
+		t.Fatal(err)
+	}
+	if tokens != 2 {
+		t.Errorf("got %d tokens in the family, want the old token and one successor", tokens)
+	}
+	stored, err := repo.GetRefreshTokenByHash(context.Background(), old.TokenHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.RevokedAt == nil || stored.ReplacedById == nil {
+		t.Errorf("got %+v, want the old token revoked and replaced", stored)
+	}
+}
+
+func TestRevokeRefreshTokenFamily(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewRefreshTokenRepository(db)
+	userID := "test-" + uuid.NewString()
+	familyID := "test-" + uuid.NewString()
+	otherFamilyID := "test-" + uuid.NewString()
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM refresh_token WHERE family_id IN ($1, $2)`, familyID, otherFamilyID)
+	})
+
+	now := time.Now()
+	tokens := []*entity.RefreshToken{
+		newTestRefreshToken(userID, familyID, now),
+		newTestRefreshToken(userID, familyID, now),
+		newTestRefreshToken(userID, otherFamilyID, now),
+	}
+	for _, token := range tokens {
+		if err := repo.InsertRefreshToken(context.Background(), token); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := repo.RevokeRefreshTokenFamily(context.Background(), familyID); err != nil {
+		t.Fatal(err)
+	}
+	for i, token := range tokens {
+		stored, err := repo.GetRefreshTokenByHash(context.Background(), token.TokenHash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if revoked, want := stored.RevokedAt != nil, token.FamilyId == familyID; revoked != want {
+			t.Errorf("token %d: got revoked %v, want %v", i, revoked, want)
+		}
+	}
+}
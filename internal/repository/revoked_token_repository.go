@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type IRevokedTokenRepository interface {
+	// RevokeToken records an access token ID as revoked until expiresAt.
+	RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error
+	// IsTokenRevoked reports whether an unexpired revocation exists for the token ID.
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+	// RevokeUserTokens records that every token of the user issued before revokedBefore is revoked.
+	// The entry is kept until expiresAt, after which no such token can still be valid.
+	RevokeUserTokens(ctx context.Context, userID string, revokedBefore time.Time, expiresAt time.Time) error
+	// GetUserRevokedBefore returns the active revocation cut-off of a user, or nil when there is none.
+	GetUserRevokedBefore(ctx context.Context, userID string) (*time.Time, error)
+	// PurgeExpiredTokens deletes revocations whose token has already expired and returns how many were removed.
+	PurgeExpiredTokens(ctx context.Context, now time.Time) (int64, error)
+}
+
+type revokedTokenRepository struct {
+	db *sql.DB
+}
+
+// NewRevokedTokenRepository creates a new instance of IRevokedTokenRepository.
+func NewRevokedTokenRepository(db *sql.DB) IRevokedTokenRepository {
+	return &revokedTokenRepository{db: db}
+}
+
+func (r *revokedTokenRepository) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO revoked_token (jti, expires_at, revoked_at) VALUES ($1, $2, $3)
+		ON CONFLICT (jti) DO NOTHING`, jti, expiresAt, time.Now())
+	return err
+}
+
+func (r *revokedTokenRepository) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM revoked_token WHERE jti = $1 AND expires_at > $2)`, jti, time.Now()).Scan(&exists)
+	return exists, err
+}
+
+func (r *revokedTokenRepository) RevokeUserTokens(ctx context.Context, userID string, revokedBefore time.Time, expiresAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO revoked_user_token (user_id, revoked_before, expires_at) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET revoked_before = GREATEST(revoked_user_token.revoked_before, EXCLUDED.revoked_before),
+			expires_at = GREATEST(revoked_user_token.expires_at, EXCLUDED.expires_at)`,
+		userID, revokedBefore, expiresAt)
+	return err
+}
+
+func (r *revokedTokenRepository) GetUserRevokedBefore(ctx context.Context, userID string) (*time.Time, error) {
+	var revokedBefore time.Time
+	err := r.db.QueryRowContext(ctx, `SELECT revoked_before FROM revoked_user_token WHERE user_id = $1 AND expires_at > $2`, userID, time.Now()).Scan(&revokedBefore)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &revokedBefore, nil
+}
+
+func (r *revokedTokenRepository) PurgeExpiredTokens(ctx context.Context, now time.Time) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM revoked_token WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, err
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	result, err = tx.ExecContext(ctx, `DELETE FROM revoked_user_token WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, err
+	}
+	purgedUsers, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return purged + purgedUsers, tx.Commit()
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestRevokedTokenRepository(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewRevokedTokenRepository(db)
+	activeJTI := "test-" + uuid.NewString()
+	expiredJTI := "test-" + uuid.NewString()
+	userID := "test-" + uuid.NewString()
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM revoked_token WHERE jti IN ($1, $2)`, activeJTI, expiredJTI)
+		db.Exec(`DELETE FROM revoked_user_token WHERE user_id = $1`, userID)
+	})
+
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	if err := repo.RevokeToken(ctx, activeJTI, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	// Revoke dua kali untuk jti yang sama tidak boleh gagal
+	if err := repo.RevokeToken(ctx, activeJTI, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.RevokeToken(ctx, expiredJTI, now.Add(-time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	for jti, want := range map[string]bool{activeJTI: true, expiredJTI: false} {
+		revoked, err := repo.IsTokenRevoked(ctx, jti)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if revoked != want {
+			t.Errorf("%s: got revoked %v, want %v", jti, revoked, want)
+		}
+	}
+
+	// Cut-off yang lebih lama tidak menimpa cut-off yang lebih baru
+	if err := repo.RevokeUserTokens(ctx, userID, now, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.RevokeUserTokens(ctx, userID, now.Add(-time.Minute), now.Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	revokedBefore, err := repo.GetUserRevokedBefore(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revokedBefore == nil || !revokedBefore.Equal(now) {
+		t.Errorf("got cut-off %v, want %v", revokedBefore, now)
+	}
+
+	if _, err = repo.PurgeExpiredTokens(ctx, now); err != nil {
+		t.Fatal(err)
+	}
+	var remaining int
+	if err = db.QueryRow(`SELECT COUNT(*) FROM revoked_token WHERE jti IN ($1, $2)`, activeJTI, expiredJTI).Scan(&remaining); err != nil {
+		t.Fatal(err)
+	}
+	if remaining != 1 {
+		t.Errorf("got %d revoked tokens after the purge, want only the unexpired one", remaining)
+	}
+}
@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/lib/pq"
+)
+
+type IRoleRepository interface {
+	ListPermissions(ctx context.Context) ([]*entity.Permission, error)
+	// CountExistingPermissions returns how many of codes are known permissions.
+	CountExistingPermissions(ctx context.Context, codes []string) (int, error)
+	// ListRoles retrieves the active roles together with their permissions.
+	ListRoles(ctx context.Context) ([]*entity.UserRole, error)
+	// GetRoleByCode retrieves an active role together with its permissions.
+	GetRoleByCode(ctx context.Context, code string) (*entity.UserRole, error)
+	// GetRolePermissions retrieves the permission codes granted to a role.
+	GetRolePermissions(ctx context.Context, roleCode string) ([]string, error)
+	CreateRole(ctx context.Context, role *entity.UserRole) error
+	// UpdateRole renames a role and replaces its permissions.
+	UpdateRole(ctx context.Context, role *entity.UserRole) error
+	DeleteRole(ctx context.Context, deletedAt time.Time, deletedBy string, code string) error
+	// CountUsersWithRole counts active users that have the role.
+	CountUsersWithRole(ctx context.Context, roleCode string) (int, error)
+	AssignUserRole(ctx context.Context, userID string, roleCode string, updatedBy string) error
+}
+
+type roleRepository struct {
+	db *sql.DB
+}
+
+// NewRoleRepository creates a new instance of IRoleRepository.
+func NewRoleRepository(db *sql.DB) IRoleRepository {
+	return &roleRepository{db: db}
+}
+
+func (r *roleRepository) ListPermissions(ctx context.Context) ([]*entity.Permission, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT code, description FROM permission ORDER BY code`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	permissions := make([]*entity.Permission, 0)
+	for rows.Next() {
+		var p entity.Permission
+		if err := rows.Scan(&p.Code, &p.Description); err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, &p)
+	}
+	return permissions, rows.Err()
+}
+
+func (r *roleRepository) CountExistingPermissions(ctx context.Context, codes []string) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM permission WHERE code = ANY($1)`, pq.Array(codes)).Scan(&count)
+	return count, err
+}
+
+func (r *roleRepository) ListRoles(ctx context.Context) ([]*entity.UserRole, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT ur.id, ur.name, ur.code, ur.created_at,
+			COALESCE(ARRAY_AGG(rp.permission_code ORDER BY rp.permission_code) FILTER (WHERE rp.permission_code IS NOT NULL), '{}')
+		FROM user_role ur
+		LEFT JOIN role_permission rp ON rp.role_code = ur.code
+		WHERE ur.is_deleted = FALSE
+		GROUP BY ur.id, ur.name, ur.code, ur.created_at
+		ORDER BY ur.code`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := make([]*entity.UserRole, 0)
+	for rows.Next() {
+		var role entity.UserRole
+		if err := rows.Scan(&role.Id, &role.Name, &role.Code, &role.CreatedAt, pq.Array(&role.Permissions)); err != nil {
+			return nil, err
+		}
+		roles = append(roles, &role)
+	}
+	return roles, rows.Err()
+}
+
+func (r *roleRepository) GetRoleByCode(ctx context.Context, code string) (*entity.UserRole, error) {
+	var role entity.UserRole
+	err := r.db.QueryRowContext(ctx, `SELECT id, name, code, created_at FROM user_role WHERE code = $1 AND is_deleted = FALSE`, code).
+		Scan(&role.Id, &role.Name, &role.Code, &role.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	role.Permissions, err = r.GetRolePermissions(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+func (r *roleRepository) GetRolePermissions(ctx context.Context, roleCode string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT permission_code FROM role_permission WHERE role_code = $1 ORDER BY permission_code`, roleCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	permissions := make([]string, 0)
+	for rows.Next() {
+		var code string
+		if err := rows.Scan(&code); err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, code)
+	}
+	return permissions, rows.Err()
+}
+
+func (r *roleRepository) CreateRole(ctx context.Context, role *entity.UserRole) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin role transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Role yang pernah dihapus (soft delete) dengan kode yang sama dihidupkan kembali
+	_, err = tx.ExecContext(ctx, `INSERT INTO user_role (id, name, code, created_at, created_by, is_deleted)
+		VALUES ($1, $2, $3, $4, $5, FALSE)
+		ON CONFLICT (code) DO UPDATE SET name = EXCLUDED.name, created_at = EXCLUDED.created_at, created_by = EXCLUDED.created_by,
+			is_deleted = FALSE, deleted_at = NULL, deleted_by = NULL`,
+		role.Id, role.Name, role.Code, role.CreatedAt, role.CreatedBy)
+	if err != nil {
+		return fmt.Errorf("failed to insert role: %w", err)
+	}
+	if err = replaceRolePermissions(ctx, tx, role.Code, role.Permissions); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *roleRepository) UpdateRole(ctx context.Context, role *entity.UserRole) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin role transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `UPDATE user_role SET name = $1, updated_at = $2, updated_by = $3 WHERE code = $4`,
+		role.Name, role.UpdatedAt, role.UpdatedBy, role.Code)
+	if err != nil {
+		return fmt.Errorf("failed to update role: %w", err)
+	}
+	if err = replaceRolePermissions(ctx, tx, role.Code, role.Permissions); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *roleRepository) DeleteRole(ctx context.Context, deletedAt time.Time, deletedBy string, code string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin role transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `UPDATE user_role SET is_deleted = TRUE, deleted_at = $1, deleted_by = $2 WHERE code = $3`, deletedAt, deletedBy, code)
+	if err != nil {
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+	if err = replaceRolePermissions(ctx, tx, code, nil); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *roleRepository) CountUsersWithRole(ctx context.Context, roleCode string) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM "user" WHERE role_code = $1 AND is_deleted = FALSE`, roleCode).Scan(&count)
+	return count, err
+}
+
+func (r *roleRepository) AssignUserRole(ctx context.Context, userID string, roleCode string, updatedBy string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE "user" SET role_code = $1, updated_at = $2, updated_by = $3 WHERE id = $4`,
+		roleCode, time.Now(), updatedBy, userID)
+	return err
+}
+
+func replaceRolePermissions(ctx context.Context, tx *sql.Tx, roleCode string, permissions []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM role_permission WHERE role_code = $1`, roleCode); err != nil {
+		return fmt.Errorf("failed to clear role permissions: %w", err)
+	}
+	for _, code := range permissions {
+		_, err := tx.ExecContext(ctx, `INSERT INTO role_permission (role_code, permission_code) VALUES ($1, $2)`, roleCode, code)
+		if err != nil {
+			return fmt.Errorf("failed to insert role permission: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+)
+
+// openTestDB connects to TEST_DATABASE_URL, a disposable database with the application schema and all migrations
+// applied, and skips the test when it is not set. Tests use fresh random ids and remove the rows they create.
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	if err = db.Ping(); err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
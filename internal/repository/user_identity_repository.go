@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+)
+
+type IUserIdentityRepository interface {
+	GetUserIdentity(ctx context.Context, provider string, subject string) (*entity.UserIdentity, error)
+	ListUserIdentities(ctx context.Context, userID string) ([]*entity.UserIdentity, error)
+	InsertUserIdentity(ctx context.Context, identity *entity.UserIdentity) error
+	// CreateUserWithIdentity inserts a new user together with its first linked identity.
+	CreateUserWithIdentity(ctx context.Context, user *entity.User, identity *entity.UserIdentity) error
+	UpdateIdentityLogin(ctx context.Context, id string, email string, loginAt time.Time) error
+	DeleteUserIdentity(ctx context.Context, userID string, provider string) error
+	// InsertLoginState saves a pending authorization request and drops expired ones.
+	InsertLoginState(ctx context.Context, state *entity.OIDCLoginState) error
+	// ConsumeLoginState deletes and returns an unexpired login state. It returns nil when the state is unknown, used or expired.
+	ConsumeLoginState(ctx context.Context, stateHash string, now time.Time) (*entity.OIDCLoginState, error)
+}
+
+type userIdentityRepository struct {
+	db *sql.DB
+}
+
+// NewUserIdentityRepository creates a new instance of IUserIdentityRepository.
+func NewUserIdentityRepository(db *sql.DB) IUserIdentityRepository {
+	return &userIdentityRepository{db: db}
+}
+
+const userIdentityColumns = `id, user_id, provider, subject, COALESCE(email, ''), created_at, last_login_at`
+
+func scanUserIdentity(row interface{ Scan(dest ...any) error }) (*entity.UserIdentity, error) {
+	var identity entity.UserIdentity
+	if err := row.Scan(&identity.Id, &identity.UserId, &identity.Provider, &identity.Subject, &identity.Email, &identity.CreatedAt, &identity.LastLoginAt); err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+func (r *userIdentityRepository) GetUserIdentity(ctx context.Context, provider string, subject string) (*entity.UserIdentity, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+userIdentityColumns+` FROM user_identity WHERE provider = $1 AND subject = $2`, provider, subject)
+	identity, err := scanUserIdentity(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return identity, nil
+}
+
+func (r *userIdentityRepository) ListUserIdentities(ctx context.Context, userID string) ([]*entity.UserIdentity, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+userIdentityColumns+` FROM user_identity WHERE user_id = $1 ORDER BY created_at`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	identities := make([]*entity.UserIdentity, 0)
+	for rows.Next() {
+		identity, err := scanUserIdentity(rows)
+		if err != nil {
+			return nil, err
+		}
+		identities = append(identities, identity)
+	}
+	return identities, rows.Err()
+}
+
+func (r *userIdentityRepository) InsertUserIdentity(ctx context.Context, identity *entity.UserIdentity) error {
+	return insertUserIdentity(ctx, r.db, identity)
+}
+
+func (r *userIdentityRepository) CreateUserWithIdentity(ctx context.Context, user *entity.User, identity *entity.UserIdentity) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin user identity transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO "user" (id, email, full_name, password, role_code, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted, email_verified, email_verified_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+		user.Id, user.Email, user.FullName, user.Password, user.RoleCode, user.CreatedAt, user.CreatedBy, user.UpdatedAt, user.UpdatedBy, user.DeletedAt, user.DeletedBy, user.IsDeleted, user.EmailVerified, user.EmailVerifiedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert user: %w", err)
+	}
+	if err = insertUserIdentity(ctx, tx, identity); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertUserIdentity(ctx context.Context, db interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}, identity *entity.UserIdentity) error {
+	_, err := db.ExecContext(ctx, `INSERT INTO user_identity (id, user_id, provider, subject, email, created_at, last_login_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		identity.Id, identity.UserId, identity.Provider, identity.Subject, identity.Email, identity.CreatedAt, identity.LastLoginAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert user identity: %w", err)
+	}
+	return nil
+}
+
+func (r *userIdentityRepository) UpdateIdentityLogin(ctx context.Context, id string, email string, loginAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE user_identity SET email = $1, last_login_at = $2 WHERE id = $3`, email, loginAt, id)
+	return err
+}
+
+func (r *userIdentityRepository) DeleteUserIdentity(ctx context.Context, userID string, provider string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM user_identity WHERE user_id = $1 AND provider = $2`, userID, provider)
+	return err
+}
+
+func (r *userIdentityRepository) InsertLoginState(ctx context.Context, state *entity.OIDCLoginState) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM oidc_login_state WHERE expires_at <= $1`, state.CreatedAt); err != nil {
+		return fmt.Errorf("failed to purge expired login states: %w", err)
+	}
+	_, err := r.db.ExecContext(ctx, `INSERT INTO oidc_login_state (state_hash, provider, nonce, code_verifier, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		state.StateHash, state.Provider, state.Nonce, state.CodeVerifier, state.ExpiresAt, state.CreatedAt)
+	return err
+}
+
+func (r *userIdentityRepository) ConsumeLoginState(ctx context.Context, stateHash string, now time.Time) (*entity.OIDCLoginState, error) {
+	// DELETE ... RETURNING membuat state hanya bisa dipakai sekali
+	var state entity.OIDCLoginState
+	err := r.db.QueryRowContext(ctx, `DELETE FROM oidc_login_state WHERE state_hash = $1 AND expires_at > $2
+		RETURNING state_hash, provider, nonce, code_verifier, expires_at, created_at`, stateHash, now).
+		Scan(&state.StateHash, &state.Provider, &state.Nonce, &state.CodeVerifier, &state.ExpiresAt, &state.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &state, nil
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/common"
+)
+
+// IUserRepository holds the user queries used by admin user management. Unlike IAuthRepository it also sees deleted users.
+type IUserRepository interface {
+	ListUsers(ctx context.Context, filter *entity.UserFilter, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.User, int32, error)
+	// GetUserById retrieves a user whether or not it is deleted.
+	GetUserById(ctx context.Context, id string) (*entity.User, error)
+	SoftDeleteUser(ctx context.Context, deletedAt time.Time, deletedBy string, id string) error
+	RestoreUser(ctx context.Context, updatedAt time.Time, updatedBy string, id string) error
+}
+
+type userRepository struct {
+	db *sql.DB
+}
+
+// NewUserRepository creates a new instance of IUserRepository.
+func NewUserRepository(db *sql.DB) IUserRepository {
+	return &userRepository{db: db}
+}
+
+const userAdminColumns = `id, email, full_name, role_code, email_verified, created_at, is_deleted, deleted_at, deleted_by`
+
+func scanUserAdmin(row interface{ Scan(dest ...any) error }) (*entity.User, error) {
+	var u entity.User
+	var deletedAt sql.NullTime
+	if err := row.Scan(&u.Id, &u.Email, &u.FullName, &u.RoleCode, &u.EmailVerified, &u.CreatedAt, &u.IsDeleted, &deletedAt, &u.DeletedBy); err != nil {
+		return nil, err
+	}
+	if deletedAt.Valid {
+		u.DeletedAt = deletedAt.Time
+	}
+	return &u, nil
+}
+
+func buildUserWhere(filter *entity.UserFilter) *utils.WhereBuilder {
+	where := utils.NewWhereBuilder()
+	isDeleted := false
+	if filter.IsDeleted != nil {
+		isDeleted = *filter.IsDeleted
+	}
+	where.Add("is_deleted", "is_deleted = ?", isDeleted)
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		pattern := "%" + utils.EscapeLikePattern(search) + "%"
+		where.Add("search", "(email ILIKE ? OR full_name ILIKE ?)", pattern, pattern)
+	}
+	if filter.RoleCode != "" {
+		where.Add("role_code", "role_code = ?", filter.RoleCode)
+	}
+	return where
+}
+
+func (r *userRepository) ListUsers(ctx context.Context, filter *entity.UserFilter, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.User, int32, error) {
+	offset := (page - 1) * limit
+	whereClause, args := buildUserWhere(filter).Build()
+
+	var totalElements int32
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(id) FROM "user" `+whereClause, args...).Scan(&totalElements); err != nil {
+		return nil, 0, fmt.Errorf("failed to get total user count: %w", err)
+	}
+	if totalElements == 0 {
+		return nil, 0, nil
+	}
+
+	allowedSortFields := map[string]bool{
+		"email":      true,
+		"full_name":  true,
+		"role_code":  true,
+		"created_at": true,
+		"deleted_at": true,
+	}
+	orderByClause, err := utils.BuildOrderByClause(sort, allowedSortFields, "ORDER BY created_at DESC")
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid sort parameter: %w", err)
+	}
+
+	dataQuery := fmt.Sprintf(`SELECT %s FROM "user" %s %s LIMIT $%d OFFSET $%d`,
+		userAdminColumns, whereClause, orderByClause, len(args)+1, len(args)+2)
+	rows, err := r.db.QueryContext(ctx, dataQuery, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]*entity.User, 0)
+	for rows.Next() {
+		u, err := scanUserAdmin(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, u)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return users, totalElements, nil
+}
+
+func (r *userRepository) GetUserById(ctx context.Context, id string) (*entity.User, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+userAdminColumns+` FROM "user" WHERE id = $1`, id)
+	u, err := scanUserAdmin(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return u, nil
+}
+
+func (r *userRepository) SoftDeleteUser(ctx context.Context, deletedAt time.Time, deletedBy string, id string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE "user" SET is_deleted = TRUE, deleted_at = $1, deleted_by = $2 WHERE id = $3`, deletedAt, deletedBy, id)
+	return err
+}
+
+func (r *userRepository) RestoreUser(ctx context.Context, updatedAt time.Time, updatedBy string, id string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE "user" SET is_deleted = FALSE, deleted_at = NULL, deleted_by = NULL, updated_at = $1, updated_by = $2 WHERE id = $3`,
+		updatedAt, updatedBy, id)
+	return err
+}
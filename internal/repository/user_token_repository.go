@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+)
+
+type IUserTokenRepository interface {
+	InsertUserToken(ctx context.Context, token *entity.UserToken) error
+	// ConsumeUserToken marks an unused, unexpired token as used and returns it.
+	// It returns nil when the token does not exist, was already used or has expired.
+	ConsumeUserToken(ctx context.Context, purpose string, tokenHash string, now time.Time) (*entity.UserToken, error)
+	// GetActiveUserToken returns an unused, unexpired token without consuming it, or nil.
+	GetActiveUserToken(ctx context.Context, purpose string, tokenHash string, now time.Time) (*entity.UserToken, error)
+	// InvalidateUserTokens marks every unused token of a user for the given purpose as used.
+	InvalidateUserTokens(ctx context.Context, userID string, purpose string) error
+	// CountUserTokensSince counts the tokens issued to a user for the given purpose since the given time, used or not.
+	CountUserTokensSince(ctx context.Context, userID string, purpose string, since time.Time) (int, error)
+	// ResetPasswordWithToken consumes a password reset token and sets the password of its user in one transaction.
+	// It returns nil when the token is not usable or its user no longer exists, in which case nothing is changed.
+	ResetPasswordWithToken(ctx context.Context, tokenHash string, newHashedPassword string, now time.Time) (*entity.UserToken, error)
+}
+
+type userTokenRepository struct {
+	db *sql.DB
+}
+
+// NewUserTokenRepository creates a new instance of IUserTokenRepository.
+func NewUserTokenRepository(db *sql.DB) IUserTokenRepository {
+	return &userTokenRepository{db: db}
+}
+
+func (r *userTokenRepository) InsertUserToken(ctx context.Context, token *entity.UserToken) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO user_token (id, user_id, purpose, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		token.Id, token.UserId, token.Purpose, token.TokenHash, token.ExpiresAt, token.CreatedAt)
+	return err
+}
+
+func (r *userTokenRepository) ConsumeUserToken(ctx context.Context, purpose string, tokenHash string, now time.Time) (*entity.UserToken, error) {
+	// UPDATE dengan syarat used_at IS NULL membuat token hanya bisa dipakai sekali walaupun dikirim bersamaan
+	var t entity.UserToken
+	err := r.db.QueryRowContext(ctx, `UPDATE user_token SET used_at = $1
+		WHERE token_hash = $2 AND purpose = $3 AND used_at IS NULL AND expires_at > $1
+		RETURNING id, user_id, purpose, token_hash, expires_at, created_at, used_at`,
+		now, tokenHash, purpose).
+		Scan(&t.Id, &t.UserId, &t.Purpose, &t.TokenHash, &t.ExpiresAt, &t.CreatedAt, &t.UsedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *userTokenRepository) GetActiveUserToken(ctx context.Context, purpose string, tokenHash string, now time.Time) (*entity.UserToken, error) {
+	var t entity.UserToken
+	err := r.db.QueryRowContext(ctx, `SELECT id, user_id, purpose, token_hash, expires_at, created_at, used_at FROM user_token
+		WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > $3`,
+		tokenHash, purpose, now).
+		Scan(&t.Id, &t.UserId, &t.Purpose, &t.TokenHash, &t.ExpiresAt, &t.CreatedAt, &t.UsedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *userTokenRepository) InvalidateUserTokens(ctx context.Context, userID string, purpose string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE user_token SET used_at = $1 WHERE user_id = $2 AND purpose = $3 AND used_at IS NULL`,
+		time.Now(), userID, purpose)
+	return err
+}
+
+func (r *userTokenRepository) CountUserTokensSince(ctx context.Context, userID string, purpose string, since time.Time) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM user_token WHERE user_id = $1 AND purpose = $2 AND created_at >= $3`,
+		userID, purpose, since).Scan(&count)
+	return count, err
+}
+
+func (r *userTokenRepository) ResetPasswordWithToken(ctx context.Context, tokenHash string, newHashedPassword string, now time.Time) (*entity.UserToken, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var t entity.UserToken
+	err = tx.QueryRowContext(ctx, `UPDATE user_token SET used_at = $1
+		WHERE token_hash = $2 AND purpose = $3 AND used_at IS NULL AND expires_at > $1
+		RETURNING id, user_id, purpose, token_hash, expires_at, created_at, used_at`,
+		now, tokenHash, entity.UserTokenPurposePasswordReset).
+		Scan(&t.Id, &t.UserId, &t.Purpose, &t.TokenHash, &t.ExpiresAt, &t.CreatedAt, &t.UsedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	result, err := tx.ExecContext(ctx, `UPDATE "user" SET password = $1, updated_at = $2, updated_by = full_name
+		WHERE id = $3 AND is_deleted = FALSE`,
+		newHashedPassword, now, t.UserId)
+	if err != nil {
+		return nil, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	// Token milik user yang sudah dihapus dibiarkan tidak terpakai
+	if affected == 0 {
+		return nil, nil
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
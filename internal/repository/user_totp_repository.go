@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/google/uuid"
+)
+
+type IUserTOTPRepository interface {
+	GetUserTOTP(ctx context.Context, userID string) (*entity.UserTOTP, error)
+	// SavePendingTOTP stores a new unconfirmed secret, replacing an earlier unconfirmed one.
+	// It returns false when the user already has a confirmed authenticator.
+	SavePendingTOTP(ctx context.Context, userID string, secretEncrypted string, now time.Time) (bool, error)
+	// ConfirmTOTP enables the pending authenticator and replaces the recovery codes in one transaction.
+	ConfirmTOTP(ctx context.Context, userID string, step int64, recoveryCodeHashes []string, now time.Time) error
+	// UseTOTPStep records step as used. It returns false when the same or a later step was already used.
+	UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error)
+	// ConsumeRecoveryCode marks an unused recovery code as used. It returns false when no such code exists.
+	ConsumeRecoveryCode(ctx context.Context, userID string, codeHash string, now time.Time) (bool, error)
+	CountUnusedRecoveryCodes(ctx context.Context, userID string) (int, error)
+	// DeleteUserTOTP removes the authenticator and the recovery codes of a user.
+	DeleteUserTOTP(ctx context.Context, userID string) error
+}
+
+type userTOTPRepository struct {
+	db *sql.DB
+}
+
+// NewUserTOTPRepository creates a new instance of IUserTOTPRepository.
+func NewUserTOTPRepository(db *sql.DB) IUserTOTPRepository {
+	return &userTOTPRepository{db: db}
+}
+
+func (r *userTOTPRepository) GetUserTOTP(ctx context.Context, userID string) (*entity.UserTOTP, error) {
+	var t entity.UserTOTP
+	err := r.db.QueryRowContext(ctx, `SELECT user_id, secret_encrypted, confirmed_at, last_used_step, created_at FROM user_totp WHERE user_id = $1`, userID).
+		Scan(&t.UserId, &t.SecretEncrypted, &t.ConfirmedAt, &t.LastUsedStep, &t.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *userTOTPRepository) SavePendingTOTP(ctx context.Context, userID string, secretEncrypted string, now time.Time) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `INSERT INTO user_totp (user_id, secret_encrypted, created_at) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET secret_encrypted = EXCLUDED.secret_encrypted, created_at = EXCLUDED.created_at, last_used_step = 0
+		WHERE user_totp.confirmed_at IS NULL`,
+		userID, secretEncrypted, now)
+	if err != nil {
+		return false, fmt.Errorf("failed to save totp secret: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *userTOTPRepository) ConfirmTOTP(ctx context.Context, userID string, step int64, recoveryCodeHashes []string, now time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin totp transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `UPDATE user_totp SET confirmed_at = $1, last_used_step = $2 WHERE user_id = $3 AND confirmed_at IS NULL`, now, step, userID)
+	if err != nil {
+		return fmt.Errorf("failed to confirm totp: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, `DELETE FROM user_recovery_code WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to clear recovery codes: %w", err)
+	}
+	for _, hash := range recoveryCodeHashes {
+		_, err = tx.ExecContext(ctx, `INSERT INTO user_recovery_code (id, user_id, code_hash, created_at) VALUES ($1, $2, $3, $4)`,
+			uuid.NewString(), userID, hash, now)
+		if err != nil {
+			return fmt.Errorf("failed to insert recovery code: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *userTOTPRepository) UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error) {
+	// Syarat last_used_step < step membuat kode yang sama tidak bisa dipakai dua kali walaupun dikirim bersamaan
+	result, err := r.db.ExecContext(ctx, `UPDATE user_totp SET last_used_step = $1 WHERE user_id = $2 AND last_used_step < $1`, step, userID)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *userTOTPRepository) ConsumeRecoveryCode(ctx context.Context, userID string, codeHash string, now time.Time) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `UPDATE user_recovery_code SET used_at = $1 WHERE user_id = $2 AND code_hash = $3 AND used_at IS NULL`,
+		now, userID, codeHash)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *userTOTPRepository) CountUnusedRecoveryCodes(ctx context.Context, userID string) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM user_recovery_code WHERE user_id = $1 AND used_at IS NULL`, userID).Scan(&count)
+	return count, err
+}
+
+func (r *userTOTPRepository) DeleteUserTOTP(ctx context.Context, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin totp transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM user_recovery_code WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, `DELETE FROM user_totp WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete totp: %w", err)
+	}
+
+	return tx.Commit()
+}
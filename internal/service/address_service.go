@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/address"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// IAddressService defines the interface for the user's address book.
+type IAddressService interface {
+	// CreateAddress adds an address to the user's address book.
+	CreateAddress(ctx context.Context, request *address.CreateAddressRequest) (*address.CreateAddressResponse, error)
+	// ListAddresses retrieves the user's addresses.
+	ListAddresses(ctx context.Context, request *address.ListAddressesRequest) (*address.ListAddressesResponse, error)
+	// UpdateAddress updates one of the user's addresses.
+	UpdateAddress(ctx context.Context, request *address.UpdateAddressRequest) (*address.UpdateAddressResponse, error)
+	// DeleteAddress removes one of the user's addresses.
+	DeleteAddress(ctx context.Context, request *address.DeleteAddressRequest) (*address.DeleteAddressResponse, error)
+	// SetDefaultAddress makes an address the user's default shipping or billing address.
+	SetDefaultAddress(ctx context.Context, request *address.SetDefaultAddressRequest) (*address.SetDefaultAddressResponse, error)
+}
+
+// AddressService implements IAddressService.
+type AddressService struct {
+	addressRepository repository.IAddressRepository
+}
+
+// NewAddressService creates a new instance of AddressService.
+func NewAddressService(addressRepository repository.IAddressRepository) IAddressService {
+	return &AddressService{
+		addressRepository: addressRepository,
+	}
+}
+
+// CreateAddress stores a new address for the authenticated user. The first address becomes the default for both types.
+func (s *AddressService) CreateAddress(ctx context.Context, request *address.CreateAddressRequest) (*address.CreateAddressResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	newAddress := &entity.Address{
+		Id:                uuid.NewString(),
+		UserId:            claims.Subject,
+		Label:             request.Label,
+		RecipientName:     request.RecipientName,
+		Phone:             request.Phone,
+		Line1:             request.Line1,
+		Line2:             request.Line2,
+		City:              request.City,
+		Region:            request.Region,
+		PostalCode:        request.PostalCode,
+		CountryCode:       request.CountryCode,
+		IsDefaultShipping: request.IsDefaultShipping,
+		IsDefaultBilling:  request.IsDefaultBilling,
+		CreatedAt:         time.Now(),
+	}
+	// Repository menjadikan alamat pertama sebagai default, aman untuk request yang bersamaan
+	if err = s.addressRepository.InsertAddress(ctx, newAddress); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &address.CreateAddressResponse{
+		Base: utils.SuccessResponse("Address created successfully"),
+		Id:   newAddress.Id,
+	}, nil
+}
+
+// ListAddresses retrieves the authenticated user's addresses, defaults first.
+func (s *AddressService) ListAddresses(ctx context.Context, request *address.ListAddressesRequest) (*address.ListAddressesResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	addresses, err := s.addressRepository.ListAddresses(ctx, claims.Subject)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	addressesData := make([]*address.Address, 0, len(addresses))
+	for _, a := range addresses {
+		addressesData = append(addressesData, toAddressResponse(a))
+	}
+
+	return &address.ListAddressesResponse{
+		Base:      utils.SuccessResponse("Addresses retrieved successfully"),
+		Addresses: addressesData,
+	}, nil
+}
+
+// UpdateAddress updates an address of the authenticated user. Orders keep the address they were placed with.
+func (s *AddressService) UpdateAddress(ctx context.Context, request *address.UpdateAddressRequest) (*address.UpdateAddressResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	now := time.Now()
+	updated, err := s.addressRepository.UpdateAddress(ctx, &entity.Address{
+		Id:            request.Id,
+		UserId:        claims.Subject,
+		Label:         request.Label,
+		RecipientName: request.RecipientName,
+		Phone:         request.Phone,
+		Line1:         request.Line1,
+		Line2:         request.Line2,
+		City:          request.City,
+		Region:        request.Region,
+		PostalCode:    request.PostalCode,
+		CountryCode:   request.CountryCode,
+		UpdatedAt:     &now,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !updated {
+		return &address.UpdateAddressResponse{
+			Base: utils.NotFoundResponse("Address not found"),
+		}, nil
+	}
+
+	return &address.UpdateAddressResponse{
+		Base: utils.SuccessResponse("Address updated successfully"),
+	}, nil
+}
+
+// DeleteAddress removes an address of the authenticated user.
+func (s *AddressService) DeleteAddress(ctx context.Context, request *address.DeleteAddressRequest) (*address.DeleteAddressResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	deleted, err := s.addressRepository.DeleteAddress(ctx, claims.Subject, request.Id, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !deleted {
+		return &address.DeleteAddressResponse{
+			Base: utils.NotFoundResponse("Address not found"),
+		}, nil
+	}
+
+	return &address.DeleteAddressResponse{
+		Base: utils.SuccessResponse("Address deleted successfully"),
+	}, nil
+}
+
+// SetDefaultAddress makes an address of the authenticated user their default shipping or billing address.
+func (s *AddressService) SetDefaultAddress(ctx context.Context, request *address.SetDefaultAddressRequest) (*address.SetDefaultAddressResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	updated, err := s.addressRepository.SetDefaultAddress(ctx, claims.Subject, request.Id, request.Type, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !updated {
+		return &address.SetDefaultAddressResponse{
+			Base: utils.NotFoundResponse("Address not found"),
+		}, nil
+	}
+
+	return &address.SetDefaultAddressResponse{
+		Base: utils.SuccessResponse("Default address updated successfully"),
+	}, nil
+}
+
+func toAddressResponse(a *entity.Address) *address.Address {
+	return &address.Address{
+		Id:                a.Id,
+		Label:             a.Label,
+		RecipientName:     a.RecipientName,
+		Phone:             a.Phone,
+		Line1:             a.Line1,
+		Line2:             a.Line2,
+		City:              a.City,
+		Region:            a.Region,
+		PostalCode:        a.PostalCode,
+		CountryCode:       a.CountryCode,
+		IsDefaultShipping: a.IsDefaultShipping,
+		IsDefaultBilling:  a.IsDefaultBilling,
+		CreatedAt:         timestamppb.New(a.CreatedAt),
+	}
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/address"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeAddressRepository implements only InsertAddress, so CreateAddress cannot read the address book first.
+type fakeAddressRepository struct {
+	repository.IAddressRepository
+
+	inserted []*entity.Address
+	err      error
+}
+
+func (r *fakeAddressRepository) InsertAddress(ctx context.Context, a *entity.Address) error {
+	if r.err != nil {
+		return r.err
+	}
+	// Seperti repository asli, alamat pertama menjadi default
+	if len(r.inserted) == 0 {
+		a.IsDefaultShipping, a.IsDefaultBilling = true, true
+	}
+	copied := *a
+	r.inserted = append(r.inserted, &copied)
+	return nil
+}
+
+func TestCreateAddressLeavesFirstDefaultToRepository(t *testing.T) {
+	repo := &fakeAddressRepository{}
+	svc := NewAddressService(repo)
+	ctx := contextWithUser("u1")
+	request := &address.CreateAddressRequest{RecipientName: "User", Phone: "0800000000", Line1: "Street 1", City: "Jakarta", PostalCode: "10110", CountryCode: "ID"}
+
+	for i := 0; i < 2; i++ {
+		res, err := svc.CreateAddress(ctx, request)
+		if err != nil || res.GetBase().GetIsError() {
+			t.Fatalf("create %d: %v / %q", i, err, res.GetBase().GetMessage())
+		}
+	}
+	if len(repo.inserted) != 2 || repo.inserted[0].UserId != "u1" {
+		t.Fatalf("got %+v", repo.inserted)
+	}
+	if second := repo.inserted[1]; second.IsDefaultShipping || second.IsDefaultBilling {
+		t.Errorf("second address was flagged as default: %+v", second)
+	}
+}
+
+func TestCreateAddressRepositoryError(t *testing.T) {
+	svc := NewAddressService(&fakeAddressRepository{err: errors.New("connection reset")})
+
+	_, err := svc.CreateAddress(contextWithUser("u1"), &address.CreateAddressRequest{})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("got %v, want Internal", err)
+	}
+}
@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"log"
-	"os"
+	"math"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
@@ -13,24 +16,93 @@ import (
 	"github.com/daiyanuthsa/grpc-ecom-be/pb/auth"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
-	gocache "github.com/patrickmn/go-cache"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 30 * 24 * time.Hour
+	EmailVerificationTokenTTL = 24 * time.Hour
+	PasswordResetTokenTTL = time.Hour
+	OIDCLoginStateTTL = 10 * time.Minute
+	// ReauthenticationMaxAge is how long after signing in a user without a password can confirm
+	// sensitive changes without entering a second factor code.
+	ReauthenticationMaxAge = 5 * time.Minute
+	// TokenEmailCooldown is the minimum time between two verification or password reset emails to the same user.
+	TokenEmailCooldown = time.Minute
+	// TokenEmailHourlyLimit caps the verification or password reset emails sent to the same user per hour.
+	TokenEmailHourlyLimit = 5
+)
+
+type AuthConfig struct {
+	// RequireVerifiedEmail menolak login untuk akun yang emailnya belum diverifikasi
+	RequireVerifiedEmail bool
+	// AppURL adalah base URL frontend yang dipakai untuk link di email
+	AppURL string
+	// TrustedProxyHops adalah jumlah reverse proxy di depan server yang menambahkan x-forwarded-for; 0 memakai alamat peer
+	TrustedProxyHops int
+	// TOTPIssuer adalah nama aplikasi yang tampil di aplikasi authenticator
+	TOTPIssuer string
+	// TOTPEncryptionKey mengenkripsi secret TOTP di database; tanpa key ini 2FA tidak bisa diaktifkan
+	TOTPEncryptionKey []byte
+}
+
+// dummyPasswordHash dibandingkan saat email tidak terdaftar supaya waktu respon sama dengan password salah
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy-password-for-timing"), 10)
+
+
 type IAuthService interface {
 	Register(ctx context.Context, request *auth.RegisterRequest) (*auth.RegisterResponse, error)
 	Login(ctx context.Context, request *auth.LoginRequest) (*auth.LoginResponse, error)
+	RefreshToken(ctx context.Context, request *auth.RefreshTokenRequest) (*auth.RefreshTokenResponse, error)
+	VerifyEmail(ctx context.Context, request *auth.VerifyEmailRequest) (*auth.VerifyEmailResponse, error)
+	ResendVerification(ctx context.Context, request *auth.ResendVerificationRequest) (*auth.ResendVerificationResponse, error)
+	RequestPasswordReset(ctx context.Context, request *auth.RequestPasswordResetRequest) (*auth.RequestPasswordResetResponse, error)
+	ResetPassword(ctx context.Context, request *auth.ResetPasswordRequest) (*auth.ResetPasswordResponse, error)
+	// UnlockAccount clears the failed login attempts and lockout of an account (requires user:manage).
+	UnlockAccount(ctx context.Context, request *auth.UnlockAccountRequest) (*auth.UnlockAccountResponse, error)
 	Logout(ctx context.Context, request *auth.LogoutRequest) (*auth.LogoutResponse, error)
 	ChangePassword(ctx context.Context, request *auth.ChangePasswordRequest) (*auth.ChangePasswordResponse, error)
 	GetProfile(ctx context.Context, request *auth.GetProfileRequest) (*auth.GetProfileResponse, error)
+	// UpdateProfile changes the full name and email of the caller. A new email must be verified again.
+	// Changing the email needs the same proof of identity as DeleteMyAccount.
+	UpdateProfile(ctx context.Context, request *auth.UpdateProfileRequest) (*auth.UpdateProfileResponse, error)
+	// DeleteMyAccount soft-deletes the caller's account, clears their cart and ends all of their sessions.
+	// The caller confirms with their password, or without one with a second factor code or a fresh sign-in.
+	DeleteMyAccount(ctx context.Context, request *auth.DeleteMyAccountRequest) (*auth.DeleteMyAccountResponse, error)
+	// GetOIDCAuthorizationURL starts a login with an OpenID Connect provider and returns the URL to send the user to.
+	GetOIDCAuthorizationURL(ctx context.Context, request *auth.GetOIDCAuthorizationURLRequest) (*auth.GetOIDCAuthorizationURLResponse, error)
+	// OIDCLogin completes a provider login, linking to or creating the user, and issues our own tokens.
+	OIDCLogin(ctx context.Context, request *auth.OIDCLoginRequest) (*auth.OIDCLoginResponse, error)
+	ListIdentities(ctx context.Context, request *auth.ListIdentitiesRequest) (*auth.ListIdentitiesResponse, error)
+	// UnlinkIdentity removes a linked provider. The last provider of an account without a password cannot be removed.
+	UnlinkIdentity(ctx context.Context, request *auth.UnlinkIdentityRequest) (*auth.UnlinkIdentityResponse, error)
+	// EnrollTOTP creates a new TOTP secret for the caller after confirming their identity like DeleteMyAccount.
+	// Two-factor authentication is enabled once ConfirmTOTP succeeds.
+	EnrollTOTP(ctx context.Context, request *auth.EnrollTOTPRequest) (*auth.EnrollTOTPResponse, error)
+	// ConfirmTOTP enables two-factor authentication with a code from the enrolled secret and returns new recovery codes.
+	// It ends every other session of the caller and returns a new one.
+	ConfirmTOTP(ctx context.Context, request *auth.ConfirmTOTPRequest) (*auth.ConfirmTOTPResponse, error)
+	DisableTOTP(ctx context.Context, request *auth.DisableTOTPRequest) (*auth.DisableTOTPResponse, error)
+	// VerifyTOTP completes a login that returned a second factor challenge and issues the tokens.
+	VerifyTOTP(ctx context.Context, request *auth.VerifyTOTPRequest) (*auth.VerifyTOTPResponse, error)
 }
 
 type authService struct {
 	authRepository repository.IAuthRepository
-	cacheService *gocache.Cache
+	refreshTokenRepository repository.IRefreshTokenRepository
+	userTokenRepository repository.IUserTokenRepository
+	userIdentityRepository repository.IUserIdentityRepository
+	userTOTPRepository repository.IUserTOTPRepository
+	guestCartRepository repository.IGuestCartRepository
+	revocationStore ITokenRevocationStore
+	mailer IMailer
+	loginThrottle *loginThrottle
+	oidcProviders map[string]*OIDCProvider
+	config AuthConfig
 }
 
 func (s *authService) Register(ctx context.Context, request *auth.RegisterRequest) (*auth.RegisterResponse, error) {
@@ -78,62 +150,346 @@ func (s *authService) Register(ctx context.Context, request *auth.RegisterReques
 		return nil, err
 	}
 
+	// Gagal kirim email tidak menggagalkan registrasi, user masih bisa ResendVerification
+	if err = s.sendVerificationEmail(ctx, &newUser); err != nil {
+		log.Printf("Failed to send verification email to user %s: %v", newUser.Id, err)
+	}
+
 	return &auth.RegisterResponse{
-		Base: utils.SuccessResponse("User registered successfully"),
+		Base: utils.SuccessResponse("User registered successfully, please check your email to verify your account"),
 	}, nil
 }
 
 func (s *authService) Login(ctx context.Context, request *auth.LoginRequest) (*auth.LoginResponse, error) {
 	// Implement your login logic here
+	now := time.Now()
+	lockedUntil, err := s.loginThrottle.lockedUntil(ctx, request.Email, now)
+	if err != nil {
+		return nil, err
+	}
+	if lockedUntil != nil {
+		return nil, status.Errorf(codes.ResourceExhausted, "Too many failed login attempts, please try again in %d seconds", int(math.Ceil(lockedUntil.Sub(now).Seconds())))
+	}
+
 	//Cek Email apakah sudah terdaftar
 	user, err := s.authRepository.GetUserByEmail(ctx, request.Email)
 	if err != nil {
 		return nil, err
 	}
 
-	//jika email tidak terdaftar, kembalikan error
+	//jika email tidak terdaftar, kembalikan error yang sama dengan password salah
 	if user == nil {
-		return &auth.LoginResponse{
-			Base: utils.BadRequestResponse("Email is not registered"),
-		}, nil
+		bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(request.Password))
+		return nil, s.failLogin(ctx, request.Email, now)
 	}
 
 	//cek password
 	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password))
 	if err != nil {
-		if err == bcrypt.ErrMismatchedHashAndPassword {
-			return nil, status.Errorf(codes.Unauthenticated, "Invalid email or password")
+		return nil, s.failLogin(ctx, request.Email, now)
+	}
+
+	totp, err := s.userTOTPRepository.GetUserTOTP(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	// Dengan 2FA, counter baru di-reset setelah VerifyTOTP; jika tidak, password yang bocor bisa dipakai menebak kode tanpa batas
+	if !totp.Enabled() {
+		if err = s.loginThrottle.reset(ctx, request.Email); err != nil {
+			return nil, err
 		}
+	}
+
+	// Dicek setelah password benar agar tidak membocorkan status akun ke orang lain
+	if s.config.RequireVerifiedEmail && !user.EmailVerified {
+		return nil, status.Errorf(codes.FailedPrecondition, "Email address is not verified")
+	}
 
+	if totp.Enabled() {
+		challengeToken, err := s.issueUserToken(ctx, user.Id, entity.UserTokenPurposeTOTPChallenge, TOTPChallengeTTL)
+		if err != nil {
+			return nil, err
+		}
 		return &auth.LoginResponse{
-			Base: utils.BadRequestResponse("Invalid email or password"),
+			Base:                 utils.SuccessResponse("Second factor required"),
+			SecondFactorRequired: true,
+			ChallengeToken:       challengeToken,
 		}, nil
 	}
 
 	//jika login berhasil, kembalikan respon sukses
-	now := time.Now()
 	// generate JWT token
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtentity.JWTClaims{
-		FullName: user.FullName,
-		Email:    user.Email,
-		RoleCode: user.RoleCode,
-		RegisteredClaims: jwt.RegisteredClaims{
-			Subject:   user.Id,
-			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour * 24)),
-			IssuedAt:  jwt.NewNumericDate(now),
-		},
-	})
-	secretKey := os.Getenv("JWT_SECRET")
-	tokenString, err := token.SignedString([]byte(secretKey))
+	tokenString, err := s.generateAccessToken(user, now, false, true)
 	if err != nil {
 		return &auth.LoginResponse{
 			Base: utils.BadRequestResponse("Failed to generate access token"),
 		}, nil
 	}
 
+	// setiap login memulai family refresh token baru
+	refreshToken, refreshTokenData, err := newRefreshToken(user.Id, uuid.NewString(), now, false)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.refreshTokenRepository.InsertRefreshToken(ctx, refreshTokenData); err != nil {
+		return nil, err
+	}
+
+	// Dengan 2FA, guest cart baru digabung setelah VerifyTOTP
+	s.mergeGuestCart(ctx, user, now)
+
 	return &auth.LoginResponse{
-		Base:        utils.SuccessResponse("Login successful"),
-		AccessToken: tokenString,
+		Base:         utils.SuccessResponse("Login successful"),
+		AccessToken:  tokenString,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+// failLogin mencatat percobaan login yang gagal dan mengembalikan error yang sama untuk email tidak terdaftar maupun password salah.
+func (s *authService) failLogin(ctx context.Context, email string, now time.Time) error {
+	if err := s.loginThrottle.recordFailure(ctx, email, now); err != nil {
+		return err
+	}
+	return status.Errorf(codes.Unauthenticated, "Invalid email or password")
+}
+
+func (s *authService) RefreshToken(ctx context.Context, request *auth.RefreshTokenRequest) (*auth.RefreshTokenResponse, error) {
+	stored, err := s.refreshTokenRepository.GetRefreshTokenByHash(ctx, utils.HashToken(request.RefreshToken))
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid refresh token")
+	}
+
+	// Token yang sudah dirotasi dipakai lagi: kemungkinan dicuri, cabut seluruh family
+	if stored.RevokedAt != nil {
+		log.Printf("WARNING: reuse of revoked refresh token %s detected, revoking family %s", stored.Id, stored.FamilyId)
+		if err = s.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, stored.FamilyId); err != nil {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid refresh token")
+	}
+
+	now := time.Now()
+	if now.After(stored.ExpiresAt) {
+		return nil, status.Errorf(codes.Unauthenticated, "Refresh token expired")
+	}
+
+	user, err := s.authRepository.GetUserById(ctx, stored.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		if err = s.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, stored.FamilyId); err != nil {
+			return nil, err
+		}
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	refreshToken, refreshTokenData, err := newRefreshToken(user.Id, stored.FamilyId, now, stored.MFA)
+	if err != nil {
+		return nil, err
+	}
+	rotated, err := s.refreshTokenRepository.RotateRefreshToken(ctx, stored.Id, refreshTokenData)
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		// Request lain sudah merotasi token ini lebih dulu; perlakukan sebagai reuse
+		log.Printf("WARNING: concurrent reuse of refresh token %s detected, revoking family %s", stored.Id, stored.FamilyId)
+		if err = s.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, stored.FamilyId); err != nil {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid refresh token")
+	}
+
+	tokenString, err := s.generateAccessToken(user, now, stored.MFA, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.RefreshTokenResponse{
+		Base:         utils.SuccessResponse("Token refreshed successfully"),
+		AccessToken:  tokenString,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+func (s *authService) VerifyEmail(ctx context.Context, request *auth.VerifyEmailRequest) (*auth.VerifyEmailResponse, error) {
+	now := time.Now()
+	token, err := s.userTokenRepository.ConsumeUserToken(ctx, entity.UserTokenPurposeEmailVerification, utils.HashToken(request.Token), now)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return &auth.VerifyEmailResponse{
+			Base: utils.BadRequestResponse("Invalid or expired verification token"),
+		}, nil
+	}
+
+	if err = s.authRepository.MarkEmailVerified(ctx, token.UserId, now); err != nil {
+		return nil, err
+	}
+
+	return &auth.VerifyEmailResponse{
+		Base: utils.SuccessResponse("Email verified successfully"),
+	}, nil
+}
+
+func (s *authService) ResendVerification(ctx context.Context, request *auth.ResendVerificationRequest) (*auth.ResendVerificationResponse, error) {
+	// Respon selalu sama supaya endpoint ini tidak bisa dipakai untuk mengecek email terdaftar
+	response := &auth.ResendVerificationResponse{
+		Base: utils.SuccessResponse("If the account exists and is not verified yet, a verification email has been sent"),
+	}
+
+	user, err := s.authRepository.GetUserByEmail(ctx, request.Email)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.EmailVerified {
+		return response, nil
+	}
+
+	// Batasi email per user agar endpoint publik ini tidak bisa dipakai untuk membanjiri inbox seseorang
+	throttled, err := s.tokenEmailThrottled(ctx, user.Id, entity.UserTokenPurposeEmailVerification, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if throttled {
+		return response, nil
+	}
+
+	// Link lama tidak berlaku lagi setelah link baru dikirim
+	if err = s.userTokenRepository.InvalidateUserTokens(ctx, user.Id, entity.UserTokenPurposeEmailVerification); err != nil {
+		return nil, err
+	}
+	if err = s.sendVerificationEmail(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (s *authService) RequestPasswordReset(ctx context.Context, request *auth.RequestPasswordResetRequest) (*auth.RequestPasswordResetResponse, error) {
+	// Respon selalu sama supaya endpoint ini tidak bisa dipakai untuk mengecek email terdaftar
+	response := &auth.RequestPasswordResetResponse{
+		Base: utils.SuccessResponse("If the email is registered, a password reset link has been sent"),
+	}
+
+	user, err := s.authRepository.GetUserByEmail(ctx, request.Email)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return response, nil
+	}
+
+	// Sama seperti ResendVerification, email reset per user dibatasi agar inbox seseorang tidak bisa dibanjiri
+	throttled, err := s.tokenEmailThrottled(ctx, user.Id, entity.UserTokenPurposePasswordReset, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if throttled {
+		return response, nil
+	}
+
+	// Hanya link reset terbaru yang berlaku
+	if err = s.userTokenRepository.InvalidateUserTokens(ctx, user.Id, entity.UserTokenPurposePasswordReset); err != nil {
+		return nil, err
+	}
+	token, err := s.issueUserToken(ctx, user.Id, entity.UserTokenPurposePasswordReset, PasswordResetTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	link := fmt.Sprintf("%s/reset-password?token=%s", s.config.AppURL, url.QueryEscape(token))
+	err = s.mailer.Send(ctx, &MailMessage{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nWe received a request to reset your password. Open the link below to choose a new one:\n\n%s\n\nThe link expires in %d minutes and can only be used once. If you did not request this, you can ignore this email.\n",
+			user.FullName, link, int(PasswordResetTokenTTL.Minutes())),
+	})
+	if err != nil {
+		// Tetap kembalikan respon yang sama, kegagalan hanya dicatat di log
+		log.Printf("Failed to send password reset email to user %s: %v", user.Id, err)
+	}
+
+	return response, nil
+}
+
+func (s *authService) ResetPassword(ctx context.Context, request *auth.ResetPasswordRequest) (*auth.ResetPasswordResponse, error) {
+	if request.NewPassword != request.NewConfirmPassword {
+		return &auth.ResetPasswordResponse{
+			Base: utils.BadRequestResponse("Password and confirm password do not match"),
+		}, nil
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(request.NewPassword), 10)
+	if err != nil {
+		return &auth.ResetPasswordResponse{
+			Base: utils.BadRequestResponse("Failed to hash password"),
+		}, nil
+	}
+
+	// Token dipakai dan password diganti dalam satu transaksi agar token tidak hangus tanpa password berubah
+	now := time.Now()
+	token, err := s.userTokenRepository.ResetPasswordWithToken(ctx, utils.HashToken(request.Token), string(hashedPassword), now)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return &auth.ResetPasswordResponse{
+			Base: utils.BadRequestResponse("Invalid or expired reset token"),
+		}, nil
+	}
+
+	user, err := s.authRepository.GetUserById(ctx, token.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return &auth.ResetPasswordResponse{
+			Base: utils.BadRequestResponse("Invalid or expired reset token"),
+		}, nil
+	}
+
+	// Semua sesi yang masih aktif harus login ulang dengan password baru
+	if err = s.refreshTokenRepository.RevokeUserRefreshTokens(ctx, user.Id); err != nil {
+		return nil, err
+	}
+	if err = s.revocationStore.RevokeUser(ctx, user.Id, now, now.Add(AccessTokenTTL)); err != nil {
+		return nil, err
+	}
+	// Pemilik email sudah terbukti, lockout akibat percobaan login gagal tidak perlu ditunggu
+	if err = s.loginThrottle.reset(ctx, user.Email); err != nil {
+		return nil, err
+	}
+
+	return &auth.ResetPasswordResponse{
+		Base: utils.SuccessResponse("Password has been reset successfully"),
+	}, nil
+}
+
+func (s *authService) UnlockAccount(ctx context.Context, request *auth.UnlockAccountRequest) (*auth.UnlockAccountResponse, error) {
+	user, err := s.authRepository.GetUserById(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return &auth.UnlockAccountResponse{
+			Base: utils.NotFoundResponse("User not found"),
+		}, nil
+	}
+
+	if err = s.loginThrottle.reset(ctx, user.Email); err != nil {
+		return nil, err
+	}
+
+	return &auth.UnlockAccountResponse{
+		Base: utils.SuccessResponse("Account unlocked successfully"),
 	}, nil
 }
 
@@ -151,8 +507,23 @@ func (s *authService) Logout(ctx context.Context, request *auth.LogoutRequest) (
 		return nil, err
 	}
 
-	//masukan token ke memory db .cache
-	s.cacheService.Set(jwtToken,"", time.Duration(tokenClaims.ExpiresAt.Time.Unix()-time.Now().Unix())*time.Second)
+	//catat token sebagai revoked sampai masa berlakunya habis
+	if err = s.revocationStore.Revoke(ctx, tokenClaims.RevocationKey(jwtToken), tokenClaims.ExpiresAt.Time); err != nil {
+		return nil, err
+	}
+
+	// cabut juga refresh token milik sesi ini jika dikirim
+	if request.RefreshToken != "" {
+		stored, err := s.refreshTokenRepository.GetRefreshTokenByHash(ctx, utils.HashToken(request.RefreshToken))
+		if err != nil {
+			return nil, err
+		}
+		if stored != nil && stored.UserId == tokenClaims.Subject {
+			if err = s.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, stored.FamilyId); err != nil {
+				return nil, err
+			}
+		}
+	}
 
 	return &auth.LogoutResponse{
 		Base: utils.SuccessResponse("Logout successful"),
@@ -182,7 +553,7 @@ func (s *authService) ChangePassword(ctx context.Context, request *auth.ChangePa
 	log.Println(claims.Email)
 
 	// Langkah 2: Dapatkan data pengguna saat ini dari database
-	user, err := s.authRepository.GetUserByEmail(ctx, claims.Email )
+	user, err := s.authRepository.GetUserById(ctx, claims.Subject)
 	if err != nil {
 		return nil, err
 	}
@@ -223,11 +594,14 @@ func (s *authService) ChangePassword(ctx context.Context, request *auth.ChangePa
 		}, nil
 	}
 
-	// Langkah 7 (Opsional tapi direkomendasikan):
-	// Blacklist token saat ini untuk memaksa pengguna login kembali dengan password baru.
-	// Ini adalah praktik keamanan yang baik.
-	// remainingDuration := time.Until(claims.ExpiresAt.Time)
-	// s.cacheService.Set(jwtToken, "blacklisted", remainingDuration)
+	// Sesi lain harus login ulang dengan password baru, termasuk access token yang belum kedaluwarsa
+	if err = s.refreshTokenRepository.RevokeUserRefreshTokens(ctx, user.Id); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err = s.revocationStore.RevokeUser(ctx, user.Id, now, now.Add(AccessTokenTTL)); err != nil {
+		return nil, err
+	}
 
 	return &auth.ChangePasswordResponse{
 		Base: utils.SuccessResponse("Password changed successfully"),
@@ -249,13 +623,17 @@ func (s *authService) GetProfile(ctx context.Context, request *auth.GetProfileRe
 		return nil, utils.UnauthenticatedResponse()
 	}
 
-	user, err := s.authRepository.GetUserByEmail(ctx, claims.Email )
+	user, err := s.authRepository.GetUserById(ctx, claims.Subject)
 	if err != nil {
 		return nil, err
 	}
 	if user == nil {
 		return nil, utils.UnauthenticatedResponse()
 	}
+	totp, err := s.userTOTPRepository.GetUserTOTP(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
 log.Println(user.CreatedAt)
 	return &auth.GetProfileResponse{
 		Base: utils.SuccessResponse("Get user profile successful"),
@@ -264,13 +642,861 @@ log.Println(user.CreatedAt)
 		Email: user.Email,
 		RoleCode: user.RoleCode,
 		MemberSince: timestamppb.New(user.CreatedAt),
+		EmailVerified: user.EmailVerified,
+		TwoFactorEnabled: totp.Enabled(),
 	}, nil
 }
 
-func NewAuthService(authRepository repository.IAuthRepository, cacheService *gocache.Cache) IAuthService {
+func (s *authService) UpdateProfile(ctx context.Context, request *auth.UpdateProfileRequest) (*auth.UpdateProfileResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
 
-	return &authService{
-		authRepository: authRepository,
-		cacheService: cacheService,
+	user, err := s.authRepository.GetUserById(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	oldEmail := user.Email
+	emailChanged := request.Email != oldEmail
+	if emailChanged {
+		// Token yang dicuri tidak boleh cukup untuk mengambil alih akun lewat ganti email + reset password
+		message, err := s.confirmIdentity(ctx, claims, user, request.CurrentPassword, request.Code, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		if message != "" {
+			return &auth.UpdateProfileResponse{
+				Base: utils.BadRequestResponse(message),
+			}, nil
+		}
+
+		existing, err := s.authRepository.GetUserByEmail(ctx, request.Email)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return &auth.UpdateProfileResponse{
+				Base: utils.BadRequestResponse("Email is already registered"),
+			}, nil
+		}
+
+		user.Email = request.Email
+		user.EmailVerified = false
+		user.EmailVerifiedAt = nil
+	}
+
+	user.FullName = request.FullName
+	user.UpdatedAt = time.Now()
+	user.UpdatedBy = &request.FullName
+	if err = s.authRepository.UpdateUserProfile(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if !emailChanged {
+		return &auth.UpdateProfileResponse{
+			Base: utils.SuccessResponse("Profile updated successfully"),
+		}, nil
+	}
+
+	// Link yang dikirim ke email lama tidak boleh dipakai untuk akun dengan email baru
+	for _, purpose := range []string{entity.UserTokenPurposeEmailVerification, entity.UserTokenPurposePasswordReset} {
+		if err = s.userTokenRepository.InvalidateUserTokens(ctx, user.Id, purpose); err != nil {
+			return nil, err
+		}
+	}
+	if err = s.sendVerificationEmail(ctx, user); err != nil {
+		log.Printf("Failed to send verification email to user %s: %v", user.Id, err)
+	}
+	err = s.mailer.Send(ctx, &MailMessage{
+		To:      oldEmail,
+		Subject: "Your email address has been changed",
+		Body: fmt.Sprintf("Hi %s,\n\nThe email address of your account has been changed to %s. If you did not make this change, please contact support immediately.\n",
+			user.FullName, user.Email),
+	})
+	if err != nil {
+		log.Printf("Failed to send email change notice to user %s: %v", user.Id, err)
+	}
+
+	return &auth.UpdateProfileResponse{
+		Base: utils.SuccessResponse("Profile updated successfully, please check your new email to verify it"),
+	}, nil
+}
+
+func (s *authService) DeleteMyAccount(ctx context.Context, request *auth.DeleteMyAccountRequest) (*auth.DeleteMyAccountResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	user, err := s.authRepository.GetUserById(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	now := time.Now()
+	message, err := s.confirmIdentity(ctx, claims, user, request.Password, request.Code, now)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &auth.DeleteMyAccountResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
+
+	if err = s.authRepository.DeleteUserAccount(ctx, user.Id, now, user.FullName, request.Anonymize); err != nil {
+		return nil, err
+	}
+
+	for _, purpose := range []string{entity.UserTokenPurposeEmailVerification, entity.UserTokenPurposePasswordReset} {
+		if err = s.userTokenRepository.InvalidateUserTokens(ctx, user.Id, purpose); err != nil {
+			return nil, err
+		}
+	}
+	if err = s.refreshTokenRepository.RevokeUserRefreshTokens(ctx, user.Id); err != nil {
+		return nil, err
+	}
+	if err = s.revocationStore.RevokeUser(ctx, user.Id, now, now.Add(AccessTokenTTL)); err != nil {
+		return nil, err
+	}
+
+	return &auth.DeleteMyAccountResponse{
+		Base: utils.SuccessResponse("Account deleted successfully"),
+	}, nil
+}
+
+func (s *authService) GetOIDCAuthorizationURL(ctx context.Context, request *auth.GetOIDCAuthorizationURLRequest) (*auth.GetOIDCAuthorizationURLResponse, error) {
+	provider, ok := s.oidcProviders[strings.ToLower(request.Provider)]
+	if !ok {
+		return &auth.GetOIDCAuthorizationURLResponse{
+			Base: utils.BadRequestResponse("Unsupported login provider"),
+		}, nil
+	}
+
+	// state melawan CSRF, nonce mengikat ID token ke request ini, code verifier untuk PKCE
+	var values [3]string
+	for i := range values {
+		value, err := utils.GenerateOpaqueToken()
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	state, nonce, codeVerifier := values[0], values[1], values[2]
+
+	authorizationURL, err := provider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = s.userIdentityRepository.InsertLoginState(ctx, &entity.OIDCLoginState{
+		StateHash:    utils.HashToken(state),
+		Provider:     provider.Name(),
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    now.Add(OIDCLoginStateTTL),
+		CreatedAt:    now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.GetOIDCAuthorizationURLResponse{
+		Base:             utils.SuccessResponse("Authorization URL created"),
+		AuthorizationUrl: authorizationURL,
+		State:            state,
+	}, nil
+}
+
+func (s *authService) OIDCLogin(ctx context.Context, request *auth.OIDCLoginRequest) (*auth.OIDCLoginResponse, error) {
+	provider, ok := s.oidcProviders[strings.ToLower(request.Provider)]
+	if !ok {
+		return &auth.OIDCLoginResponse{
+			Base: utils.BadRequestResponse("Unsupported login provider"),
+		}, nil
+	}
+
+	now := time.Now()
+	loginState, err := s.userIdentityRepository.ConsumeLoginState(ctx, utils.HashToken(request.State), now)
+	if err != nil {
+		return nil, err
+	}
+	if loginState == nil || loginState.Provider != provider.Name() {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid or expired login state")
+	}
+
+	rawIDToken, err := provider.Exchange(ctx, request.Code, loginState.CodeVerifier)
+	if err != nil {
+		log.Printf("OIDC code exchange failed: %v", err)
+		return nil, status.Errorf(codes.Unauthenticated, "Failed to sign in with %s", provider.Name())
+	}
+	identity, err := provider.VerifyIDToken(ctx, rawIDToken, loginState.Nonce)
+	if err != nil {
+		log.Printf("OIDC id token rejected: %v", err)
+		return nil, status.Errorf(codes.Unauthenticated, "Failed to sign in with %s", provider.Name())
+	}
+
+	user, isNewUser, err := s.resolveOIDCUser(ctx, provider.Name(), identity, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.config.RequireVerifiedEmail && !user.EmailVerified {
+		return nil, status.Errorf(codes.FailedPrecondition, "Email address is not verified")
+	}
+
+	totp, err := s.userTOTPRepository.GetUserTOTP(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	if totp.Enabled() {
+		challengeToken, err := s.issueUserToken(ctx, user.Id, entity.UserTokenPurposeTOTPChallenge, TOTPChallengeTTL)
+		if err != nil {
+			return nil, err
+		}
+		return &auth.OIDCLoginResponse{
+			Base:                 utils.SuccessResponse("Second factor required"),
+			IsNewUser:            isNewUser,
+			SecondFactorRequired: true,
+			ChallengeToken:       challengeToken,
+		}, nil
+	}
+
+	tokenString, refreshToken, err := s.issueSession(ctx, user, now, false)
+	if err != nil {
+		return nil, err
+	}
+	s.mergeGuestCart(ctx, user, now)
+
+	return &auth.OIDCLoginResponse{
+		Base:         utils.SuccessResponse("Login successful"),
+		AccessToken:  tokenString,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(AccessTokenTTL.Seconds()),
+		IsNewUser:    isNewUser,
+	}, nil
+}
+
+// resolveOIDCUser returns the user linked to the provider identity. An unlinked identity is linked to the user with the
+// same email when the provider verified that email, otherwise a new user is created.
+func (s *authService) resolveOIDCUser(ctx context.Context, provider string, identity *OIDCIdentity, now time.Time) (*entity.User, bool, error) {
+	linked, err := s.userIdentityRepository.GetUserIdentity(ctx, provider, identity.Subject)
+	if err != nil {
+		return nil, false, err
+	}
+	if linked != nil {
+		user, err := s.authRepository.GetUserById(ctx, linked.UserId)
+		if err != nil {
+			return nil, false, err
+		}
+		if user == nil {
+			return nil, false, status.Errorf(codes.PermissionDenied, "This account has been disabled")
+		}
+		if err = s.userIdentityRepository.UpdateIdentityLogin(ctx, linked.Id, identity.Email, now); err != nil {
+			return nil, false, err
+		}
+		return user, false, nil
+	}
+
+	if identity.Email == "" {
+		return nil, false, status.Errorf(codes.FailedPrecondition, "The login provider did not share an email address")
+	}
+
+	newIdentity := &entity.UserIdentity{
+		Id:          uuid.NewString(),
+		Provider:    provider,
+		Subject:     identity.Subject,
+		Email:       identity.Email,
+		CreatedAt:   now,
+		LastLoginAt: &now,
+	}
+
+	user, err := s.authRepository.GetUserByEmail(ctx, identity.Email)
+	if err != nil {
+		return nil, false, err
+	}
+	if user != nil {
+		// Tanpa email terverifikasi dari provider, siapa pun bisa mengaku pemilik akun ini
+		if !identity.EmailVerified {
+			return nil, false, status.Errorf(codes.AlreadyExists, "An account with this email already exists, please sign in with your password")
+		}
+		if !user.EmailVerified {
+			// Akun ini belum terbukti milik pemegang email dan bisa saja dibuat orang lain lebih dulu,
+			// jadi password dan sesi yang ada dicabut sebelum ditautkan
+			if err = s.authRepository.UpdateUserPassword(ctx, user.Id, "", user.FullName); err != nil {
+				return nil, false, err
+			}
+			if err = s.refreshTokenRepository.RevokeUserRefreshTokens(ctx, user.Id); err != nil {
+				return nil, false, err
+			}
+			if err = s.revocationStore.RevokeUser(ctx, user.Id, now, now.Add(AccessTokenTTL)); err != nil {
+				return nil, false, err
+			}
+			if err = s.authRepository.MarkEmailVerified(ctx, user.Id, now); err != nil {
+				return nil, false, err
+			}
+			user.EmailVerified = true
+		}
+		newIdentity.UserId = user.Id
+		if err = s.userIdentityRepository.InsertUserIdentity(ctx, newIdentity); err != nil {
+			return nil, false, err
+		}
+		return user, false, nil
+	}
+
+	fullName := identity.Name
+	if fullName == "" {
+		fullName, _, _ = strings.Cut(identity.Email, "@")
+	}
+	// Password kosong tidak pernah cocok dengan bcrypt; user bisa membuat password lewat RequestPasswordReset
+	user = &entity.User{
+		Id:            uuid.NewString(),
+		Email:         identity.Email,
+		FullName:      fullName,
+		RoleCode:      entity.UserRoleCustomer,
+		EmailVerified: identity.EmailVerified,
+		CreatedAt:     now,
+		CreatedBy:     &fullName,
+	}
+	if identity.EmailVerified {
+		user.EmailVerifiedAt = &now
+	}
+	newIdentity.UserId = user.Id
+	if err = s.userIdentityRepository.CreateUserWithIdentity(ctx, user, newIdentity); err != nil {
+		return nil, false, err
+	}
+
+	if !user.EmailVerified {
+		if err = s.sendVerificationEmail(ctx, user); err != nil {
+			log.Printf("Failed to send verification email to user %s: %v", user.Id, err)
+		}
+	}
+	return user, true, nil
+}
+
+func (s *authService) ListIdentities(ctx context.Context, request *auth.ListIdentitiesRequest) (*auth.ListIdentitiesResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	identities, err := s.userIdentityRepository.ListUserIdentities(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*auth.UserIdentity, 0, len(identities))
+	for _, identity := range identities {
+		item := &auth.UserIdentity{
+			Provider: identity.Provider,
+			Email:    identity.Email,
+			LinkedAt: timestamppb.New(identity.CreatedAt),
+		}
+		if identity.LastLoginAt != nil {
+			item.LastLoginAt = timestamppb.New(*identity.LastLoginAt)
+		}
+		items = append(items, item)
+	}
+
+	return &auth.ListIdentitiesResponse{
+		Base:       utils.SuccessResponse("List identities successful"),
+		Identities: items,
+	}, nil
+}
+
+func (s *authService) UnlinkIdentity(ctx context.Context, request *auth.UnlinkIdentityRequest) (*auth.UnlinkIdentityResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	user, err := s.authRepository.GetUserById(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	identities, err := s.userIdentityRepository.ListUserIdentities(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	provider := strings.ToLower(request.Provider)
+	linked := false
+	for _, identity := range identities {
+		if identity.Provider == provider {
+			linked = true
+			break
+		}
+	}
+	if !linked {
+		return &auth.UnlinkIdentityResponse{
+			Base: utils.NotFoundResponse("Login provider is not linked"),
+		}, nil
+	}
+	if user.Password == "" && len(identities) == 1 {
+		return &auth.UnlinkIdentityResponse{
+			Base: utils.BadRequestResponse("Set a password before unlinking your last login provider"),
+		}, nil
+	}
+
+	if err = s.userIdentityRepository.DeleteUserIdentity(ctx, user.Id, provider); err != nil {
+		return nil, err
+	}
+
+	return &auth.UnlinkIdentityResponse{
+		Base: utils.SuccessResponse("Login provider unlinked successfully"),
+	}, nil
+}
+
+func (s *authService) EnrollTOTP(ctx context.Context, request *auth.EnrollTOTPRequest) (*auth.EnrollTOTPResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+	if len(s.config.TOTPEncryptionKey) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Two-factor authentication is not available")
+	}
+
+	user, err := s.authRepository.GetUserById(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+	// Sesi yang dicuri tidak boleh cukup untuk mendaftarkan authenticator milik orang lain
+	message, err := s.confirmIdentity(ctx, claims, user, request.Password, "", time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &auth.EnrollTOTPResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := sealTOTPSecret(s.config.TOTPEncryptionKey, secret)
+	if err != nil {
+		return nil, err
+	}
+	saved, err := s.userTOTPRepository.SavePendingTOTP(ctx, user.Id, sealed, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !saved {
+		return &auth.EnrollTOTPResponse{
+			Base: utils.BadRequestResponse("Two-factor authentication is already enabled"),
+		}, nil
+	}
+
+	return &auth.EnrollTOTPResponse{
+		Base:       utils.SuccessResponse("Scan the code with your authenticator app and confirm it with a code"),
+		Secret:     secret,
+		OtpauthUri: utils.TOTPURI(s.config.TOTPIssuer, user.Email, secret),
+	}, nil
+}
+
+func (s *authService) ConfirmTOTP(ctx context.Context, request *auth.ConfirmTOTPRequest) (*auth.ConfirmTOTPResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	user, err := s.authRepository.GetUserById(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	totp, err := s.userTOTPRepository.GetUserTOTP(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	if totp == nil {
+		return &auth.ConfirmTOTPResponse{
+			Base: utils.BadRequestResponse("Start two-factor enrollment first"),
+		}, nil
+	}
+	if totp.Enabled() {
+		return &auth.ConfirmTOTPResponse{
+			Base: utils.BadRequestResponse("Two-factor authentication is already enabled"),
+		}, nil
+	}
+
+	now := time.Now()
+	message, err := s.confirmIdentity(ctx, claims, user, request.Password, "", now)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &auth.ConfirmTOTPResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
+
+	secret, err := openTOTPSecret(s.config.TOTPEncryptionKey, totp.SecretEncrypted)
+	if err != nil {
+		return nil, err
+	}
+	step, ok := utils.ValidateTOTP(secret, request.Code, now, totpSkewSteps)
+	if !ok {
+		return &auth.ConfirmTOTPResponse{
+			Base: utils.BadRequestResponse("Invalid authentication code"),
+		}, nil
+	}
+
+	recoveryCodes, recoveryCodeHashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err = s.userTOTPRepository.ConfirmTOTP(ctx, totp.UserId, step, recoveryCodeHashes, now); err != nil {
+		return nil, err
+	}
+
+	// Sesi lain yang mungkin dipakai penyerang dicabut; pemanggil mendapat sesi baru yang sudah melewati 2FA
+	if err = s.refreshTokenRepository.RevokeUserRefreshTokens(ctx, user.Id); err != nil {
+		return nil, err
+	}
+	if err = s.revocationStore.RevokeUser(ctx, user.Id, now, now.Add(AccessTokenTTL)); err != nil {
+		return nil, err
+	}
+	accessToken, refreshToken, err := s.issueSession(ctx, user, now, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.ConfirmTOTPResponse{
+		Base:          utils.SuccessResponse("Two-factor authentication enabled, store the recovery codes in a safe place"),
+		RecoveryCodes: recoveryCodes,
+		AccessToken:   accessToken,
+		RefreshToken:  refreshToken,
+		ExpiresIn:     int64(AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+func (s *authService) DisableTOTP(ctx context.Context, request *auth.DisableTOTPRequest) (*auth.DisableTOTPResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	user, err := s.authRepository.GetUserById(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	totp, err := s.userTOTPRepository.GetUserTOTP(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !totp.Enabled() {
+		return &auth.DisableTOTPResponse{
+			Base: utils.BadRequestResponse("Two-factor authentication is not enabled"),
+		}, nil
+	}
+
+	now := time.Now()
+	lockedUntil, err := s.loginThrottle.lockedUntil(ctx, user.Email, now)
+	if err != nil {
+		return nil, err
+	}
+	if lockedUntil != nil {
+		return nil, status.Errorf(codes.ResourceExhausted, "Too many failed attempts, please try again in %d seconds", int(math.Ceil(lockedUntil.Sub(now).Seconds())))
+	}
+
+	// Akun dari login OIDC bisa tidak punya password; kode kedua tetap wajib
+	if user.Password != "" && bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password)) != nil {
+		if err = s.loginThrottle.recordFailure(ctx, user.Email, now); err != nil {
+			return nil, err
+		}
+		return &auth.DisableTOTPResponse{
+			Base: utils.BadRequestResponse("Incorrect password"),
+		}, nil
+	}
+	ok, err := s.verifySecondFactor(ctx, totp, request.Code, now)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if err = s.loginThrottle.recordFailure(ctx, user.Email, now); err != nil {
+			return nil, err
+		}
+		return &auth.DisableTOTPResponse{
+			Base: utils.BadRequestResponse("Invalid authentication code"),
+		}, nil
+	}
+
+	if err = s.userTOTPRepository.DeleteUserTOTP(ctx, user.Id); err != nil {
+		return nil, err
+	}
+
+	return &auth.DisableTOTPResponse{
+		Base: utils.SuccessResponse("Two-factor authentication disabled"),
+	}, nil
+}
+
+func (s *authService) VerifyTOTP(ctx context.Context, request *auth.VerifyTOTPRequest) (*auth.VerifyTOTPResponse, error) {
+	now := time.Now()
+	challengeHash := utils.HashToken(request.ChallengeToken)
+	// Challenge tidak langsung dipakai habis supaya salah ketik kode tidak memaksa login ulang; tebakan dibatasi login throttle
+	challenge, err := s.userTokenRepository.GetActiveUserToken(ctx, entity.UserTokenPurposeTOTPChallenge, challengeHash, now)
+	if err != nil {
+		return nil, err
+	}
+	if challenge == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid or expired challenge, please log in again")
+	}
+
+	user, err := s.authRepository.GetUserById(ctx, challenge.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid or expired challenge, please log in again")
+	}
+
+	lockedUntil, err := s.loginThrottle.lockedUntil(ctx, user.Email, now)
+	if err != nil {
+		return nil, err
+	}
+	if lockedUntil != nil {
+		return nil, status.Errorf(codes.ResourceExhausted, "Too many failed login attempts, please try again in %d seconds", int(math.Ceil(lockedUntil.Sub(now).Seconds())))
+	}
+
+	totp, err := s.userTOTPRepository.GetUserTOTP(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !totp.Enabled() {
+		// 2FA dimatikan setelah challenge dibuat
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid or expired challenge, please log in again")
+	}
+
+	ok, err := s.verifySecondFactor(ctx, totp, request.Code, now)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if err = s.loginThrottle.recordFailure(ctx, user.Email, now); err != nil {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid authentication code")
+	}
+
+	consumed, err := s.userTokenRepository.ConsumeUserToken(ctx, entity.UserTokenPurposeTOTPChallenge, challengeHash, now)
+	if err != nil {
+		return nil, err
+	}
+	if consumed == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid or expired challenge, please log in again")
+	}
+	if err = s.loginThrottle.reset(ctx, user.Email); err != nil {
+		return nil, err
+	}
+
+	accessToken, refreshToken, err := s.issueSession(ctx, user, now, true)
+	if err != nil {
+		return nil, err
+	}
+	s.mergeGuestCart(ctx, user, now)
+
+	return &auth.VerifyTOTPResponse{
+		Base:         utils.SuccessResponse("Login successful"),
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+// generateAccessToken signs an access token. signIn is true when the user just authenticated rather than refreshed a session.
+func (s *authService) generateAccessToken(user *entity.User, now time.Time, mfa bool, signIn bool) (string, error) {
+	keySet, err := jwtentity.DefaultKeySet()
+	if err != nil {
+		return "", err
+	}
+	var amr []string
+	if mfa {
+		amr = []string{jwtentity.AuthMethodMFA}
+	}
+	var authTime *jwt.NumericDate
+	if signIn {
+		authTime = jwt.NewNumericDate(now)
+	}
+	return keySet.Sign(jwtentity.JWTClaims{
+		FullName: user.FullName,
+		Email:    user.Email,
+		RoleCode: user.RoleCode,
+		AMR:      amr,
+		AuthTime: authTime,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   user.Id,
+			ExpiresAt: jwt.NewNumericDate(now.Add(AccessTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	})
+}
+
+// newRefreshToken membuat refresh token opaque baru. Token asli hanya dikembalikan ke client, yang disimpan hanya hash-nya.
+func newRefreshToken(userID string, familyID string, now time.Time, mfa bool) (string, *entity.RefreshToken, error) {
+	token, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return "", nil, err
+	}
+	return token, &entity.RefreshToken{
+		Id:        uuid.NewString(),
+		UserId:    userID,
+		FamilyId:  familyID,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: now.Add(RefreshTokenTTL),
+		CreatedAt: now,
+		MFA:       mfa,
+	}, nil
+}
+
+// sendVerificationEmail membuat token verifikasi baru dan mengirim link-nya ke email user.
+func (s *authService) sendVerificationEmail(ctx context.Context, user *entity.User) error {
+	token, err := s.issueUserToken(ctx, user.Id, entity.UserTokenPurposeEmailVerification, EmailVerificationTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/verify-email?token=%s", s.config.AppURL, url.QueryEscape(token))
+	return s.mailer.Send(ctx, &MailMessage{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease verify your email address by opening the link below:\n\n%s\n\nThe link expires in %d hours. If you did not create an account, you can ignore this email.\n",
+			user.FullName, link, int(EmailVerificationTokenTTL.Hours())),
+	})
+}
+
+// confirmIdentity checks that the caller owns the account rather than just holding its access token.
+// Accounts with a password must enter it. Accounts created through an OIDC provider have none and enter a TOTP or
+// recovery code instead, or sign in with the provider again within ReauthenticationMaxAge.
+// It returns the message for the client when the proof is missing or wrong, or an empty string.
+func (s *authService) confirmIdentity(ctx context.Context, claims *jwtentity.JWTClaims, user *entity.User, password string, code string, now time.Time) (string, error) {
+	lockedUntil, err := s.loginThrottle.lockedUntil(ctx, user.Email, now)
+	if err != nil {
+		return "", err
+	}
+	if lockedUntil != nil {
+		return "", status.Errorf(codes.ResourceExhausted, "Too many failed attempts, please try again in %d seconds", int(math.Ceil(lockedUntil.Sub(now).Seconds())))
+	}
+
+	if user.Password != "" {
+		if password == "" {
+			return "Current password is required", nil
+		}
+		if bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)) != nil {
+			if err = s.loginThrottle.recordFailure(ctx, user.Email, now); err != nil {
+				return "", err
+			}
+			return "Incorrect password", nil
+		}
+		return "", nil
+	}
+
+	if code != "" {
+		totp, err := s.userTOTPRepository.GetUserTOTP(ctx, user.Id)
+		if err != nil {
+			return "", err
+		}
+		if !totp.Enabled() {
+			return "Two-factor authentication is not enabled, sign in again to confirm this change", nil
+		}
+		ok, err := s.verifySecondFactor(ctx, totp, code, now)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			if err = s.loginThrottle.recordFailure(ctx, user.Email, now); err != nil {
+				return "", err
+			}
+			return "Invalid authentication code", nil
+		}
+		return "", nil
+	}
+	// Token hasil refresh tidak punya auth_time, jadi sesi lama tidak cukup
+	if claims.AuthenticatedSince(now.Add(-ReauthenticationMaxAge)) {
+		return "", nil
+	}
+	return "Sign in again or enter an authentication code to confirm this change", nil
+}
+
+// tokenEmailThrottled reports whether an email with a token for purpose was sent to the user within
+// TokenEmailCooldown, or TokenEmailHourlyLimit times within the last hour.
+func (s *authService) tokenEmailThrottled(ctx context.Context, userID string, purpose string, now time.Time) (bool, error) {
+	recent, err := s.userTokenRepository.CountUserTokensSince(ctx, userID, purpose, now.Add(-TokenEmailCooldown))
+	if err != nil {
+		return false, err
+	}
+	if recent > 0 {
+		return true, nil
+	}
+	lastHour, err := s.userTokenRepository.CountUserTokensSince(ctx, userID, purpose, now.Add(-time.Hour))
+	if err != nil {
+		return false, err
+	}
+	return lastHour >= TokenEmailHourlyLimit, nil
+}
+
+// issueUserToken menyimpan hash token sekali pakai dan mengembalikan token aslinya untuk dikirim ke user.
+func (s *authService) issueUserToken(ctx context.Context, userID string, purpose string, ttl time.Duration) (string, error) {
+	token, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	err = s.userTokenRepository.InsertUserToken(ctx, &entity.UserToken{
+		Id:        uuid.NewString(),
+		UserId:    userID,
+		Purpose:   purpose,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func NewAuthService(authRepository repository.IAuthRepository, refreshTokenRepository repository.IRefreshTokenRepository, userTokenRepository repository.IUserTokenRepository, rateLimitRepository repository.IRateLimitRepository, userIdentityRepository repository.IUserIdentityRepository, userTOTPRepository repository.IUserTOTPRepository, guestCartRepository repository.IGuestCartRepository, revocationStore ITokenRevocationStore, mailer IMailer, oidcProviders map[string]*OIDCProvider, config AuthConfig) IAuthService {
+
+	return &authService{
+		authRepository: authRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		userTokenRepository: userTokenRepository,
+		userIdentityRepository: userIdentityRepository,
+		userTOTPRepository: userTOTPRepository,
+		guestCartRepository: guestCartRepository,
+		revocationStore: revocationStore,
+		mailer: mailer,
+		loginThrottle: &loginThrottle{
+			limiter: &rateLimiter{repository: rateLimitRepository},
+			trustedProxyHops: config.TrustedProxyHops,
+		},
+		oidcProviders: oidcProviders,
+		config: config,
 	}
 }
\ No newline at end of file
@@ -0,0 +1,736 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/auth"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestResendVerificationThrottle(t *testing.T) {
+	user := &entity.User{Id: "u1", Email: "user@example.com", FullName: "User"}
+	tests := []struct {
+		name       string
+		issuedAgo  []time.Duration
+		verified   bool
+		wantEmails int
+	}{
+		{name: "first resend", issuedAgo: nil, wantEmails: 1},
+		{name: "within cooldown", issuedAgo: []time.Duration{30 * time.Second}, wantEmails: 0},
+		{name: "after cooldown", issuedAgo: []time.Duration{2 * time.Minute}, wantEmails: 1},
+		{name: "hourly limit reached", issuedAgo: []time.Duration{5 * time.Minute, 10 * time.Minute, 20 * time.Minute, 30 * time.Minute, 50 * time.Minute}, wantEmails: 0},
+		{name: "hourly limit expired", issuedAgo: []time.Duration{5 * time.Minute, 10 * time.Minute, 20 * time.Minute, 30 * time.Minute, 70 * time.Minute}, wantEmails: 1},
+		{name: "already verified", verified: true, wantEmails: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := *user
+			u.EmailVerified = tt.verified
+			tokens := &fakeUserTokenRepository{}
+			now := time.Now()
+			for _, ago := range tt.issuedAgo {
+				tokens.tokens = append(tokens.tokens, &entity.UserToken{
+					UserId:    u.Id,
+					Purpose:   entity.UserTokenPurposeEmailVerification,
+					CreatedAt: now.Add(-ago),
+					ExpiresAt: now.Add(EmailVerificationTokenTTL - ago),
+				})
+			}
+			mailer := &fakeMailer{}
+			svc := &authService{authRepository: newFakeAuthRepository(&u), userTokenRepository: tokens, mailer: mailer}
+
+			res, err := svc.ResendVerification(context.Background(), &auth.ResendVerificationRequest{Email: u.Email})
+			if err != nil {
+				t.Fatalf("ResendVerification returned error: %v", err)
+			}
+			if res.GetBase().GetIsError() {
+				t.Errorf("got error response %q, want the uniform success response", res.GetBase().GetMessage())
+			}
+			if len(mailer.sent) != tt.wantEmails {
+				t.Errorf("got %d emails, want %d", len(mailer.sent), tt.wantEmails)
+			}
+		})
+	}
+}
+
+func TestResendVerificationUnknownEmail(t *testing.T) {
+	mailer := &fakeMailer{}
+	svc := &authService{authRepository: newFakeAuthRepository(), userTokenRepository: &fakeUserTokenRepository{}, mailer: mailer}
+
+	res, err := svc.ResendVerification(context.Background(), &auth.ResendVerificationRequest{Email: "nobody@example.com"})
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("got %v / %q, want the uniform success response", err, res.GetBase().GetMessage())
+	}
+	if len(mailer.sent) != 0 {
+		t.Errorf("got %d emails, want 0", len(mailer.sent))
+	}
+}
+
+func newResetPasswordTestService(user *entity.User, rawToken string, expiresAt time.Time) (*authService, *fakeAuthRepository, *fakeUserTokenRepository) {
+	users := newFakeAuthRepository(user)
+	tokens := &fakeUserTokenRepository{users: users}
+	tokens.tokens = append(tokens.tokens, &entity.UserToken{
+		Id:        "t1",
+		UserId:    user.Id,
+		Purpose:   entity.UserTokenPurposePasswordReset,
+		TokenHash: utils.HashToken(rawToken),
+		CreatedAt: time.Now(),
+		ExpiresAt: expiresAt,
+	})
+	svc := &authService{
+		authRepository:         users,
+		userTokenRepository:    tokens,
+		refreshTokenRepository: &fakeRefreshTokenRepository{},
+		revocationStore:        NewMemoryTokenRevocationStore(time.Minute),
+		loginThrottle:          &loginThrottle{limiter: &rateLimiter{repository: &fakeRateLimitRepository{}}},
+	}
+	return svc, users, tokens
+}
+
+func TestResetPassword(t *testing.T) {
+	user := &entity.User{Id: "u1", Email: "user@example.com", FullName: "User", Password: "old-hash"}
+	svc, users, tokens := newResetPasswordTestService(user, "reset-token", time.Now().Add(time.Hour))
+	request := &auth.ResetPasswordRequest{Token: "reset-token", NewPassword: "new-password", NewConfirmPassword: "new-password"}
+	// Diambil sebelum reset karena hashing bcrypt bisa lebih dari satu detik
+	issuedBefore := time.Now().Add(-time.Second)
+
+	res, err := svc.ResetPassword(context.Background(), request)
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("got %v / %q, want success", err, res.GetBase().GetMessage())
+	}
+	if bcrypt.CompareHashAndPassword([]byte(users.users["u1"].Password), []byte("new-password")) != nil {
+		t.Error("password was not updated")
+	}
+	if tokens.tokens[0].UsedAt == nil {
+		t.Error("reset token was not consumed")
+	}
+	revoked, err := svc.revocationStore.IsRevoked(context.Background(), "jti", "u1", issuedBefore)
+	if err != nil || !revoked {
+		t.Errorf("got revoked %v (%v), want earlier access tokens revoked", revoked, err)
+	}
+
+	res, err = svc.ResetPassword(context.Background(), request)
+	if err != nil || !res.GetBase().GetIsError() {
+		t.Errorf("got %v / %q, want a reused token to be rejected", err, res.GetBase().GetMessage())
+	}
+}
+
+func TestResetPasswordRejectedLeavesStateUnchanged(t *testing.T) {
+	tests := []struct {
+		name      string
+		token     string
+		expiresAt time.Time
+		deleted   bool
+	}{
+		{name: "unknown token", token: "other-token", expiresAt: time.Now().Add(time.Hour)},
+		{name: "expired token", token: "reset-token", expiresAt: time.Now().Add(-time.Minute)},
+		{name: "deleted user", token: "reset-token", expiresAt: time.Now().Add(time.Hour), deleted: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := &entity.User{Id: "u1", Email: "user@example.com", Password: "old-hash", IsDeleted: tt.deleted}
+			svc, users, tokens := newResetPasswordTestService(user, "reset-token", tt.expiresAt)
+
+			res, err := svc.ResetPassword(context.Background(), &auth.ResetPasswordRequest{Token: tt.token, NewPassword: "new-password", NewConfirmPassword: "new-password"})
+			if err != nil || !res.GetBase().GetIsError() {
+				t.Fatalf("got %v / %q, want an error response", err, res.GetBase().GetMessage())
+			}
+			if users.users["u1"].Password != "old-hash" {
+				t.Error("password changed")
+			}
+			if tokens.tokens[0].UsedAt != nil {
+				t.Error("reset token consumed")
+			}
+		})
+	}
+}
+
+func TestChangePasswordRevokesSessions(t *testing.T) {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("old-password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := &entity.User{Id: "u1", Email: "user@example.com", FullName: "User", Password: string(passwordHash)}
+	refreshTokens := &fakeRefreshTokenRepository{}
+	svc := &authService{
+		authRepository:         newFakeAuthRepository(user),
+		refreshTokenRepository: refreshTokens,
+		revocationStore:        NewMemoryTokenRevocationStore(time.Minute),
+	}
+	accessToken, err := svc.generateAccessToken(user, time.Now(), false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+accessToken))
+	// Diambil sebelum perubahan karena hashing bcrypt bisa lebih dari satu detik
+	issuedBefore := time.Now().Add(-time.Second)
+
+	res, err := svc.ChangePassword(ctx, &auth.ChangePasswordRequest{OldPassword: "old-password", NewPassword: "new-password", NewConfirmPassword: "new-password"})
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("got %v / %q, want success", err, res.GetBase().GetMessage())
+	}
+	if len(refreshTokens.revokedUsers) != 1 || refreshTokens.revokedUsers[0] != "u1" {
+		t.Errorf("got refresh tokens revoked for %v, want u1", refreshTokens.revokedUsers)
+	}
+	revoked, err := svc.revocationStore.IsRevoked(context.Background(), "jti", "u1", issuedBefore)
+	if err != nil || !revoked {
+		t.Errorf("got revoked %v (%v), want earlier access tokens revoked", revoked, err)
+	}
+}
+
+func newOIDCTestService(t *testing.T, config AuthConfig, users ...*entity.User) (*authService, *stubOIDCServer, *fakeAuthRepository, *fakeUserIdentityRepository, *fakeMailer) {
+	t.Helper()
+	server := newStubOIDCServer(t)
+	authRepository := newFakeAuthRepository(users...)
+	identities := &fakeUserIdentityRepository{users: authRepository, states: map[string]*entity.OIDCLoginState{}}
+	mailer := &fakeMailer{}
+	svc := &authService{
+		authRepository:         authRepository,
+		refreshTokenRepository: &fakeRefreshTokenRepository{},
+		userTokenRepository:    &fakeUserTokenRepository{},
+		userIdentityRepository: identities,
+		userTOTPRepository:     newFakeUserTOTPRepository(),
+		revocationStore:        NewMemoryTokenRevocationStore(time.Minute),
+		mailer:                 mailer,
+		loginThrottle:          &loginThrottle{limiter: &rateLimiter{repository: &fakeRateLimitRepository{}}},
+		oidcProviders:          map[string]*OIDCProvider{"stub": server.provider()},
+		config:                 config,
+	}
+	return svc, server, authRepository, identities, mailer
+}
+
+// startOIDCLogin starts a login and lets the stub provider return an ID token built from the login's nonce.
+func startOIDCLogin(t *testing.T, svc *authService, server *stubOIDCServer, identities *fakeUserIdentityRepository, modify func(c *oidcIDTokenClaims)) *auth.OIDCLoginRequest {
+	t.Helper()
+	res, err := svc.GetOIDCAuthorizationURL(context.Background(), &auth.GetOIDCAuthorizationURLRequest{Provider: "stub"})
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("GetOIDCAuthorizationURL: %v / %q", err, res.GetBase().GetMessage())
+	}
+	state := identities.states[utils.HashToken(res.State)]
+	claims := server.claims(state.Nonce)
+	if modify != nil {
+		modify(claims)
+	}
+	server.idToken = server.sign(t, claims, server.key)
+	return &auth.OIDCLoginRequest{Provider: "stub", State: res.State, Code: "auth-code"}
+}
+
+func TestOIDCLoginRejectsInvalidIDTokens(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *oidcIDTokenClaims)
+	}{
+		{name: "bad issuer", modify: func(c *oidcIDTokenClaims) { c.Issuer = "https://evil.example.com" }},
+		{name: "bad audience", modify: func(c *oidcIDTokenClaims) { c.Audience = jwt.ClaimStrings{"other-client"} }},
+		{name: "bad nonce", modify: func(c *oidcIDTokenClaims) { c.Nonce = "replayed-nonce" }},
+		{name: "expired", modify: func(c *oidcIDTokenClaims) {
+			c.IssuedAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+			c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-10 * time.Minute))
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, server, users, identities, _ := newOIDCTestService(t, AuthConfig{})
+			request := startOIDCLogin(t, svc, server, identities, tt.modify)
+
+			_, err := svc.OIDCLogin(context.Background(), request)
+			if status.Code(err) != codes.Unauthenticated {
+				t.Fatalf("got %v, want Unauthenticated", err)
+			}
+			if len(users.users) != 0 || len(identities.identities) != 0 {
+				t.Errorf("got %d users and %d identities, want none", len(users.users), len(identities.identities))
+			}
+		})
+	}
+}
+
+func TestOIDCLoginStateIsSingleUse(t *testing.T) {
+	svc, server, _, identities, _ := newOIDCTestService(t, AuthConfig{})
+	request := startOIDCLogin(t, svc, server, identities, nil)
+
+	if res, err := svc.OIDCLogin(context.Background(), request); err != nil || res.GetAccessToken() == "" {
+		t.Fatalf("first login: %v / %q", err, res.GetBase().GetMessage())
+	}
+	if _, err := svc.OIDCLogin(context.Background(), request); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("replayed login: got %v, want Unauthenticated", err)
+	}
+}
+
+func TestOIDCLoginUnverifiedEmail(t *testing.T) {
+	unverified := func(c *oidcIDTokenClaims) { c.EmailVerified = false }
+
+	t.Run("creates an unverified user", func(t *testing.T) {
+		svc, server, users, identities, mailer := newOIDCTestService(t, AuthConfig{})
+		res, err := svc.OIDCLogin(context.Background(), startOIDCLogin(t, svc, server, identities, unverified))
+		if err != nil || !res.GetIsNewUser() {
+			t.Fatalf("got %v / new user %v, want a new user", err, res.GetIsNewUser())
+		}
+		for _, u := range users.users {
+			if u.EmailVerified || u.Password != "" {
+				t.Errorf("got user %+v, want unverified and passwordless", u)
+			}
+		}
+		if len(mailer.sent) != 1 {
+			t.Errorf("got %d emails, want a verification email", len(mailer.sent))
+		}
+	})
+
+	t.Run("rejected when verification is required", func(t *testing.T) {
+		svc, server, _, identities, _ := newOIDCTestService(t, AuthConfig{RequireVerifiedEmail: true})
+		_, err := svc.OIDCLogin(context.Background(), startOIDCLogin(t, svc, server, identities, unverified))
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("got %v, want FailedPrecondition", err)
+		}
+	})
+
+	t.Run("not linked to an existing account", func(t *testing.T) {
+		existing := &entity.User{Id: "u1", Email: "oidc@example.com", Password: "hash", EmailVerified: true}
+		svc, server, users, identities, _ := newOIDCTestService(t, AuthConfig{}, existing)
+		_, err := svc.OIDCLogin(context.Background(), startOIDCLogin(t, svc, server, identities, unverified))
+		if status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("got %v, want AlreadyExists", err)
+		}
+		if len(identities.identities) != 0 || users.users["u1"].Password != "hash" {
+			t.Error("existing account was changed")
+		}
+	})
+}
+
+func TestOIDCLoginLinksVerifiedEmail(t *testing.T) {
+	existing := &entity.User{Id: "u1", Email: "oidc@example.com", Password: "hash", EmailVerified: true}
+	svc, server, _, identities, _ := newOIDCTestService(t, AuthConfig{}, existing)
+
+	res, err := svc.OIDCLogin(context.Background(), startOIDCLogin(t, svc, server, identities, nil))
+	if err != nil || res.GetIsNewUser() {
+		t.Fatalf("got %v / new user %v, want the existing account", err, res.GetIsNewUser())
+	}
+	if len(identities.identities) != 1 || identities.identities[0].UserId != "u1" {
+		t.Errorf("got identities %+v, want one linked to u1", identities.identities)
+	}
+	claims := parseAccessToken(t, res.GetAccessToken())
+	if claims.Subject != "u1" || !claims.AuthenticatedSince(time.Now().Add(-time.Minute)) {
+		t.Errorf("got subject %q auth_time %v, want u1 signed in now", claims.Subject, claims.AuthTime)
+	}
+}
+
+func parseAccessToken(t *testing.T, token string) *jwtentity.JWTClaims {
+	t.Helper()
+	claims, err := jwtentity.GetClaimsFromToken(token)
+	if err != nil {
+		t.Fatalf("failed to parse access token: %v", err)
+	}
+	return claims
+}
+
+// contextWithSignIn returns the context of a user whose access token came from a sign-in at authTime,
+// or from a refresh when authTime is zero.
+func contextWithSignIn(userID string, authTime time.Time) context.Context {
+	claims := &jwtentity.JWTClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: userID}}
+	if !authTime.IsZero() {
+		claims.AuthTime = jwt.NewNumericDate(authTime)
+	}
+	return claims.SetToContext(context.Background())
+}
+
+func TestDeleteMyAccountProofOfIdentity(t *testing.T) {
+	totpKey := make([]byte, 32)
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := sealTOTPSecret(totpKey, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := utils.TOTPCode(secret, utils.TOTPStep(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("secret-password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		password    string
+		withTOTP    bool
+		authTime    time.Time
+		request     *auth.DeleteMyAccountRequest
+		wantDeleted bool
+	}{
+		{name: "password account with password", password: string(passwordHash), request: &auth.DeleteMyAccountRequest{Password: "secret-password"}, wantDeleted: true},
+		{name: "password account with wrong password", password: string(passwordHash), request: &auth.DeleteMyAccountRequest{Password: "wrong"}},
+		{name: "password account with fresh sign-in only", password: string(passwordHash), authTime: time.Now(), request: &auth.DeleteMyAccountRequest{}},
+		{name: "passwordless with fresh sign-in", authTime: time.Now().Add(-time.Minute), request: &auth.DeleteMyAccountRequest{}, wantDeleted: true},
+		{name: "passwordless with old sign-in", authTime: time.Now().Add(-ReauthenticationMaxAge - time.Minute), request: &auth.DeleteMyAccountRequest{}},
+		{name: "passwordless with refreshed token", request: &auth.DeleteMyAccountRequest{}},
+		{name: "passwordless with totp code", withTOTP: true, request: &auth.DeleteMyAccountRequest{Code: code}, wantDeleted: true},
+		{name: "passwordless with wrong totp code", withTOTP: true, request: &auth.DeleteMyAccountRequest{Code: "000000"}},
+		{name: "passwordless with code but no totp", request: &auth.DeleteMyAccountRequest{Code: code}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := newFakeAuthRepository(&entity.User{Id: "u1", Email: "user@example.com", Password: tt.password})
+			totps := newFakeUserTOTPRepository()
+			if tt.withTOTP {
+				confirmedAt := time.Now().Add(-time.Hour)
+				totps.totps["u1"] = &entity.UserTOTP{UserId: "u1", SecretEncrypted: sealed, ConfirmedAt: &confirmedAt}
+			}
+			svc := &authService{
+				authRepository:         users,
+				refreshTokenRepository: &fakeRefreshTokenRepository{},
+				userTokenRepository:    &fakeUserTokenRepository{},
+				userTOTPRepository:     totps,
+				revocationStore:        NewMemoryTokenRevocationStore(time.Minute),
+				loginThrottle:          &loginThrottle{limiter: &rateLimiter{repository: &fakeRateLimitRepository{}}},
+				config:                 AuthConfig{TOTPEncryptionKey: totpKey},
+			}
+
+			res, err := svc.DeleteMyAccount(contextWithSignIn("u1", tt.authTime), tt.request)
+			if err != nil {
+				t.Fatalf("DeleteMyAccount returned error: %v", err)
+			}
+			if deleted := users.users["u1"].IsDeleted; deleted != tt.wantDeleted {
+				t.Errorf("got deleted %v (%q), want %v", deleted, res.GetBase().GetMessage(), tt.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestUpdateProfileEmailChangeWithoutPassword(t *testing.T) {
+	users := newFakeAuthRepository(&entity.User{Id: "u1", Email: "old@example.com", FullName: "User", EmailVerified: true})
+	svc := &authService{
+		authRepository:      users,
+		userTokenRepository: &fakeUserTokenRepository{},
+		userTOTPRepository:  newFakeUserTOTPRepository(),
+		mailer:              &fakeMailer{},
+		loginThrottle:       &loginThrottle{limiter: &rateLimiter{repository: &fakeRateLimitRepository{}}},
+	}
+	request := &auth.UpdateProfileRequest{FullName: "User", Email: "new@example.com"}
+
+	res, err := svc.UpdateProfile(contextWithSignIn("u1", time.Time{}), request)
+	if err != nil || !res.GetBase().GetIsError() || users.users["u1"].Email != "old@example.com" {
+		t.Fatalf("got %v / %q, want a refreshed session to be rejected", err, res.GetBase().GetMessage())
+	}
+
+	res, err = svc.UpdateProfile(contextWithSignIn("u1", time.Now()), request)
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("got %v / %q, want a fresh sign-in to be accepted", err, res.GetBase().GetMessage())
+	}
+	if u := users.users["u1"]; u.Email != "new@example.com" || u.EmailVerified {
+		t.Errorf("got email %q verified %v, want the new unverified email", u.Email, u.EmailVerified)
+	}
+}
+
+func newRefreshTokenTestService(t *testing.T, user *entity.User, mfa bool) (*authService, *fakeRefreshTokenRepository, string) {
+	t.Helper()
+	refreshTokens := &fakeRefreshTokenRepository{}
+	token, tokenData, err := newRefreshToken(user.Id, "family-1", time.Now(), mfa)
+	if err != nil {
+		t.Fatal(err)
+	}
+	refreshTokens.inserted = append(refreshTokens.inserted, tokenData)
+	svc := &authService{authRepository: newFakeAuthRepository(user), refreshTokenRepository: refreshTokens}
+	return svc, refreshTokens, token
+}
+
+func TestRefreshTokenRotation(t *testing.T) {
+	user := &entity.User{Id: "u1", Email: "user@example.com", FullName: "User", RoleCode: "customer"}
+	svc, refreshTokens, token := newRefreshTokenTestService(t, user, true)
+
+	res, err := svc.RefreshToken(context.Background(), &auth.RefreshTokenRequest{RefreshToken: token})
+	if err != nil {
+		t.Fatalf("RefreshToken returned error: %v", err)
+	}
+	if res.GetAccessToken() == "" || res.GetRefreshToken() == "" || res.GetRefreshToken() == token {
+		t.Fatalf("got access token %q and refresh token %q, want a new pair", res.GetAccessToken(), res.GetRefreshToken())
+	}
+
+	old, next := refreshTokens.inserted[0], refreshTokens.inserted[1]
+	if old.RevokedAt == nil || old.ReplacedById == nil || *old.ReplacedById != next.Id {
+		t.Errorf("old token not marked as replaced by %s: %+v", next.Id, old)
+	}
+	if next.FamilyId != old.FamilyId || !next.MFA {
+		t.Errorf("got family %q and MFA %v, want the rotated token to keep family %q and MFA", next.FamilyId, next.MFA, old.FamilyId)
+	}
+	if claims := parseAccessToken(t, res.GetAccessToken()); claims.Subject != "u1" || !claims.HasMFA() {
+		t.Errorf("got subject %q and MFA %v, want an MFA access token for u1", claims.Subject, claims.HasMFA())
+	}
+
+	if _, err = svc.RefreshToken(context.Background(), &auth.RefreshTokenRequest{RefreshToken: res.GetRefreshToken()}); err != nil {
+		t.Errorf("rotated refresh token rejected: %v", err)
+	}
+}
+
+func TestRefreshTokenReuseRevokesFamily(t *testing.T) {
+	user := &entity.User{Id: "u1", Email: "user@example.com", FullName: "User"}
+	svc, refreshTokens, token := newRefreshTokenTestService(t, user, false)
+
+	res, err := svc.RefreshToken(context.Background(), &auth.RefreshTokenRequest{RefreshToken: token})
+	if err != nil {
+		t.Fatalf("RefreshToken returned error: %v", err)
+	}
+
+	// Token lama dipakai lagi setelah dirotasi
+	_, err = svc.RefreshToken(context.Background(), &auth.RefreshTokenRequest{RefreshToken: token})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("got %v, want Unauthenticated for a reused token", err)
+	}
+	if len(refreshTokens.revokedFamilies) != 1 || refreshTokens.revokedFamilies[0] != "family-1" {
+		t.Errorf("got revoked families %v, want family-1", refreshTokens.revokedFamilies)
+	}
+
+	_, err = svc.RefreshToken(context.Background(), &auth.RefreshTokenRequest{RefreshToken: res.GetRefreshToken()})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("got %v, want the rest of the family to be revoked", err)
+	}
+}
+
+// raceRefreshTokenRepository lets another request rotate the token between the lookup and the rotation.
+type raceRefreshTokenRepository struct {
+	*fakeRefreshTokenRepository
+}
+
+func (r *raceRefreshTokenRepository) RotateRefreshToken(ctx context.Context, oldID string, next *entity.RefreshToken) (bool, error) {
+	other := *next
+	other.Id = "other-request"
+	if _, err := r.fakeRefreshTokenRepository.RotateRefreshToken(ctx, oldID, &other); err != nil {
+		return false, err
+	}
+	return r.fakeRefreshTokenRepository.RotateRefreshToken(ctx, oldID, next)
+}
+
+func TestRefreshTokenConcurrentRotation(t *testing.T) {
+	user := &entity.User{Id: "u1", Email: "user@example.com", FullName: "User"}
+	svc, refreshTokens, token := newRefreshTokenTestService(t, user, false)
+	svc.refreshTokenRepository = &raceRefreshTokenRepository{refreshTokens}
+
+	_, err := svc.RefreshToken(context.Background(), &auth.RefreshTokenRequest{RefreshToken: token})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("got %v, want Unauthenticated when another request rotated the token first", err)
+	}
+	for _, stored := range refreshTokens.inserted {
+		if stored.RevokedAt == nil {
+			t.Errorf("token %s still active, want the whole family revoked", stored.Id)
+		}
+	}
+}
+
+func TestRefreshTokenRejected(t *testing.T) {
+	tests := []struct {
+		name        string
+		unknown     bool
+		expired     bool
+		deleted     bool
+		wantRevoked bool
+	}{
+		{name: "unknown token", unknown: true},
+		{name: "expired token", expired: true},
+		{name: "deleted user", deleted: true, wantRevoked: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := &entity.User{Id: "u1", Email: "user@example.com", FullName: "User", IsDeleted: tt.deleted}
+			svc, refreshTokens, token := newRefreshTokenTestService(t, user, false)
+			if tt.expired {
+				refreshTokens.inserted[0].ExpiresAt = time.Now().Add(-time.Minute)
+			}
+			if tt.unknown {
+				token = "unknown"
+			}
+
+			res, err := svc.RefreshToken(context.Background(), &auth.RefreshTokenRequest{RefreshToken: token})
+			if err == nil && !res.GetBase().GetIsError() {
+				t.Fatal("got a new token pair, want the refresh to be rejected")
+			}
+			if len(refreshTokens.inserted) != 1 {
+				t.Errorf("got %d stored tokens, want no new token", len(refreshTokens.inserted))
+			}
+			if got := len(refreshTokens.revokedFamilies) == 1; got != tt.wantRevoked {
+				t.Errorf("got family revoked %v, want %v", got, tt.wantRevoked)
+			}
+		})
+	}
+}
+
+// tokenFromMail extracts the token from the link to path in an email.
+func tokenFromMail(t *testing.T, message *MailMessage, path string) string {
+	t.Helper()
+	match := regexp.MustCompile(regexp.QuoteMeta(path) + `\?token=(\S+)`).FindStringSubmatch(message.Body)
+	if match == nil {
+		t.Fatalf("no %s link in %q", path, message.Body)
+	}
+	token, err := url.QueryUnescape(match[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestEmailVerification(t *testing.T) {
+	users := newFakeAuthRepository()
+	mailer := &fakeMailer{}
+	svc := &authService{
+		authRepository:         users,
+		userTokenRepository:    &fakeUserTokenRepository{},
+		refreshTokenRepository: &fakeRefreshTokenRepository{},
+		userTOTPRepository:     newFakeUserTOTPRepository(),
+		mailer:                 mailer,
+		loginThrottle:          &loginThrottle{limiter: &rateLimiter{repository: &fakeRateLimitRepository{}}},
+		config:                 AuthConfig{RequireVerifiedEmail: true, AppURL: "https://shop.example.com"},
+	}
+	login := &auth.LoginRequest{Email: "user@example.com", Password: "password"}
+
+	res, err := svc.Register(context.Background(), &auth.RegisterRequest{FullName: "User", Email: login.Email, Password: login.Password, ConfirmPassword: login.Password})
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("Register: %v / %q", err, res.GetBase().GetMessage())
+	}
+	if len(mailer.sent) != 1 || mailer.sent[0].To != login.Email {
+		t.Fatalf("got %d emails, want one verification email to %s", len(mailer.sent), login.Email)
+	}
+	token := tokenFromMail(t, mailer.sent[0], "/verify-email")
+
+	if _, err = svc.Login(context.Background(), login); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("got %v, want FailedPrecondition before the email is verified", err)
+	}
+
+	verifyRes, err := svc.VerifyEmail(context.Background(), &auth.VerifyEmailRequest{Token: token})
+	if err != nil || verifyRes.GetBase().GetIsError() {
+		t.Fatalf("VerifyEmail: %v / %q", err, verifyRes.GetBase().GetMessage())
+	}
+	user, _ := users.GetUserByEmail(context.Background(), login.Email)
+	if !user.EmailVerified || user.EmailVerifiedAt == nil {
+		t.Errorf("got %+v, want the email marked as verified", user)
+	}
+
+	verifyRes, err = svc.VerifyEmail(context.Background(), &auth.VerifyEmailRequest{Token: token})
+	if err != nil || verifyRes.GetBase().GetStatusCode() != 400 {
+		t.Errorf("got %v / %q, want a reused token to be rejected", err, verifyRes.GetBase().GetMessage())
+	}
+
+	loginRes, err := svc.Login(context.Background(), login)
+	if err != nil || loginRes.GetAccessToken() == "" {
+		t.Errorf("got %v / %q, want the verified user to log in", err, loginRes.GetBase().GetMessage())
+	}
+}
+
+func TestVerifyEmailRejectsExpiredToken(t *testing.T) {
+	user := &entity.User{Id: "u1", Email: "user@example.com", FullName: "User"}
+	users := newFakeAuthRepository(user)
+	tokens := &fakeUserTokenRepository{}
+	tokens.tokens = append(tokens.tokens, &entity.UserToken{
+		UserId:    "u1",
+		Purpose:   entity.UserTokenPurposeEmailVerification,
+		TokenHash: utils.HashToken("verify-token"),
+		CreatedAt: time.Now().Add(-EmailVerificationTokenTTL - time.Minute),
+		ExpiresAt: time.Now().Add(-time.Minute),
+	})
+	svc := &authService{authRepository: users, userTokenRepository: tokens}
+
+	res, err := svc.VerifyEmail(context.Background(), &auth.VerifyEmailRequest{Token: "verify-token"})
+	if err != nil || res.GetBase().GetStatusCode() != 400 {
+		t.Fatalf("got %v / %q, want an expired token to be rejected", err, res.GetBase().GetMessage())
+	}
+	if users.users["u1"].EmailVerified {
+		t.Error("email verified with an expired token")
+	}
+}
+
+func TestRequestPasswordResetThrottle(t *testing.T) {
+	user := &entity.User{Id: "u1", Email: "user@example.com", FullName: "User"}
+	tests := []struct {
+		name       string
+		issuedAgo  []time.Duration
+		wantEmails int
+	}{
+		{name: "first request", issuedAgo: nil, wantEmails: 1},
+		{name: "within cooldown", issuedAgo: []time.Duration{30 * time.Second}, wantEmails: 0},
+		{name: "after cooldown", issuedAgo: []time.Duration{2 * time.Minute}, wantEmails: 1},
+		{name: "hourly limit reached", issuedAgo: []time.Duration{5 * time.Minute, 10 * time.Minute, 20 * time.Minute, 30 * time.Minute, 50 * time.Minute}, wantEmails: 0},
+		{name: "hourly limit expired", issuedAgo: []time.Duration{5 * time.Minute, 10 * time.Minute, 20 * time.Minute, 30 * time.Minute, 70 * time.Minute}, wantEmails: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := &fakeUserTokenRepository{}
+			now := time.Now()
+			for _, ago := range tt.issuedAgo {
+				tokens.tokens = append(tokens.tokens, &entity.UserToken{
+					UserId:    user.Id,
+					Purpose:   entity.UserTokenPurposePasswordReset,
+					CreatedAt: now.Add(-ago),
+					ExpiresAt: now.Add(PasswordResetTokenTTL - ago),
+				})
+			}
+			mailer := &fakeMailer{}
+			svc := &authService{authRepository: newFakeAuthRepository(user), userTokenRepository: tokens, mailer: mailer}
+
+			res, err := svc.RequestPasswordReset(context.Background(), &auth.RequestPasswordResetRequest{Email: user.Email})
+			if err != nil {
+				t.Fatalf("RequestPasswordReset returned error: %v", err)
+			}
+			if res.GetBase().GetIsError() || res.GetBase().GetMessage() != "If the email is registered, a password reset link has been sent" {
+				t.Errorf("got response %q, want the uniform success response", res.GetBase().GetMessage())
+			}
+			if len(mailer.sent) != tt.wantEmails {
+				t.Errorf("got %d emails, want %d", len(mailer.sent), tt.wantEmails)
+			}
+		})
+	}
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	user := &entity.User{Id: "u1", Email: "user@example.com", FullName: "User", Password: "old-hash"}
+	users := newFakeAuthRepository(user)
+	tokens := &fakeUserTokenRepository{users: users}
+	mailer := &fakeMailer{}
+	svc := &authService{
+		authRepository:         users,
+		userTokenRepository:    tokens,
+		refreshTokenRepository: &fakeRefreshTokenRepository{},
+		revocationStore:        NewMemoryTokenRevocationStore(time.Minute),
+		mailer:                 mailer,
+		loginThrottle:          &loginThrottle{limiter: &rateLimiter{repository: &fakeRateLimitRepository{}}},
+		config:                 AuthConfig{AppURL: "https://shop.example.com"},
+	}
+
+	for _, email := range []string{"user@example.com", "user@example.com", "nobody@example.com"} {
+		res, err := svc.RequestPasswordReset(context.Background(), &auth.RequestPasswordResetRequest{Email: email})
+		if err != nil || res.GetBase().GetIsError() {
+			t.Fatalf("%s: got %v / %q, want the uniform success response", email, err, res.GetBase().GetMessage())
+		}
+		// Permintaan berikutnya dibuat setelah cooldown email lewat
+		for _, token := range tokens.tokens {
+			token.CreatedAt = token.CreatedAt.Add(-2 * TokenEmailCooldown)
+		}
+	}
+	if len(mailer.sent) != 2 {
+		t.Fatalf("got %d emails, want one per request for the registered email only", len(mailer.sent))
+	}
+
+	// Hanya link terbaru yang masih berlaku
+	first := tokenFromMail(t, mailer.sent[0], "/reset-password")
+	latest := tokenFromMail(t, mailer.sent[1], "/reset-password")
+	res, err := svc.ResetPassword(context.Background(), &auth.ResetPasswordRequest{Token: first, NewPassword: "new-password", NewConfirmPassword: "new-password"})
+	if err != nil || !res.GetBase().GetIsError() {
+		t.Errorf("got %v / %q, want the superseded link to be rejected", err, res.GetBase().GetMessage())
+	}
+	res, err = svc.ResetPassword(context.Background(), &auth.ResetPasswordRequest{Token: latest, NewPassword: "new-password", NewConfirmPassword: "new-password"})
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("got %v / %q, want the latest link to reset the password", err, res.GetBase().GetMessage())
+	}
+	if bcrypt.CompareHashAndPassword([]byte(users.users["u1"].Password), []byte("new-password")) != nil {
+		t.Error("password was not updated")
+	}
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
@@ -13,35 +15,87 @@ import (
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ICartService defines the interface for cart-related business logic.
 type ICartService interface {
+	// CreateGuestCart issues a token for a cart that can be used without logging in.
+	CreateGuestCart(ctx context.Context, request *cart.CreateGuestCartRequest) (*cart.CreateGuestCartResponse, error)
 	// AddProductToCart adds a product to the user's cart.
 	AddProductToCart(ctx context.Context, req *cart.AddProductToCartRequest) (*cart.AddProductToCartResponse, error)
-	// ListCart retrieves all items in the user's cart.
+	// ListCart retrieves all items in the caller's cart.
 	ListCart(ctx context.Context, request *cart.ListCartRequest) (*cart.ListCartResponse, error)
 	// UpdateCartItem updates the quantity of a specific cart item.
 	UpdateCartItem(ctx context.Context, request *cart.UpdateCartItemRequest) (*cart.UpdateCartItemResponse, error)
 	// DeleteCartItem removes a specific cart item from the cart.
 	DeleteCartItem(ctx context.Context, request *cart.DeleteCartItemRequest) (*cart.DeleteCartItemResponse, error)
+	// ApplyCoupon applies a coupon code to the logged-in user's cart.
+	ApplyCoupon(ctx context.Context, request *cart.ApplyCouponRequest) (*cart.ApplyCouponResponse, error)
+	// RemoveCoupon removes the coupon applied to the logged-in user's cart.
+	RemoveCoupon(ctx context.Context, request *cart.RemoveCouponRequest) (*cart.RemoveCouponResponse, error)
 }
 
 // CartService implements ICartService.
 type CartService struct {
-	cartRepository    repository.ICartRepository
-	productRepository repository.IProductRepository
+	cartRepository      repository.ICartRepository
+	productRepository   repository.IProductRepository
+	inventoryRepository repository.IInventoryRepository
+	variantRepository   repository.IProductVariantRepository
+	guestCartRepository repository.IGuestCartRepository
+	couponRepository    repository.ICouponRepository
+	pricingEngine       *PricingEngine
+	rateLimiter         *rateLimiter
+	// trustedProxyHops is the number of reverse proxies in front of the server, see clientIP
+	trustedProxyHops int
 }
 
 // NewCartService creates a new instance of CartService.
-func NewCartService(cartRepository repository.ICartRepository, productRepository repository.IProductRepository) ICartService {
+func NewCartService(cartRepository repository.ICartRepository, productRepository repository.IProductRepository, inventoryRepository repository.IInventoryRepository, variantRepository repository.IProductVariantRepository, guestCartRepository repository.IGuestCartRepository, couponRepository repository.ICouponRepository, rateLimitRepository repository.IRateLimitRepository, pricingEngine *PricingEngine, trustedProxyHops int) ICartService {
 	return &CartService{
-		cartRepository:    cartRepository,
-		productRepository: productRepository,
+		cartRepository:      cartRepository,
+		productRepository:   productRepository,
+		inventoryRepository: inventoryRepository,
+		variantRepository:   variantRepository,
+		guestCartRepository: guestCartRepository,
+		couponRepository:    couponRepository,
+		pricingEngine:       pricingEngine,
+		rateLimiter:         &rateLimiter{repository: rateLimitRepository},
+		trustedProxyHops:    trustedProxyHops,
 	}
 }
 
-// AddProductToCart adds a product to the user's cart or updates its quantity if already present.
+// CreateGuestCart creates an empty guest cart and returns its token. Only the hash of the token is stored.
+// The number of carts per client IP is throttled; expired carts are removed by RunGuestCartPurge.
+func (s *CartService) CreateGuestCart(ctx context.Context, request *cart.CreateGuestCartRequest) (*cart.CreateGuestCartResponse, error) {
+	now := time.Now()
+	if err := s.checkGuestCartThrottle(ctx, now); err != nil {
+		return nil, err
+	}
+
+	token, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	guestCart := &entity.GuestCart{
+		Id:        uuid.NewString(),
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: now.Add(GuestCartTTL),
+		CreatedAt: now,
+	}
+	if err = s.guestCartRepository.CreateGuestCart(ctx, guestCart); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &cart.CreateGuestCartResponse{
+		Base:      utils.SuccessResponse("Guest cart created successfully"),
+		CartToken: token,
+		ExpiresAt: timestamppb.New(guestCart.ExpiresAt),
+	}, nil
+}
+
+// AddProductToCart adds a product to the caller's cart (user or guest cart) or updates its quantity if already present.
 func (s *CartService) AddProductToCart(ctx context.Context, req *cart.AddProductToCartRequest) (*cart.AddProductToCartResponse, error) {
 	// cek apakah id produk ada di DB atau tidak (gunakan produk repository)
 	product, err := s.productRepository.GetProductById(ctx, req.ProductId)
@@ -54,17 +108,57 @@ func (s *CartService) AddProductToCart(ctx context.Context, req *cart.AddProduct
 		}, nil
 	}
 
-	// cek siapa usernya
-	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	// produk yang punya varian wajib memilih salah satu variannya
+	var variantID *string
+	if req.VariantId != "" {
+		variant, err := s.variantRepository.GetVariantById(ctx, req.VariantId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if variant == nil || variant.ProductId != product.Id {
+			return &cart.AddProductToCartResponse{
+				Base: utils.NotFoundResponse("product variant not found"),
+			}, nil
+		}
+		variantID = &variant.Id
+	} else {
+		hasVariants, err := s.variantRepository.HasVariants(ctx, product.Id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if hasVariants {
+			return &cart.AddProductToCartResponse{
+				Base: utils.BadRequestResponse("Please select a product variant"),
+			}, nil
+		}
+	}
+
+	// cek siapa pemilik cart (user yang login atau guest cart)
+	carts, ownerID, actor, err := s.cartFor(ctx)
 	if err != nil {
-		return nil, utils.UnauthenticatedResponse()
-	}	
+		return nil, err
+	}
+
+	// cek apakah procuk (dengan varian yang sama) sudah ada di cart user
+	cartItem, err := carts.FindByUserIDAndProductID(ctx, ownerID, req.ProductId, variantID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	// cek apakah procuk sudah ada di cart user
-	cartItem, err := s.cartRepository.FindByUserIDAndProductID(ctx, claims.Subject, req.ProductId)
+	// cek stok: jumlah di cart setelah ditambah tidak boleh melebihi stok tersedia
+	newQuantity := 1
+	if cartItem != nil {
+		newQuantity = cartItem.Quantity + 1
+	}
+	available, err := s.availableStock(ctx, req.ProductId, variantID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if newQuantity > available {
+		return &cart.AddProductToCartResponse{
+			Base: utils.BadRequestResponse("Insufficient stock"),
+		}, nil
+	}
 
 	var cartID uuid.UUID
 
@@ -72,11 +166,11 @@ func (s *CartService) AddProductToCart(ctx context.Context, req *cart.AddProduct
 		//  sudah ada -> update
 		cartItem.Quantity += 1 // Cast to int64
 		now := time.Now()
-		updatedBy := claims.FullName
+		updatedBy := actor
 		cartItem.UpdatedAt = &now
 		cartItem.UpdatedBy = &updatedBy
 
-		err = s.cartRepository.Update(ctx, cartItem)
+		err = carts.Update(ctx, cartItem)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -85,13 +179,14 @@ func (s *CartService) AddProductToCart(ctx context.Context, req *cart.AddProduct
 		//belum ada -> insert
 		newItem := &entity.CartItem{
 			ID:        uuid.New(), // Generate new UUID
-			UserID:    claims.Subject,
+			UserID:    ownerID,
 			ProductID: req.ProductId,
+			VariantID: variantID,
 			Quantity:  1, // Cast to int64
 			CreatedAt: time.Now(),
-			CreatedBy: claims.FullName,
+			CreatedBy: actor,
 		}
-		err = s.cartRepository.Insert(ctx, newItem)
+		err = carts.Insert(ctx, newItem)
 		if err != nil {
 			return &cart.AddProductToCartResponse{
 				Base: utils.BadRequestResponse("Failed to add product to cart"),
@@ -107,20 +202,21 @@ func (s *CartService) AddProductToCart(ctx context.Context, req *cart.AddProduct
 	}, nil
 }
 
-// ListCart retrieves all items in the user's cart along with product details and total price.
+// ListCart retrieves all items in the caller's cart along with product details and the price breakdown.
 func (s *CartService) ListCart(ctx context.Context, request *cart.ListCartRequest) (*cart.ListCartResponse, error){
-	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	carts, ownerID, actor, err := s.cartFor(ctx)
 	if err != nil {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, err
 	}
 
-	cartItems, err := s.cartRepository.FindByUserID(ctx, claims.Subject)
+	cartItems, err := carts.FindByUserID(ctx, ownerID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	var responseItems []*cart.CartItem
-	var totalPrice float64
+	var pricingLines []PricingLine
+	var productIDs []string
 
 	for _, item := range cartItems {
 		product, err := s.productRepository.GetProductById(ctx, item.ProductID)
@@ -132,29 +228,100 @@ func (s *CartService) ListCart(ctx context.Context, request *cart.ListCartReques
 			continue
 		}
 
-		responseItems = append(responseItems, &cart.CartItem{
+		responseItem := &cart.CartItem{
 			CartId:      item.ID.String(),
 			ProductId:   product.Id,
 			ProductName: product.Name,
 			ImageUrl:    product.ImageFileName,
-			Price:       product.Price,
 			Quantity:    int32(item.Quantity),
+		}
+		price := product.Price
+		if item.VariantID != nil {
+			variant, err := s.variantRepository.GetVariantById(ctx, *item.VariantID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get variant details for variant ID %s: %v", *item.VariantID, err)
+			}
+			if variant == nil {
+				log.Printf("WARNING: Variant with ID %s found in cart but not in product variants", *item.VariantID)
+				continue
+			}
+			responseItem.VariantId = variant.Id
+			responseItem.Sku = variant.Sku
+			responseItem.VariantName = variant.Name
+			price = variant.EffectivePrice(product.Price)
+			if variant.ImageFileName != nil {
+				responseItem.ImageUrl = *variant.ImageFileName
+			}
+		}
+
+		responseItems = append(responseItems, responseItem)
+		pricingLines = append(pricingLines, PricingLine{
+			UnitPrice: price,
+			Quantity:  int64(item.Quantity),
 		})
-		totalPrice += product.Price * float64(item.Quantity)
+		productIDs = append(productIDs, product.Id)
+	}
+
+	// Kupon hanya bisa dipasang di cart user yang login
+	var appliedCoupon *cart.AppliedCoupon
+	var discount int64
+	if actor != entity.GuestCartActor {
+		cartCoupon, err := s.couponRepository.GetCartCoupon(ctx, ownerID, productIDs)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if cartCoupon != nil {
+			for i, productID := range productIDs {
+				pricingLines[i].Discountable = cartCoupon.EligibleProductIds[productID]
+			}
+			var message string
+			discount, message = couponDiscount(cartCoupon, pricingLines, time.Now())
+			appliedCoupon = &cart.AppliedCoupon{
+				Code:         cartCoupon.Coupon.Code,
+				IsApplicable: message == "",
+				Message:      message,
+			}
+		}
+	}
+
+	// Harga dihitung dengan pricing engine yang sama dengan checkout
+	breakdown, err := s.pricingEngine.Price(pricingLines, discount)
+	if err != nil {
+		if errors.Is(err, ErrCurrencyMismatch) {
+			return &cart.ListCartResponse{
+				Base: utils.BadRequestResponse("Cart contains items priced in another currency"),
+			}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for i, priced := range breakdown.Lines {
+		responseItems[i].Price = breakdown.Money(priced.UnitPrice).Major()
+		responseItems[i].Subtotal = breakdown.Money(priced.Subtotal).Major()
+		responseItems[i].Discount = breakdown.Money(priced.Discount).Major()
+		responseItems[i].Tax = breakdown.Money(priced.Tax).Major()
+		responseItems[i].Total = breakdown.Money(priced.Total).Major()
+		responseItems[i].PriceMoney = toMoneyResponse(breakdown.Money(priced.UnitPrice))
+		responseItems[i].SubtotalMoney = toMoneyResponse(breakdown.Money(priced.Subtotal))
+		responseItems[i].DiscountMoney = toMoneyResponse(breakdown.Money(priced.Discount))
+		responseItems[i].TaxMoney = toMoneyResponse(breakdown.Money(priced.Tax))
+		responseItems[i].TotalMoney = toMoneyResponse(breakdown.Money(priced.Total))
 	}
 
 	return &cart.ListCartResponse{
 		Base:      utils.SuccessResponse("Cart items retrieved successfully"),
 		Items:     responseItems,
-		TotalPrice: totalPrice,
+		TotalPrice: breakdown.Money(breakdown.GrandTotal).Major(),
+		PriceBreakdown: breakdown.toResponse(),
+		TotalPriceMoney: toMoneyResponse(breakdown.Money(breakdown.GrandTotal)),
+		AppliedCoupon: appliedCoupon,
 	}, nil
 }
 
-// UpdateCartItem updates the quantity of a specific cart item for the authenticated user.
+// UpdateCartItem updates the quantity of a specific cart item in the caller's cart.
 func (s *CartService) UpdateCartItem(ctx context.Context, request *cart.UpdateCartItemRequest) (*cart.UpdateCartItemResponse, error){
-	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	carts, ownerID, actor, err := s.cartFor(ctx)
 	if err != nil {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, err
 	}
 
 	cartUUID, err := uuid.Parse(request.CartId)
@@ -164,7 +331,14 @@ func (s *CartService) UpdateCartItem(ctx context.Context, request *cart.UpdateCa
 		}, nil
 	}
 
-	cartItem, err := s.cartRepository.FindByID(ctx, cartUUID.String())
+	// Item dihapus lewat DeleteCartItem, bukan dengan jumlah nol atau negatif
+	if request.NewQuantity < 1 {
+		return &cart.UpdateCartItemResponse{
+			Base: utils.BadRequestResponse("Quantity must be at least 1"),
+		}, nil
+	}
+
+	cartItem, err := carts.FindByID(ctx, cartUUID.String())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -174,17 +348,27 @@ func (s *CartService) UpdateCartItem(ctx context.Context, request *cart.UpdateCa
 		}, nil
 	}
 
-	if cartItem.UserID != claims.Subject {
+	if cartItem.UserID != ownerID {
 		return nil, utils.UnauthenticatedResponse()
 	}
 
+	available, err := s.availableStock(ctx, cartItem.ProductID, cartItem.VariantID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if request.NewQuantity > int64(available) {
+		return &cart.UpdateCartItemResponse{
+			Base: utils.BadRequestResponse("Insufficient stock"),
+		}, nil
+	}
+
 	cartItem.Quantity = int(request.NewQuantity) 
 	now := time.Now()
-	updatedBy := claims.FullName
+	updatedBy := actor
 	cartItem.UpdatedAt = &now
 	cartItem.UpdatedBy = &updatedBy
 
-	err = s.cartRepository.Update(ctx, cartItem)
+	err = carts.Update(ctx, cartItem)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -194,11 +378,11 @@ func (s *CartService) UpdateCartItem(ctx context.Context, request *cart.UpdateCa
 	}, nil
 }
 
-// DeleteCartItem removes a specific cart item from the authenticated user's cart.
+// DeleteCartItem removes a specific cart item from the caller's cart.
 func (s *CartService) DeleteCartItem(ctx context.Context, request *cart.DeleteCartItemRequest) (*cart.DeleteCartItemResponse, error){
-	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	carts, ownerID, _, err := s.cartFor(ctx)
 	if err != nil {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, err
 	}
 
 	cartUUID, err := uuid.Parse(request.CartId)
@@ -208,7 +392,7 @@ func (s *CartService) DeleteCartItem(ctx context.Context, request *cart.DeleteCa
 		}, nil
 	}
 
-	cartItem, err := s.cartRepository.FindByID(ctx, cartUUID.String())
+	cartItem, err := carts.FindByID(ctx, cartUUID.String())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -218,11 +402,11 @@ func (s *CartService) DeleteCartItem(ctx context.Context, request *cart.DeleteCa
 		}, nil
 	}
 
-	if cartItem.UserID != claims.Subject {
+	if cartItem.UserID != ownerID {
 		return nil, utils.UnauthenticatedResponse()													
 	}
 
-	err = s.cartRepository.Delete(ctx, cartUUID.String())
+	err = carts.Delete(ctx, cartUUID.String())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -231,3 +415,67 @@ func (s *CartService) DeleteCartItem(ctx context.Context, request *cart.DeleteCa
 		Base: utils.SuccessResponse("Cart item deleted successfully"),
 	}, nil
 }
+
+// ApplyCoupon applies a coupon code to the user's cart. Whether the coupon applies to the cart items is
+// shown by ListCart and checked again at checkout, since the cart can still change.
+func (s *CartService) ApplyCoupon(ctx context.Context, request *cart.ApplyCouponRequest) (*cart.ApplyCouponResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	coupon, err := s.couponRepository.GetCouponByCode(ctx, strings.ToUpper(strings.TrimSpace(request.Code)))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if coupon == nil {
+		return &cart.ApplyCouponResponse{
+			Base: utils.NotFoundResponse("Coupon not found"),
+		}, nil
+	}
+
+	userRedemptions, err := s.couponRepository.CountUserRedemptions(ctx, coupon.Id, claims.Subject)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	now := time.Now()
+	if message := checkCoupon(coupon, userRedemptions, now); message != "" {
+		return &cart.ApplyCouponResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
+
+	err = s.couponRepository.SetCartCoupon(ctx, claims.Subject, coupon.Id, now)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &cart.ApplyCouponResponse{
+		Base: utils.SuccessResponse("Coupon applied successfully"),
+	}, nil
+}
+
+// RemoveCoupon removes the coupon applied to the user's cart. Removing when no coupon is applied succeeds.
+func (s *CartService) RemoveCoupon(ctx context.Context, request *cart.RemoveCouponRequest) (*cart.RemoveCouponResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	err = s.couponRepository.RemoveCartCoupon(ctx, claims.Subject)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &cart.RemoveCouponResponse{
+		Base: utils.SuccessResponse("Coupon removed successfully"),
+	}, nil
+}
+
+// availableStock returns the stock of the variant when variantID is set, otherwise the stock of the product.
+func (s *CartService) availableStock(ctx context.Context, productID string, variantID *string) (int, error) {
+	if variantID != nil {
+		return s.inventoryRepository.GetVariantStock(ctx, *variantID)
+	}
+	return s.inventoryRepository.GetStock(ctx, productID)
+}
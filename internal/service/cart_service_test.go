@@ -0,0 +1,51 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/cart"
+	"github.com/google/uuid"
+)
+
+func TestAddProductToCartVariantSelection(t *testing.T) {
+	products := &fakeProductRepository{products: map[string]*entity.Product{"p1": {Id: "p1"}, "p2": {Id: "p2"}}}
+	variants := &fakeVariantRepository{variants: map[string]*entity.ProductVariant{"v1": {Id: "v1", ProductId: "p1"}}}
+	svc := NewCartService(nil, products, nil, variants, nil, nil, nil, nil, 0)
+
+	tests := []struct {
+		name     string
+		request  *cart.AddProductToCartRequest
+		wantCode int64
+	}{
+		{name: "product with variants needs a variant", request: &cart.AddProductToCartRequest{ProductId: "p1"}, wantCode: 400},
+		{name: "unknown variant", request: &cart.AddProductToCartRequest{ProductId: "p1", VariantId: "missing"}, wantCode: 404},
+		{name: "variant of another product", request: &cart.AddProductToCartRequest{ProductId: "p2", VariantId: "v1"}, wantCode: 404},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := svc.AddProductToCart(contextWithUser("user-1"), tt.request)
+			if err != nil {
+				t.Fatalf("AddProductToCart: %v", err)
+			}
+			if got := res.GetBase().GetStatusCode(); got != tt.wantCode {
+				t.Fatalf("status code = %d, want %d (%s)", got, tt.wantCode, res.GetBase().GetMessage())
+			}
+		})
+	}
+}
+
+func TestUpdateCartItemRejectsQuantityBelowOne(t *testing.T) {
+	// Tanpa repository: quantity harus ditolak sebelum cart dan stok dibaca
+	svc := NewCartService(nil, nil, nil, nil, nil, nil, nil, nil, 0)
+
+	for _, quantity := range []int64{0, -1} {
+		res, err := svc.UpdateCartItem(contextWithUser("user-1"), &cart.UpdateCartItemRequest{CartId: uuid.NewString(), NewQuantity: quantity})
+		if err != nil {
+			t.Fatalf("quantity %d: %v", quantity, err)
+		}
+		if got := res.GetBase().GetStatusCode(); got != 400 {
+			t.Errorf("quantity %d: status code = %d, want 400 (%s)", quantity, got, res.GetBase().GetMessage())
+		}
+	}
+}
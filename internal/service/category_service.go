@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/category"
+	"github.com/google/uuid"
+)
+
+// ICategoryService defines the interface for category-related business logic.
+type ICategoryService interface {
+	// CreateCategory creates a root category or a sub-category (requires category:write).
+	CreateCategory(ctx context.Context, request *category.CreateCategoryRequest) (*category.CreateCategoryResponse, error)
+	// UpdateCategory renames or moves a category within the tree (requires category:write).
+	UpdateCategory(ctx context.Context, request *category.UpdateCategoryRequest) (*category.UpdateCategoryResponse, error)
+	// DeleteCategory deletes a category without sub-categories (requires category:write).
+	DeleteCategory(ctx context.Context, request *category.DeleteCategoryRequest) (*category.DeleteCategoryResponse, error)
+	// ListCategories returns the full category tree.
+	ListCategories(ctx context.Context, request *category.ListCategoriesRequest) (*category.ListCategoriesResponse, error)
+}
+
+// CategoryService implements ICategoryService.
+type CategoryService struct {
+	categoryRepository repository.ICategoryRepository
+}
+
+// NewCategoryService creates a new instance of CategoryService.
+func NewCategoryService(categoryRepository repository.ICategoryRepository) ICategoryService {
+	return &CategoryService{
+		categoryRepository: categoryRepository,
+	}
+}
+
+// CreateCategory creates a new category under an optional parent.
+func (s *CategoryService) CreateCategory(ctx context.Context, request *category.CreateCategoryRequest) (*category.CreateCategoryResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	parentID := strings.TrimSpace(request.ParentId)
+	if parentID != "" {
+		parent, err := s.categoryRepository.GetCategoryById(ctx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			return &category.CreateCategoryResponse{
+				Base: utils.NotFoundResponse("Parent category not found"),
+			}, nil
+		}
+	}
+
+	newCategory := &entity.Category{
+		Id:          uuid.NewString(),
+		ParentId:    optionalString(parentID),
+		Name:        request.Name,
+		Description: request.Description,
+		CreatedAt:   time.Now(),
+		CreatedBy:   claims.FullName,
+	}
+	if err = s.categoryRepository.CreateCategory(ctx, newCategory); err != nil {
+		return nil, err
+	}
+
+	return &category.CreateCategoryResponse{
+		Base: utils.SuccessResponse("Category created successfully"),
+		Id:   newCategory.Id,
+	}, nil
+}
+
+// UpdateCategory updates a category. Moving a category below itself or one of its descendants is rejected.
+func (s *CategoryService) UpdateCategory(ctx context.Context, request *category.UpdateCategoryRequest) (*category.UpdateCategoryResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	categoryData, err := s.categoryRepository.GetCategoryById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if categoryData == nil {
+		return &category.UpdateCategoryResponse{
+			Base: utils.NotFoundResponse("Category not found"),
+		}, nil
+	}
+
+	parentID := strings.TrimSpace(request.ParentId)
+	if parentID != "" {
+		parent, err := s.categoryRepository.GetCategoryById(ctx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			return &category.UpdateCategoryResponse{
+				Base: utils.NotFoundResponse("Parent category not found"),
+			}, nil
+		}
+
+		// Cegah siklus: parent baru tidak boleh kategori ini sendiri atau keturunannya
+		inSubtree, err := s.categoryRepository.IsCategoryInSubtree(ctx, categoryData.Id, parentID)
+		if err != nil {
+			return nil, err
+		}
+		if inSubtree {
+			return &category.UpdateCategoryResponse{
+				Base: utils.BadRequestResponse("Category cannot be moved under itself or its sub-category"),
+			}, nil
+		}
+	}
+
+	now := time.Now()
+	categoryData.ParentId = optionalString(parentID)
+	categoryData.Name = request.Name
+	categoryData.Description = request.Description
+	categoryData.UpdatedAt = &now
+	categoryData.UpdatedBy = &claims.FullName
+
+	if err = s.categoryRepository.UpdateCategory(ctx, categoryData); err != nil {
+		return nil, err
+	}
+
+	return &category.UpdateCategoryResponse{
+		Base: utils.SuccessResponse("Category updated successfully"),
+		Id:   categoryData.Id,
+	}, nil
+}
+
+// DeleteCategory soft-deletes a leaf category and unassigns it from its products.
+func (s *CategoryService) DeleteCategory(ctx context.Context, request *category.DeleteCategoryRequest) (*category.DeleteCategoryResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	categoryData, err := s.categoryRepository.GetCategoryById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if categoryData == nil {
+		return &category.DeleteCategoryResponse{
+			Base: utils.NotFoundResponse("Category not found"),
+		}, nil
+	}
+
+	hasChildren, err := s.categoryRepository.HasChildCategories(ctx, categoryData.Id)
+	if err != nil {
+		return nil, err
+	}
+	if hasChildren {
+		return &category.DeleteCategoryResponse{
+			Base: utils.BadRequestResponse("Category still has sub-categories"),
+		}, nil
+	}
+
+	if err = s.categoryRepository.DeleteCategory(ctx, time.Now(), claims.FullName, categoryData.Id); err != nil {
+		return nil, err
+	}
+
+	return &category.DeleteCategoryResponse{
+		Base: utils.SuccessResponse("Category deleted successfully"),
+	}, nil
+}
+
+// ListCategories returns all active categories nested as a tree.
+func (s *CategoryService) ListCategories(ctx context.Context, request *category.ListCategoriesRequest) (*category.ListCategoriesResponse, error) {
+	categories, err := s.categoryRepository.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category.ListCategoriesResponse{
+		Base:       utils.SuccessResponse("Categories retrieved successfully"),
+		Categories: buildCategoryTree(categories),
+	}, nil
+}
+
+// buildCategoryTree menyusun daftar kategori flat menjadi tree. Urutan saudara mengikuti urutan input.
+func buildCategoryTree(categories []*entity.Category) []*category.Category {
+	nodes := make(map[string]*category.Category, len(categories))
+	for _, c := range categories {
+		nodes[c.Id] = &category.Category{
+			Id:          c.Id,
+			ParentId:    utils.SafeDerefString(c.ParentId),
+			Name:        c.Name,
+			Description: c.Description,
+			Children:    make([]*category.Category, 0),
+		}
+	}
+
+	roots := make([]*category.Category, 0)
+	for _, c := range categories {
+		node := nodes[c.Id]
+		parent, ok := nodes[node.ParentId]
+		if node.ParentId == "" || !ok {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+	return roots
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
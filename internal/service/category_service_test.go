@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/category"
+)
+
+// fakeCategoryTreeRepository keeps a category tree in memory.
+type fakeCategoryTreeRepository struct {
+	repository.ICategoryRepository
+
+	categories []*entity.Category
+	updated    *entity.Category
+	deleted    string
+}
+
+func (r *fakeCategoryTreeRepository) GetCategoryById(ctx context.Context, id string) (*entity.Category, error) {
+	for _, c := range r.categories {
+		if c.Id == id {
+			copied := *c
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeCategoryTreeRepository) ListCategories(ctx context.Context) ([]*entity.Category, error) {
+	return r.categories, nil
+}
+
+func (r *fakeCategoryTreeRepository) IsCategoryInSubtree(ctx context.Context, rootID string, candidateID string) (bool, error) {
+	for id := candidateID; id != ""; {
+		if id == rootID {
+			return true, nil
+		}
+		c, _ := r.GetCategoryById(ctx, id)
+		if c == nil || c.ParentId == nil {
+			return false, nil
+		}
+		id = *c.ParentId
+	}
+	return false, nil
+}
+
+func (r *fakeCategoryTreeRepository) HasChildCategories(ctx context.Context, id string) (bool, error) {
+	for _, c := range r.categories {
+		if c.ParentId != nil && *c.ParentId == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeCategoryTreeRepository) UpdateCategory(ctx context.Context, c *entity.Category) error {
+	r.updated = c
+	return nil
+}
+
+func (r *fakeCategoryTreeRepository) DeleteCategory(ctx context.Context, deletedAt time.Time, deletedBy string, id string) error {
+	r.deleted = id
+	return nil
+}
+
+// newTestCategoryTree returns fashion > (men > shirts, women) and electronics.
+func newTestCategoryTree() *fakeCategoryTreeRepository {
+	parent := func(id string) *string { return &id }
+	return &fakeCategoryTreeRepository{categories: []*entity.Category{
+		{Id: "electronics", Name: "Electronics"},
+		{Id: "fashion", Name: "Fashion"},
+		{Id: "men", ParentId: parent("fashion"), Name: "Men"},
+		{Id: "shirts", ParentId: parent("men"), Name: "Shirts"},
+		{Id: "women", ParentId: parent("fashion"), Name: "Women"},
+	}}
+}
+
+func TestListCategoriesTree(t *testing.T) {
+	svc := NewCategoryService(newTestCategoryTree())
+	res, err := svc.ListCategories(context.Background(), &category.ListCategoriesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roots := res.GetCategories()
+	if len(roots) != 2 || roots[0].GetId() != "electronics" || roots[1].GetId() != "fashion" {
+		t.Fatalf("got roots %v", roots)
+	}
+	fashion := roots[1].GetChildren()
+	if len(fashion) != 2 || fashion[0].GetId() != "men" || fashion[1].GetId() != "women" {
+		t.Fatalf("got fashion children %v", fashion)
+	}
+	if shirts := fashion[0].GetChildren(); len(shirts) != 1 || shirts[0].GetParentId() != "men" {
+		t.Errorf("got men children %v", shirts)
+	}
+}
+
+func TestBuildCategoryTreeOrphanBecomesRoot(t *testing.T) {
+	deletedParent := "deleted"
+	tree := buildCategoryTree([]*entity.Category{{Id: "orphan", ParentId: &deletedParent}})
+	if len(tree) != 1 || tree[0].GetId() != "orphan" {
+		t.Errorf("got %v, want the category whose parent is gone listed as a root", tree)
+	}
+}
+
+func TestUpdateCategoryParent(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		parentID string
+		wantCode int64
+	}{
+		{name: "move to another root", id: "men", parentID: "electronics", wantCode: 200},
+		{name: "make root", id: "men", wantCode: 200},
+		{name: "under itself", id: "men", parentID: "men", wantCode: 400},
+		{name: "under its descendant", id: "fashion", parentID: "shirts", wantCode: 400},
+		{name: "unknown parent", id: "men", parentID: "missing", wantCode: 404},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newTestCategoryTree()
+			svc := NewCategoryService(repo)
+			res, err := svc.UpdateCategory(contextWithUser("admin"), &category.UpdateCategoryRequest{Id: tt.id, ParentId: tt.parentID, Name: "Renamed"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.GetBase().GetStatusCode() != tt.wantCode {
+				t.Fatalf("got %d %q, want %d", res.GetBase().GetStatusCode(), res.GetBase().GetMessage(), tt.wantCode)
+			}
+			if tt.wantCode != 200 {
+				if repo.updated != nil {
+					t.Errorf("rejected move was saved: %+v", repo.updated)
+				}
+				return
+			}
+			if got := repo.updated; got == nil || (got.ParentId == nil) != (tt.parentID == "") {
+				t.Errorf("got %+v, want parent %q", got, tt.parentID)
+			}
+		})
+	}
+}
+
+func TestDeleteCategoryWithSubCategories(t *testing.T) {
+	repo := newTestCategoryTree()
+	svc := NewCategoryService(repo)
+
+	res, err := svc.DeleteCategory(contextWithUser("admin"), &category.DeleteCategoryRequest{Id: "men"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.GetBase().GetStatusCode() != 400 || repo.deleted != "" {
+		t.Fatalf("got %d and deleted %q, want the category with sub-categories kept", res.GetBase().GetStatusCode(), repo.deleted)
+	}
+
+	if res, err = svc.DeleteCategory(contextWithUser("admin"), &category.DeleteCategoryRequest{Id: "shirts"}); err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("deleting a leaf failed: %v / %q", err, res.GetBase().GetMessage())
+	}
+	if repo.deleted != "shirts" {
+		t.Errorf("got deleted %q, want shirts", repo.deleted)
+	}
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"fmt"
+	"math/bits"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+)
+
+// couponRejection is returned from a checkout build when the applied coupon cannot be used.
+// Its message is meant for the client.
+type couponRejection struct {
+	message string
+}
+
+func (e *couponRejection) Error() string {
+	return e.message
+}
+
+// checkCoupon returns the message for the client when the coupon cannot be redeemed by a user who
+// already redeemed it userRedemptions times, or an empty string.
+func checkCoupon(coupon *entity.Coupon, userRedemptions int, now time.Time) string {
+	if !coupon.IsActive {
+		return "Coupon is not active"
+	}
+	if coupon.ValidFrom != nil && now.Before(*coupon.ValidFrom) {
+		return "Coupon is not valid yet"
+	}
+	if coupon.ValidTo != nil && !now.Before(*coupon.ValidTo) {
+		return "Coupon has expired"
+	}
+	if coupon.IsUsageLimitReached() {
+		return "Coupon usage limit has been reached"
+	}
+	if coupon.IsPerUserLimitReached(userRedemptions) {
+		return "You have already used this coupon the maximum number of times"
+	}
+	return ""
+}
+
+// couponDiscount returns the order-level discount, in minor units, that the cart coupon gives on lines.
+// Only Discountable lines count towards the discount; the minimum cart value applies to the whole cart.
+// When the coupon cannot be used it returns 0 and the message for the client.
+func couponDiscount(cartCoupon *entity.CartCoupon, lines []PricingLine, now time.Time) (int64, string) {
+	coupon := cartCoupon.Coupon
+	if message := checkCoupon(coupon, cartCoupon.UserRedemptions, now); message != "" {
+		return 0, message
+	}
+
+	var subtotal, eligibleSubtotal int64
+	for _, line := range lines {
+		if line.UnitPrice.Currency != coupon.MinCartValue.Currency {
+			return 0, "Coupon cannot be used with items priced in another currency"
+		}
+		lineSubtotal := line.UnitPrice.Times(line.Quantity).Amount
+		subtotal += lineSubtotal
+		if line.Discountable {
+			eligibleSubtotal += lineSubtotal
+		}
+	}
+	if eligibleSubtotal == 0 {
+		return 0, "Coupon does not apply to any item in the cart"
+	}
+	if subtotal < coupon.MinCartValue.Amount {
+		return 0, fmt.Sprintf("Cart subtotal must be at least %s to use this coupon", coupon.MinCartValue)
+	}
+
+	if coupon.DiscountType == entity.CouponDiscountPercentage {
+		// Dibulatkan ke bawah agar diskon tidak pernah melebihi persentase kupon
+		hi, lo := bits.Mul64(uint64(eligibleSubtotal), uint64(coupon.PercentOffBasisPoints))
+		discount, _ := bits.Div64(hi, lo, 10000)
+		return int64(discount), ""
+	}
+	return min(coupon.AmountOff.Amount, eligibleSubtotal), ""
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/common"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/coupon"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ICouponService defines the interface for coupon management. Access is enforced by the permission middleware.
+type ICouponService interface {
+	// CreateCoupon creates a coupon code. Codes are case-insensitive and stored in upper case.
+	CreateCoupon(ctx context.Context, request *coupon.CreateCouponRequest) (*coupon.CreateCouponResponse, error)
+	// ListCoupons retrieves a page of coupons.
+	ListCoupons(ctx context.Context, request *coupon.ListCouponsRequest) (*coupon.ListCouponsResponse, error)
+	// DeactivateCoupon stops a coupon from being applied to carts or redeemed at checkout.
+	DeactivateCoupon(ctx context.Context, request *coupon.DeactivateCouponRequest) (*coupon.DeactivateCouponResponse, error)
+}
+
+// CouponService implements ICouponService.
+type CouponService struct {
+	couponRepository   repository.ICouponRepository
+	productRepository  repository.IProductRepository
+	categoryRepository repository.ICategoryRepository
+}
+
+// NewCouponService creates a new instance of CouponService.
+func NewCouponService(couponRepository repository.ICouponRepository, productRepository repository.IProductRepository, categoryRepository repository.ICategoryRepository) ICouponService {
+	return &CouponService{
+		couponRepository:   couponRepository,
+		productRepository:  productRepository,
+		categoryRepository: categoryRepository,
+	}
+}
+
+func (s *CouponService) CreateCoupon(ctx context.Context, request *coupon.CreateCouponRequest) (*coupon.CreateCouponResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	newCoupon := &entity.Coupon{
+		Id:           uuid.NewString(),
+		Code:         strings.ToUpper(request.Code),
+		Description:  request.Description,
+		DiscountType: request.DiscountType,
+		AmountOff:    entity.NewMoney(0, entity.DefaultCurrency),
+		MinCartValue: entity.NewMoney(0, entity.DefaultCurrency),
+		IsActive:     true,
+		ProductIds:   request.ProductIds,
+		CategoryIds:  request.CategoryIds,
+		CreatedAt:    time.Now(),
+		CreatedBy:    claims.FullName,
+	}
+	if request.DiscountType == entity.CouponDiscountPercentage {
+		newCoupon.PercentOffBasisPoints = int64(request.PercentOffBasisPoints)
+	} else if request.AmountOff != nil {
+		newCoupon.AmountOff = entity.NewMoney(request.AmountOff.Amount, request.AmountOff.Currency)
+	}
+	if request.MinCartValue != nil {
+		newCoupon.MinCartValue = entity.NewMoney(request.MinCartValue.Amount, request.MinCartValue.Currency)
+	}
+	if request.ValidFrom != nil {
+		validFrom := request.ValidFrom.AsTime()
+		newCoupon.ValidFrom = &validFrom
+	}
+	if request.ValidTo != nil {
+		validTo := request.ValidTo.AsTime()
+		newCoupon.ValidTo = &validTo
+	}
+	// Batas 0 berarti tidak terbatas
+	if request.UsageLimit > 0 {
+		usageLimit := int(request.UsageLimit)
+		newCoupon.UsageLimit = &usageLimit
+	}
+	if request.PerUserLimit > 0 {
+		perUserLimit := int(request.PerUserLimit)
+		newCoupon.PerUserLimit = &perUserLimit
+	}
+
+	errMessage, err := s.validateCoupon(ctx, newCoupon)
+	if err != nil {
+		return nil, err
+	}
+	if errMessage != "" {
+		return &coupon.CreateCouponResponse{
+			Base: utils.BadRequestResponse(errMessage),
+		}, nil
+	}
+
+	existing, err := s.couponRepository.GetCouponByCode(ctx, newCoupon.Code)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return &coupon.CreateCouponResponse{
+			Base: utils.BadRequestResponse("Coupon code is already used"),
+		}, nil
+	}
+
+	err = s.couponRepository.CreateCoupon(ctx, newCoupon)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coupon.CreateCouponResponse{
+		Base: utils.SuccessResponse("Coupon created successfully"),
+		Id:   newCoupon.Id,
+	}, nil
+}
+
+// validateCoupon returns the message for the client when the coupon cannot be created, or an empty string.
+func (s *CouponService) validateCoupon(ctx context.Context, c *entity.Coupon) (string, error) {
+	switch c.DiscountType {
+	case entity.CouponDiscountPercentage:
+		if c.PercentOffBasisPoints <= 0 {
+			return "Percent off must be greater than 0", nil
+		}
+		// 10000 basis point = 100%; diskon lebih besar akan membuat total order negatif
+		if c.PercentOffBasisPoints > 10000 {
+			return "Percent off must not exceed 100%", nil
+		}
+	case entity.CouponDiscountFixedAmount:
+		if c.AmountOff.Amount <= 0 {
+			return "Amount off must be greater than 0", nil
+		}
+		if c.AmountOff.Currency != entity.DefaultCurrency {
+			return fmt.Sprintf("Amount off currency must be %s", entity.DefaultCurrency), nil
+		}
+	}
+	if c.MinCartValue.Currency != entity.DefaultCurrency {
+		return fmt.Sprintf("Minimum cart value currency must be %s", entity.DefaultCurrency), nil
+	}
+	if c.ValidFrom != nil && c.ValidTo != nil && !c.ValidFrom.Before(*c.ValidTo) {
+		return "Valid from must be before valid to", nil
+	}
+
+	if len(c.ProductIds) > 0 {
+		count, err := s.productRepository.CountActiveProducts(ctx, c.ProductIds)
+		if err != nil {
+			return "", err
+		}
+		if count != len(c.ProductIds) {
+			return "One or more products not found", nil
+		}
+	}
+	if len(c.CategoryIds) > 0 {
+		count, err := s.categoryRepository.CountActiveCategories(ctx, c.CategoryIds)
+		if err != nil {
+			return "", err
+		}
+		if count != len(c.CategoryIds) {
+			return "One or more categories not found", nil
+		}
+	}
+	return "", nil
+}
+
+func (s *CouponService) ListCoupons(ctx context.Context, request *coupon.ListCouponsRequest) (*coupon.ListCouponsResponse, error) {
+	const DefaultPage int32 = 1
+	const DefaultLimit int32 = 10
+
+	paginationReq := request.GetPagination()
+	page := paginationReq.GetPage()
+	limit := paginationReq.GetLimit()
+	sort := paginationReq.GetSort()
+
+	if page == 0 {
+		page = DefaultPage
+	}
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+
+	coupons, totalElements, err := s.couponRepository.ListCoupons(ctx, page, limit, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int32(math.Ceil(float64(totalElements) / float64(limit)))
+	if totalElements == 0 {
+		totalPages = 0
+	}
+
+	couponsData := make([]*coupon.Coupon, 0, len(coupons))
+	for _, c := range coupons {
+		couponsData = append(couponsData, toCouponResponse(c))
+	}
+
+	return &coupon.ListCouponsResponse{
+		Base: utils.SuccessResponse("Coupons retrieved successfully"),
+		Pagination: &common.PaginationResponse{
+			Page:          page,
+			Limit:         limit,
+			TotalPages:    totalPages,
+			TotalElements: totalElements,
+		},
+		Coupons: couponsData,
+	}, nil
+}
+
+func (s *CouponService) DeactivateCoupon(ctx context.Context, request *coupon.DeactivateCouponRequest) (*coupon.DeactivateCouponResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	existing, err := s.couponRepository.GetCouponById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return &coupon.DeactivateCouponResponse{
+			Base: utils.NotFoundResponse("Coupon not found"),
+		}, nil
+	}
+
+	err = s.couponRepository.DeactivateCoupon(ctx, request.Id, time.Now(), claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coupon.DeactivateCouponResponse{
+		Base: utils.SuccessResponse("Coupon deactivated successfully"),
+	}, nil
+}
+
+func toCouponResponse(c *entity.Coupon) *coupon.Coupon {
+	res := &coupon.Coupon{
+		Id:                    c.Id,
+		Code:                  c.Code,
+		Description:           c.Description,
+		DiscountType:          c.DiscountType,
+		PercentOffBasisPoints: int32(c.PercentOffBasisPoints),
+		AmountOff:             toMoneyResponse(c.AmountOff),
+		MinCartValue:          toMoneyResponse(c.MinCartValue),
+		RedemptionCount:       int32(c.RedemptionCount),
+		IsActive:              c.IsActive,
+		ProductIds:            c.ProductIds,
+		CategoryIds:           c.CategoryIds,
+		CreatedAt:             timestamppb.New(c.CreatedAt),
+		CreatedBy:             c.CreatedBy,
+	}
+	if c.ValidFrom != nil {
+		res.ValidFrom = timestamppb.New(*c.ValidFrom)
+	}
+	if c.ValidTo != nil {
+		res.ValidTo = timestamppb.New(*c.ValidTo)
+	}
+	if c.UsageLimit != nil {
+		res.UsageLimit = int32(*c.UsageLimit)
+	}
+	if c.PerUserLimit != nil {
+		res.PerUserLimit = int32(*c.PerUserLimit)
+	}
+	return res
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestCheckCoupon(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name            string
+		coupon          entity.Coupon
+		userRedemptions int
+		want            string
+	}{
+		{name: "valid", coupon: entity.Coupon{IsActive: true}},
+		{name: "inside validity window", coupon: entity.Coupon{IsActive: true, ValidFrom: timePtr(now.Add(-time.Hour)), ValidTo: timePtr(now.Add(time.Hour))}},
+		{name: "inactive", coupon: entity.Coupon{IsActive: false}, want: "Coupon is not active"},
+		{name: "not valid yet", coupon: entity.Coupon{IsActive: true, ValidFrom: timePtr(now.Add(time.Minute))}, want: "Coupon is not valid yet"},
+		{name: "expired", coupon: entity.Coupon{IsActive: true, ValidTo: timePtr(now.Add(-time.Minute))}, want: "Coupon has expired"},
+		{name: "expires at now", coupon: entity.Coupon{IsActive: true, ValidTo: timePtr(now)}, want: "Coupon has expired"},
+		{name: "usage limit reached", coupon: entity.Coupon{IsActive: true, UsageLimit: intPtr(1), RedemptionCount: 1}, want: "Coupon usage limit has been reached"},
+		{name: "usage limit not reached", coupon: entity.Coupon{IsActive: true, UsageLimit: intPtr(2), RedemptionCount: 1}},
+		{name: "per user limit reached", coupon: entity.Coupon{IsActive: true, PerUserLimit: intPtr(1)}, userRedemptions: 1,
+			want: "You have already used this coupon the maximum number of times"},
+		{name: "inactive wins over expired", coupon: entity.Coupon{IsActive: false, ValidTo: timePtr(now.Add(-time.Minute))}, want: "Coupon is not active"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := checkCoupon(&tt.coupon, tt.userRedemptions, now); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateCoupon(t *testing.T) {
+	percentage := func(basisPoints int64) *entity.Coupon {
+		return &entity.Coupon{DiscountType: entity.CouponDiscountPercentage, PercentOffBasisPoints: basisPoints, MinCartValue: entity.Money{Currency: entity.DefaultCurrency}}
+	}
+	tests := []struct {
+		name   string
+		coupon *entity.Coupon
+		want   string
+	}{
+		{name: "percentage", coupon: percentage(1000)},
+		{name: "full discount", coupon: percentage(10000)},
+		{name: "zero percent", coupon: percentage(0), want: "Percent off must be greater than 0"},
+		{name: "over 100 percent", coupon: percentage(10001), want: "Percent off must not exceed 100%"},
+		{name: "fixed amount", coupon: &entity.Coupon{DiscountType: entity.CouponDiscountFixedAmount, AmountOff: entity.Money{Amount: 500, Currency: entity.DefaultCurrency},
+			MinCartValue: entity.Money{Currency: entity.DefaultCurrency}}},
+		{name: "zero amount", coupon: &entity.Coupon{DiscountType: entity.CouponDiscountFixedAmount, AmountOff: entity.Money{Currency: entity.DefaultCurrency},
+			MinCartValue: entity.Money{Currency: entity.DefaultCurrency}}, want: "Amount off must be greater than 0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&CouponService{}).validateCoupon(context.Background(), tt.coupon)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCouponDiscount(t *testing.T) {
+	now := time.Now()
+	percentage := func(basisPoints int64, minCartValue int64) *entity.Coupon {
+		return &entity.Coupon{IsActive: true, DiscountType: entity.CouponDiscountPercentage, PercentOffBasisPoints: basisPoints,
+			AmountOff: idr(0), MinCartValue: idr(minCartValue)}
+	}
+	fixed := func(amount int64, minCartValue int64) *entity.Coupon {
+		return &entity.Coupon{IsActive: true, DiscountType: entity.CouponDiscountFixedAmount, AmountOff: idr(amount), MinCartValue: idr(minCartValue)}
+	}
+	tests := []struct {
+		name        string
+		coupon      *entity.Coupon
+		lines       []PricingLine
+		want        int64
+		wantMessage string
+	}{
+		{name: "percentage of eligible lines", coupon: percentage(1000, 0),
+			lines: []PricingLine{{UnitPrice: idr(10000), Quantity: 2, Discountable: true}, {UnitPrice: idr(5000), Quantity: 1}}, want: 2000},
+		{name: "percentage rounds down", coupon: percentage(1250, 0),
+			lines: []PricingLine{{UnitPrice: idr(999), Quantity: 1, Discountable: true}}, want: 124},
+		{name: "fixed amount", coupon: fixed(3000, 0),
+			lines: []PricingLine{{UnitPrice: idr(10000), Quantity: 1, Discountable: true}}, want: 3000},
+		{name: "fixed amount capped at eligible subtotal", coupon: fixed(3000, 0),
+			lines: []PricingLine{{UnitPrice: idr(1000), Quantity: 1, Discountable: true}, {UnitPrice: idr(9000), Quantity: 1}}, want: 1000},
+		{name: "minimum cart value counts every line", coupon: fixed(1000, 10000),
+			lines: []PricingLine{{UnitPrice: idr(4000), Quantity: 1, Discountable: true}, {UnitPrice: idr(6000), Quantity: 1}}, want: 1000},
+		{name: "below minimum cart value", coupon: fixed(1000, 10000),
+			lines:       []PricingLine{{UnitPrice: idr(9999), Quantity: 1, Discountable: true}},
+			wantMessage: "Cart subtotal must be at least IDR 100.00 to use this coupon"},
+		{name: "no eligible line", coupon: percentage(1000, 0),
+			lines: []PricingLine{{UnitPrice: idr(5000), Quantity: 1}}, wantMessage: "Coupon does not apply to any item in the cart"},
+		{name: "other currency", coupon: percentage(1000, 0),
+			lines:       []PricingLine{{UnitPrice: entity.NewMoney(500, "USD"), Quantity: 1, Discountable: true}},
+			wantMessage: "Coupon cannot be used with items priced in another currency"},
+		{name: "expired coupon gives nothing", coupon: &entity.Coupon{IsActive: true, DiscountType: entity.CouponDiscountFixedAmount,
+			AmountOff: idr(1000), MinCartValue: idr(0), ValidTo: timePtr(now.Add(-time.Second))},
+			lines: []PricingLine{{UnitPrice: idr(5000), Quantity: 1, Discountable: true}}, wantMessage: "Coupon has expired"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, message := couponDiscount(&entity.CartCoupon{Coupon: tt.coupon}, tt.lines, now)
+			if got != tt.want || message != tt.wantMessage {
+				t.Errorf("got %d, %q; want %d, %q", got, message, tt.want, tt.wantMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+)
+
+const FakePaymentProviderName = "fake"
+
+// FakePaymentProvider is a deterministic in-process gateway for tests and local development.
+// Every intent succeeds; IDs are derived from the payment reference so repeated runs produce the same values.
+type FakePaymentProvider struct {
+	webhookSecret []byte
+}
+
+func NewFakePaymentProvider(webhookSecret string) *FakePaymentProvider {
+	return &FakePaymentProvider{webhookSecret: []byte(webhookSecret)}
+}
+
+func (p *FakePaymentProvider) Name() string {
+	return FakePaymentProviderName
+}
+
+func (p *FakePaymentProvider) CreatePaymentIntent(ctx context.Context, request *PaymentIntentRequest) (*PaymentIntent, error) {
+	if request.Amount.Amount <= 0 {
+		return nil, fmt.Errorf("fake provider: amount must be positive")
+	}
+	intentID := "fake_pi_" + request.Reference
+	return &PaymentIntent{
+		ID:           intentID,
+		ClientSecret: intentID + "_secret_" + p.sign([]byte(intentID))[:16],
+		Status:       "requires_confirmation",
+	}, nil
+}
+
+func (p *FakePaymentProvider) CapturePayment(ctx context.Context, intentID string) (*PaymentIntent, error) {
+	return &PaymentIntent{
+		ID:     intentID,
+		Status: "succeeded",
+	}, nil
+}
+
+func (p *FakePaymentProvider) VoidPayment(ctx context.Context, intentID string) error {
+	return nil
+}
+
+func (p *FakePaymentProvider) RefundPayment(ctx context.Context, intentID string, amount entity.Money) (*PaymentRefund, error) {
+	return &PaymentRefund{
+		ID:     "fake_re_" + intentID,
+		Status: "succeeded",
+	}, nil
+}
+
+func (p *FakePaymentProvider) VerifyWebhook(payload []byte, signature string) (*PaymentWebhookEvent, error) {
+	expected := p.sign(payload)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	var event PaymentWebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload: %w", err)
+	}
+	return &event, nil
+}
+
+// SignWebhookPayload returns the signature the fake gateway would send for payload,
+// so dev tooling can simulate webhook deliveries.
+func (p *FakePaymentProvider) SignWebhookPayload(payload []byte) string {
+	return p.sign(payload)
+}
+
+func (p *FakePaymentProvider) sign(payload []byte) string {
+	mac := hmac.New(sha256.New, p.webhookSecret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+)
+
+// fakeAuthRepository keeps users in memory by id.
+type fakeAuthRepository struct {
+	repository.IAuthRepository
+
+	users map[string]*entity.User
+}
+
+func newFakeAuthRepository(users ...*entity.User) *fakeAuthRepository {
+	r := &fakeAuthRepository{users: map[string]*entity.User{}}
+	for _, u := range users {
+		r.users[u.Id] = u
+	}
+	return r
+}
+
+func (r *fakeAuthRepository) GetUserByEmail(ctx context.Context, email string) (*entity.User, error) {
+	for _, u := range r.users {
+		if u.Email == email && !u.IsDeleted {
+			copied := *u
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeAuthRepository) GetUserById(ctx context.Context, id string) (*entity.User, error) {
+	u, ok := r.users[id]
+	if !ok || u.IsDeleted {
+		return nil, nil
+	}
+	copied := *u
+	return &copied, nil
+}
+
+func (r *fakeAuthRepository) InsertUser(ctx context.Context, user *entity.User) error {
+	copied := *user
+	r.users[user.Id] = &copied
+	return nil
+}
+
+func (r *fakeAuthRepository) UpdateUserPassword(ctx context.Context, userID string, newHashedPassword string, updateBy string) error {
+	r.users[userID].Password = newHashedPassword
+	return nil
+}
+
+func (r *fakeAuthRepository) UpdateUserProfile(ctx context.Context, user *entity.User) error {
+	copied := *user
+	r.users[user.Id] = &copied
+	return nil
+}
+
+func (r *fakeAuthRepository) MarkEmailVerified(ctx context.Context, userID string, verifiedAt time.Time) error {
+	r.users[userID].EmailVerified = true
+	r.users[userID].EmailVerifiedAt = &verifiedAt
+	return nil
+}
+
+func (r *fakeAuthRepository) DeleteUserAccount(ctx context.Context, userID string, deletedAt time.Time, deletedBy string, anonymize bool) error {
+	r.users[userID].IsDeleted = true
+	return nil
+}
+
+// fakeUserTokenRepository keeps single-use tokens in memory with the same rules as the SQL repository.
+type fakeUserTokenRepository struct {
+	repository.IUserTokenRepository
+
+	tokens []*entity.UserToken
+	// users receives password resets; it is only needed by tests of ResetPassword
+	users *fakeAuthRepository
+}
+
+func (r *fakeUserTokenRepository) InsertUserToken(ctx context.Context, token *entity.UserToken) error {
+	copied := *token
+	r.tokens = append(r.tokens, &copied)
+	return nil
+}
+
+func (r *fakeUserTokenRepository) active(purpose string, tokenHash string, now time.Time) *entity.UserToken {
+	for _, t := range r.tokens {
+		if t.Purpose == purpose && t.TokenHash == tokenHash && t.UsedAt == nil && t.ExpiresAt.After(now) {
+			return t
+		}
+	}
+	return nil
+}
+
+func (r *fakeUserTokenRepository) ConsumeUserToken(ctx context.Context, purpose string, tokenHash string, now time.Time) (*entity.UserToken, error) {
+	t := r.active(purpose, tokenHash, now)
+	if t == nil {
+		return nil, nil
+	}
+	t.UsedAt = &now
+	copied := *t
+	return &copied, nil
+}
+
+func (r *fakeUserTokenRepository) GetActiveUserToken(ctx context.Context, purpose string, tokenHash string, now time.Time) (*entity.UserToken, error) {
+	t := r.active(purpose, tokenHash, now)
+	if t == nil {
+		return nil, nil
+	}
+	copied := *t
+	return &copied, nil
+}
+
+func (r *fakeUserTokenRepository) InvalidateUserTokens(ctx context.Context, userID string, purpose string) error {
+	now := time.Now()
+	for _, t := range r.tokens {
+		if t.UserId == userID && t.Purpose == purpose && t.UsedAt == nil {
+			t.UsedAt = &now
+		}
+	}
+	return nil
+}
+
+func (r *fakeUserTokenRepository) CountUserTokensSince(ctx context.Context, userID string, purpose string, since time.Time) (int, error) {
+	count := 0
+	for _, t := range r.tokens {
+		if t.UserId == userID && t.Purpose == purpose && !t.CreatedAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *fakeUserTokenRepository) ResetPasswordWithToken(ctx context.Context, tokenHash string, newHashedPassword string, now time.Time) (*entity.UserToken, error) {
+	t := r.active(entity.UserTokenPurposePasswordReset, tokenHash, now)
+	if t == nil {
+		return nil, nil
+	}
+	user, ok := r.users.users[t.UserId]
+	if !ok || user.IsDeleted {
+		return nil, nil
+	}
+	t.UsedAt = &now
+	user.Password = newHashedPassword
+	copied := *t
+	return &copied, nil
+}
+
+// fakeRefreshTokenRepository keeps refresh tokens in memory and records which users had their refresh tokens revoked.
+type fakeRefreshTokenRepository struct {
+	repository.IRefreshTokenRepository
+
+	revokedUsers    []string
+	revokedFamilies []string
+	inserted        []*entity.RefreshToken
+}
+
+func (r *fakeRefreshTokenRepository) InsertRefreshToken(ctx context.Context, token *entity.RefreshToken) error {
+	r.inserted = append(r.inserted, token)
+	return nil
+}
+
+func (r *fakeRefreshTokenRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error) {
+	for _, t := range r.inserted {
+		if t.TokenHash == tokenHash {
+			copied := *t
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeRefreshTokenRepository) byId(id string) *entity.RefreshToken {
+	for _, t := range r.inserted {
+		if t.Id == id {
+			return t
+		}
+	}
+	return nil
+}
+
+func (r *fakeRefreshTokenRepository) RotateRefreshToken(ctx context.Context, oldID string, next *entity.RefreshToken) (bool, error) {
+	old := r.byId(oldID)
+	if old == nil || old.RevokedAt != nil {
+		return false, nil
+	}
+	now := time.Now()
+	old.RevokedAt = &now
+	old.ReplacedById = &next.Id
+	r.inserted = append(r.inserted, next)
+	return true, nil
+}
+
+func (r *fakeRefreshTokenRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	r.revokedFamilies = append(r.revokedFamilies, familyID)
+	now := time.Now()
+	for _, t := range r.inserted {
+		if t.FamilyId == familyID && t.RevokedAt == nil {
+			t.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+func (r *fakeRefreshTokenRepository) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	r.revokedUsers = append(r.revokedUsers, userID)
+	return nil
+}
+
+// fakeRateLimitRepository never locks anything out.
+type fakeRateLimitRepository struct {
+	repository.IRateLimitRepository
+}
+
+func (r *fakeRateLimitRepository) GetLockedUntil(ctx context.Context, keys []string, now time.Time) (*time.Time, error) {
+	return nil, nil
+}
+
+func (r *fakeRateLimitRepository) RecordHit(ctx context.Context, key string, now time.Time, window time.Duration) (int, error) {
+	return 1, nil
+}
+
+func (r *fakeRateLimitRepository) ClearKey(ctx context.Context, key string) error {
+	return nil
+}
+
+// fakeUserIdentityRepository keeps login states and linked identities in memory.
+type fakeUserIdentityRepository struct {
+	repository.IUserIdentityRepository
+
+	users      *fakeAuthRepository
+	states     map[string]*entity.OIDCLoginState
+	identities []*entity.UserIdentity
+}
+
+func (r *fakeUserIdentityRepository) InsertLoginState(ctx context.Context, state *entity.OIDCLoginState) error {
+	r.states[state.StateHash] = state
+	return nil
+}
+
+func (r *fakeUserIdentityRepository) ConsumeLoginState(ctx context.Context, stateHash string, now time.Time) (*entity.OIDCLoginState, error) {
+	state, ok := r.states[stateHash]
+	delete(r.states, stateHash)
+	if !ok || !state.ExpiresAt.After(now) {
+		return nil, nil
+	}
+	return state, nil
+}
+
+func (r *fakeUserIdentityRepository) GetUserIdentity(ctx context.Context, provider string, subject string) (*entity.UserIdentity, error) {
+	for _, identity := range r.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			return identity, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeUserIdentityRepository) InsertUserIdentity(ctx context.Context, identity *entity.UserIdentity) error {
+	r.identities = append(r.identities, identity)
+	return nil
+}
+
+func (r *fakeUserIdentityRepository) CreateUserWithIdentity(ctx context.Context, user *entity.User, identity *entity.UserIdentity) error {
+	if err := r.users.InsertUser(ctx, user); err != nil {
+		return err
+	}
+	return r.InsertUserIdentity(ctx, identity)
+}
+
+func (r *fakeUserIdentityRepository) UpdateIdentityLogin(ctx context.Context, id string, email string, loginAt time.Time) error {
+	return nil
+}
+
+// fakeUserTOTPRepository keeps TOTP enrollments and recovery code hashes in memory.
+type fakeUserTOTPRepository struct {
+	repository.IUserTOTPRepository
+
+	totps         map[string]*entity.UserTOTP
+	recoveryCodes map[string][]string
+}
+
+func newFakeUserTOTPRepository() *fakeUserTOTPRepository {
+	return &fakeUserTOTPRepository{totps: map[string]*entity.UserTOTP{}, recoveryCodes: map[string][]string{}}
+}
+
+func (r *fakeUserTOTPRepository) GetUserTOTP(ctx context.Context, userID string) (*entity.UserTOTP, error) {
+	totp, ok := r.totps[userID]
+	if !ok {
+		return nil, nil
+	}
+	copied := *totp
+	return &copied, nil
+}
+
+func (r *fakeUserTOTPRepository) SavePendingTOTP(ctx context.Context, userID string, secretEncrypted string, now time.Time) (bool, error) {
+	if r.totps[userID].Enabled() {
+		return false, nil
+	}
+	r.totps[userID] = &entity.UserTOTP{UserId: userID, SecretEncrypted: secretEncrypted, CreatedAt: now}
+	return true, nil
+}
+
+func (r *fakeUserTOTPRepository) ConfirmTOTP(ctx context.Context, userID string, step int64, recoveryCodeHashes []string, now time.Time) error {
+	r.totps[userID].ConfirmedAt = &now
+	r.totps[userID].LastUsedStep = step
+	r.recoveryCodes[userID] = recoveryCodeHashes
+	return nil
+}
+
+func (r *fakeUserTOTPRepository) UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error) {
+	totp := r.totps[userID]
+	if step <= totp.LastUsedStep {
+		return false, nil
+	}
+	totp.LastUsedStep = step
+	return true, nil
+}
+
+func (r *fakeUserTOTPRepository) ConsumeRecoveryCode(ctx context.Context, userID string, codeHash string, now time.Time) (bool, error) {
+	for i, hash := range r.recoveryCodes[userID] {
+		if hash == codeHash {
+			r.recoveryCodes[userID] = append(r.recoveryCodes[userID][:i], r.recoveryCodes[userID][i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// fakeMailer records the messages it was asked to send.
+type fakeMailer struct {
+	sent []*MailMessage
+}
+
+func (m *fakeMailer) Send(ctx context.Context, message *MailMessage) error {
+	m.sent = append(m.sent, message)
+	return nil
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	GuestCartTTL = 30 * 24 * time.Hour
+	// GuestCartPurgeInterval is how often RunGuestCartPurge removes expired guest carts.
+	GuestCartPurgeInterval = time.Hour
+	// GuestCartTokenMetadataKey is the metadata key that carries the token returned by CreateGuestCart.
+	GuestCartTokenMetadataKey = "x-cart-token"
+)
+
+// guestCartThrottlePolicy limits how many guest carts one client IP may create, since CreateGuestCart needs no login.
+var guestCartThrottlePolicy = rateLimitPolicy{Threshold: 30, Window: 10 * time.Minute, BaseLockout: time.Minute, MaxLockout: time.Hour}
+
+func guestCartThrottleKey(ip string) string {
+	return rateLimitKey(rateLimitPurposeGuestCartIP, ip)
+}
+
+// RunGuestCartPurge deletes expired guest carts every interval until ctx is cancelled.
+func RunGuestCartPurge(ctx context.Context, guestCartRepository repository.IGuestCartRepository, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			purged, err := guestCartRepository.PurgeExpiredGuestCarts(ctx, now)
+			if err != nil {
+				log.Printf("failed to purge guest carts: %v", err)
+				continue
+			}
+			if purged > 0 {
+				log.Printf("purged %d expired guest carts", purged)
+			}
+		}
+	}
+}
+
+// checkGuestCartThrottle counts a guest cart created by the client IP and rejects the request while the IP is locked.
+func (s *CartService) checkGuestCartThrottle(ctx context.Context, now time.Time) error {
+	ip := clientIP(ctx, s.trustedProxyHops)
+	if ip == "" {
+		return nil
+	}
+	key := guestCartThrottleKey(ip)
+	lockedUntil, err := s.rateLimiter.lockedUntil(ctx, []string{key}, now)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if lockedUntil != nil {
+		return status.Errorf(codes.ResourceExhausted, "Too many guest carts created, please try again in %d seconds", int(math.Ceil(lockedUntil.Sub(now).Seconds())))
+	}
+	if err = s.rateLimiter.hit(ctx, key, guestCartThrottlePolicy, now); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// guestCartTokenFromContext returns the guest cart token sent in the request metadata, or "".
+func guestCartTokenFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(GuestCartTokenMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// cartFor returns the repository and owner id of the caller's cart, and the name recorded for changes.
+// A logged-in user always works on their own cart; otherwise the guest cart of the x-cart-token metadata is used.
+func (s *CartService) cartFor(ctx context.Context) (repository.ICartRepository, string, string, error) {
+	if claims, err := jwtentity.GetClaimsFromContext(ctx); err == nil {
+		return s.cartRepository, claims.Subject, claims.FullName, nil
+	}
+
+	token := guestCartTokenFromContext(ctx)
+	if token == "" {
+		return nil, "", "", utils.UnauthenticatedResponse()
+	}
+	guestCart, err := s.guestCartRepository.GetGuestCartByTokenHash(ctx, utils.HashToken(token), time.Now())
+	if err != nil {
+		return nil, "", "", status.Error(codes.Internal, err.Error())
+	}
+	if guestCart == nil {
+		return nil, "", "", status.Errorf(codes.Unauthenticated, "Guest cart not found or expired")
+	}
+	return s.guestCartRepository, guestCart.Id, entity.GuestCartActor, nil
+}
+
+// mergeGuestCart moves the guest cart sent with a successful login into the user's cart.
+// A failed merge is only logged so that it never blocks the login itself.
+func (s *authService) mergeGuestCart(ctx context.Context, user *entity.User, now time.Time) {
+	token := guestCartTokenFromContext(ctx)
+	if token == "" {
+		return
+	}
+	merged, err := s.guestCartRepository.MergeIntoUserCart(ctx, utils.HashToken(token), user.Id, user.FullName, now)
+	if err != nil {
+		log.Printf("failed to merge guest cart into cart of user %s: %v", user.Id, err)
+		return
+	}
+	if merged > 0 {
+		log.Printf("merged %d guest cart items into cart of user %s", merged, user.Id)
+	}
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/cart"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// fakeGuestCartRepository keeps guest carts in memory.
+type fakeGuestCartRepository struct {
+	repository.IGuestCartRepository
+
+	carts []*entity.GuestCart
+}
+
+func (r *fakeGuestCartRepository) CreateGuestCart(ctx context.Context, guestCart *entity.GuestCart) error {
+	r.carts = append(r.carts, guestCart)
+	return nil
+}
+
+func (r *fakeGuestCartRepository) PurgeExpiredGuestCarts(ctx context.Context, now time.Time) (int64, error) {
+	kept := r.carts[:0]
+	for _, guestCart := range r.carts {
+		if guestCart.ExpiresAt.After(now) {
+			kept = append(kept, guestCart)
+		}
+	}
+	purged := int64(len(r.carts) - len(kept))
+	r.carts = kept
+	return purged, nil
+}
+
+func contextFromIP(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000}})
+}
+
+func TestCreateGuestCartThrottle(t *testing.T) {
+	guestCarts := &fakeGuestCartRepository{}
+	throttle := newMemoryRateLimitRepository()
+	svc := NewCartService(nil, nil, nil, nil, guestCarts, nil, throttle, nil, 0)
+
+	for i := 0; i < guestCartThrottlePolicy.Threshold; i++ {
+		res, err := svc.CreateGuestCart(contextFromIP("203.0.113.7"), &cart.CreateGuestCartRequest{})
+		if err != nil {
+			t.Fatalf("create %d: %v", i, err)
+		}
+		if res.GetCartToken() == "" {
+			t.Fatalf("create %d returned no token", i)
+		}
+	}
+
+	_, err := svc.CreateGuestCart(contextFromIP("203.0.113.7"), &cart.CreateGuestCartRequest{})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("got %v after %d carts, want ResourceExhausted", err, guestCartThrottlePolicy.Threshold)
+	}
+	if len(guestCarts.carts) != guestCartThrottlePolicy.Threshold {
+		t.Errorf("got %d carts stored, want %d", len(guestCarts.carts), guestCartThrottlePolicy.Threshold)
+	}
+
+	// IP lain tidak ikut terkunci
+	if _, err = svc.CreateGuestCart(contextFromIP("198.51.100.1"), &cart.CreateGuestCartRequest{}); err != nil {
+		t.Errorf("other IP: %v", err)
+	}
+}
+
+func TestCreateGuestCartDoesNotPurge(t *testing.T) {
+	expired := &entity.GuestCart{Id: "expired", ExpiresAt: time.Now().Add(-time.Hour)}
+	guestCarts := &fakeGuestCartRepository{carts: []*entity.GuestCart{expired}}
+	svc := NewCartService(nil, nil, nil, nil, guestCarts, nil, newMemoryRateLimitRepository(), nil, 0)
+
+	if _, err := svc.CreateGuestCart(contextFromIP("203.0.113.7"), &cart.CreateGuestCartRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(guestCarts.carts) != 2 {
+		t.Fatalf("got %d carts, want the expired cart kept until the purge job runs", len(guestCarts.carts))
+	}
+}
+
+func TestRunGuestCartPurge(t *testing.T) {
+	expired := &entity.GuestCart{Id: "expired", ExpiresAt: time.Now().Add(-time.Hour)}
+	active := &entity.GuestCart{Id: "active", ExpiresAt: time.Now().Add(GuestCartTTL)}
+	guestCarts := &purgeSignalRepository{fakeGuestCartRepository: &fakeGuestCartRepository{carts: []*entity.GuestCart{expired, active}}, purged: make(chan struct{}, 1)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		RunGuestCartPurge(ctx, guestCarts, time.Millisecond)
+		close(done)
+	}()
+	select {
+	case <-guestCarts.purged:
+	case <-time.After(time.Second):
+		t.Fatal("purge did not run")
+	}
+	cancel()
+	<-done
+
+	if len(guestCarts.carts) != 1 || guestCarts.carts[0].Id != "active" {
+		t.Errorf("got %d carts after purge, want only the active cart", len(guestCarts.carts))
+	}
+}
+
+// purgeSignalRepository reports each purge on a channel.
+type purgeSignalRepository struct {
+	*fakeGuestCartRepository
+	purged chan struct{}
+}
+
+func (r *purgeSignalRepository) PurgeExpiredGuestCarts(ctx context.Context, now time.Time) (int64, error) {
+	purged, err := r.fakeGuestCartRepository.PurgeExpiredGuestCarts(ctx, now)
+	select {
+	case r.purged <- struct{}{}:
+	default:
+	}
+	return purged, err
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestMain(m *testing.M) {
+	// Access token yang diterbitkan service di test ditandatangani dengan HS256
+	os.Setenv("JWT_SECRET", "service-test-secret")
+	os.Exit(m.Run())
+}
+
+// contextWithUser returns a context carrying the claims the auth middleware sets for a logged-in user.
+func contextWithUser(userID string) context.Context {
+	claims := &jwtentity.JWTClaims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: userID},
+		FullName:         "Test User " + userID,
+		RoleCode:         "customer",
+	}
+	return claims.SetToContext(context.Background())
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/inventory"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IInventoryService defines the interface for stock-related business logic.
+type IInventoryService interface {
+	// SetStock overwrites the stock of a product (requires inventory:write).
+	SetStock(ctx context.Context, request *inventory.SetStockRequest) (*inventory.SetStockResponse, error)
+	// AdjustStock adds or removes stock of a product (requires inventory:write).
+	AdjustStock(ctx context.Context, request *inventory.AdjustStockRequest) (*inventory.AdjustStockResponse, error)
+	// GetStock retrieves the available stock of a product.
+	GetStock(ctx context.Context, request *inventory.GetStockRequest) (*inventory.GetStockResponse, error)
+}
+
+// InventoryService implements IInventoryService.
+type InventoryService struct {
+	inventoryRepository repository.IInventoryRepository
+	productRepository   repository.IProductRepository
+	variantRepository   repository.IProductVariantRepository
+}
+
+// NewInventoryService creates a new instance of InventoryService.
+func NewInventoryService(inventoryRepository repository.IInventoryRepository, productRepository repository.IProductRepository, variantRepository repository.IProductVariantRepository) IInventoryService {
+	return &InventoryService{
+		inventoryRepository: inventoryRepository,
+		productRepository:   productRepository,
+		variantRepository:   variantRepository,
+	}
+}
+
+// SetStock overwrites the available stock of an existing product, or of one of its variants when variant_id is set.
+func (s *InventoryService) SetStock(ctx context.Context, request *inventory.SetStockRequest) (*inventory.SetStockResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	productData, err := s.productRepository.GetProductById(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productData == nil {
+		return &inventory.SetStockResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	if request.VariantId != "" {
+		var found bool
+		found, err = s.variantBelongsToProduct(ctx, request.VariantId, productData.Id)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return &inventory.SetStockResponse{
+				Base: utils.NotFoundResponse("Product variant not found"),
+			}, nil
+		}
+		err = s.inventoryRepository.SetVariantStock(ctx, request.VariantId, int(request.Quantity), claims.FullName)
+	} else {
+		err = s.inventoryRepository.SetStock(ctx, &entity.ProductStock{
+			ProductId: productData.Id,
+			Quantity:  int(request.Quantity),
+			UpdatedAt: time.Now(),
+			UpdatedBy: claims.FullName,
+		})
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &inventory.SetStockResponse{
+		Base:      utils.SuccessResponse("Stock updated successfully"),
+		ProductId: productData.Id,
+		Quantity:  request.Quantity,
+	}, nil
+}
+
+// AdjustStock adds a positive or negative delta to the available stock of an existing product or variant.
+func (s *InventoryService) AdjustStock(ctx context.Context, request *inventory.AdjustStockRequest) (*inventory.AdjustStockResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	productData, err := s.productRepository.GetProductById(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productData == nil {
+		return &inventory.AdjustStockResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	var quantity int
+	if request.VariantId != "" {
+		var found bool
+		found, err = s.variantBelongsToProduct(ctx, request.VariantId, productData.Id)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return &inventory.AdjustStockResponse{
+				Base: utils.NotFoundResponse("Product variant not found"),
+			}, nil
+		}
+		quantity, err = s.inventoryRepository.AdjustVariantStock(ctx, request.VariantId, int(request.Delta), claims.FullName)
+	} else {
+		quantity, err = s.inventoryRepository.AdjustStock(ctx, productData.Id, int(request.Delta), claims.FullName)
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrInsufficientStock) {
+			return &inventory.AdjustStockResponse{
+				Base: utils.BadRequestResponse("Stock cannot be negative"),
+			}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &inventory.AdjustStockResponse{
+		Base:      utils.SuccessResponse("Stock adjusted successfully"),
+		ProductId: productData.Id,
+		Quantity:  int32(quantity),
+	}, nil
+}
+
+// GetStock retrieves the available stock of a product or of one of its variants.
+func (s *InventoryService) GetStock(ctx context.Context, request *inventory.GetStockRequest) (*inventory.GetStockResponse, error) {
+	productData, err := s.productRepository.GetProductById(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productData == nil {
+		return &inventory.GetStockResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	var quantity int
+	if request.VariantId != "" {
+		var found bool
+		found, err = s.variantBelongsToProduct(ctx, request.VariantId, productData.Id)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return &inventory.GetStockResponse{
+				Base: utils.NotFoundResponse("Product variant not found"),
+			}, nil
+		}
+		quantity, err = s.inventoryRepository.GetVariantStock(ctx, request.VariantId)
+	} else {
+		quantity, err = s.inventoryRepository.GetStock(ctx, productData.Id)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &inventory.GetStockResponse{
+		Base:      utils.SuccessResponse("Stock retrieved successfully"),
+		ProductId: productData.Id,
+		Quantity:  int32(quantity),
+		InStock:   quantity > 0,
+	}, nil
+}
+
+func (s *InventoryService) variantBelongsToProduct(ctx context.Context, variantID string, productID string) (bool, error) {
+	variant, err := s.variantRepository.GetVariantById(ctx, variantID)
+	if err != nil {
+		return false, err
+	}
+	return variant != nil && variant.ProductId == productID, nil
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/inventory"
+)
+
+// fakeInventoryRepository keeps product and variant stock in memory and, like the real one, never lets it go negative.
+type fakeInventoryRepository struct {
+	repository.IInventoryRepository
+
+	stock        map[string]int
+	variantStock map[string]int
+}
+
+func (r *fakeInventoryRepository) GetStock(ctx context.Context, productID string) (int, error) {
+	return r.stock[productID], nil
+}
+
+func (r *fakeInventoryRepository) SetStock(ctx context.Context, stock *entity.ProductStock) error {
+	r.stock[stock.ProductId] = stock.Quantity
+	return nil
+}
+
+func (r *fakeInventoryRepository) AdjustStock(ctx context.Context, productID string, delta int, updatedBy string) (int, error) {
+	return adjustFakeStock(r.stock, productID, delta)
+}
+
+func (r *fakeInventoryRepository) GetVariantStock(ctx context.Context, variantID string) (int, error) {
+	return r.variantStock[variantID], nil
+}
+
+func (r *fakeInventoryRepository) AdjustVariantStock(ctx context.Context, variantID string, delta int, updatedBy string) (int, error) {
+	return adjustFakeStock(r.variantStock, variantID, delta)
+}
+
+func adjustFakeStock(stock map[string]int, id string, delta int) (int, error) {
+	if stock[id]+delta < 0 {
+		return 0, repository.ErrInsufficientStock
+	}
+	stock[id] += delta
+	return stock[id], nil
+}
+
+type fakeVariantRepository struct {
+	repository.IProductVariantRepository
+
+	variants map[string]*entity.ProductVariant
+}
+
+func (r *fakeVariantRepository) GetVariantById(ctx context.Context, id string) (*entity.ProductVariant, error) {
+	return r.variants[id], nil
+}
+
+func (r *fakeVariantRepository) HasVariants(ctx context.Context, productID string) (bool, error) {
+	for _, v := range r.variants {
+		if v.ProductId == productID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func newTestInventoryService() (IInventoryService, *fakeInventoryRepository) {
+	products := &fakeProductRepository{products: map[string]*entity.Product{"p1": {Id: "p1"}, "p2": {Id: "p2"}}}
+	variants := &fakeVariantRepository{variants: map[string]*entity.ProductVariant{"v1": {Id: "v1", ProductId: "p1"}}}
+	inventoryRepo := &fakeInventoryRepository{stock: map[string]int{"p1": 2}, variantStock: map[string]int{"v1": 1}}
+	return NewInventoryService(inventoryRepo, products, variants), inventoryRepo
+}
+
+func TestAdjustStock(t *testing.T) {
+	tests := []struct {
+		name         string
+		request      *inventory.AdjustStockRequest
+		wantCode     int64
+		wantQuantity int32
+	}{
+		{name: "add", request: &inventory.AdjustStockRequest{ProductId: "p1", Delta: 3}, wantCode: 200, wantQuantity: 5},
+		{name: "remove all", request: &inventory.AdjustStockRequest{ProductId: "p1", Delta: -2}, wantCode: 200, wantQuantity: 0},
+		{name: "below zero", request: &inventory.AdjustStockRequest{ProductId: "p1", Delta: -3}, wantCode: 400},
+		{name: "unknown product", request: &inventory.AdjustStockRequest{ProductId: "missing", Delta: 1}, wantCode: 404},
+		{name: "variant", request: &inventory.AdjustStockRequest{ProductId: "p1", VariantId: "v1", Delta: -1}, wantCode: 200, wantQuantity: 0},
+		{name: "variant below zero", request: &inventory.AdjustStockRequest{ProductId: "p1", VariantId: "v1", Delta: -2}, wantCode: 400},
+		{name: "variant of another product", request: &inventory.AdjustStockRequest{ProductId: "p2", VariantId: "v1", Delta: 1}, wantCode: 404},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := newTestInventoryService()
+			res, err := svc.AdjustStock(contextWithUser("admin"), tt.request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.GetBase().GetStatusCode() != tt.wantCode || res.GetQuantity() != tt.wantQuantity {
+				t.Errorf("got %d %q with quantity %d, want %d with quantity %d",
+					res.GetBase().GetStatusCode(), res.GetBase().GetMessage(), res.GetQuantity(), tt.wantCode, tt.wantQuantity)
+			}
+		})
+	}
+}
+
+func TestGetStock(t *testing.T) {
+	svc, _ := newTestInventoryService()
+	tests := []struct {
+		request      *inventory.GetStockRequest
+		wantQuantity int32
+		wantInStock  bool
+	}{
+		{request: &inventory.GetStockRequest{ProductId: "p1"}, wantQuantity: 2, wantInStock: true},
+		{request: &inventory.GetStockRequest{ProductId: "p2"}},
+		{request: &inventory.GetStockRequest{ProductId: "p1", VariantId: "v1"}, wantQuantity: 1, wantInStock: true},
+	}
+	for _, tt := range tests {
+		res, err := svc.GetStock(context.Background(), tt.request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.GetQuantity() != tt.wantQuantity || res.GetInStock() != tt.wantInStock {
+			t.Errorf("%v: got %d (in stock %v), want %d (%v)", tt.request, res.GetQuantity(), res.GetInStock(), tt.wantQuantity, tt.wantInStock)
+		}
+	}
+}
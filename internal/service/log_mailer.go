@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// LogMailer is a mailer for local development. Only the recipient and subject are logged, since bodies carry
+// verification and password reset tokens; when a directory is configured the full message is saved as an .eml file
+// readable only by the server user, so links can be opened from there.
+type LogMailer struct {
+	dir string
+}
+
+func NewLogMailer(dir string) *LogMailer {
+	return &LogMailer{dir: dir}
+}
+
+func (m *LogMailer) Send(ctx context.Context, message *MailMessage) error {
+	log.Printf("MAIL to=%s subject=%q", message.To, message.Subject)
+	if m.dir == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(m.dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create mail directory: %w", err)
+	}
+	fileName := filepath.Join(m.dir, fmt.Sprintf("%d.eml", time.Now().UnixNano()))
+	if err := os.WriteFile(fileName, buildMailBody("noreply@localhost", message), 0o600); err != nil {
+		return fmt.Errorf("failed to write mail file: %w", err)
+	}
+	return nil
+}
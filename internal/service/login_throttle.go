@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+var (
+	accountLoginThrottlePolicy = rateLimitPolicy{Threshold: 5, Window: 15 * time.Minute, BaseLockout: time.Minute, MaxLockout: time.Hour}
+	// Satu IP bisa dipakai banyak user (NAT, kantor), jadi ambangnya lebih longgar
+	ipLoginThrottlePolicy = rateLimitPolicy{Threshold: 20, Window: 15 * time.Minute, BaseLockout: time.Minute, MaxLockout: time.Hour}
+)
+
+// loginThrottle rate limits failed logins per account and per client IP.
+type loginThrottle struct {
+	limiter *rateLimiter
+	// trustedProxyHops is the number of reverse proxies in front of the server, see clientIP
+	trustedProxyHops int
+}
+
+func accountThrottleKey(email string) string {
+	return rateLimitKey(rateLimitPurposeLoginAccount, strings.ToLower(strings.TrimSpace(email)))
+}
+
+func ipThrottleKey(ip string) string {
+	return rateLimitKey(rateLimitPurposeLoginIP, ip)
+}
+
+// lockedUntil returns when the account or the client IP may try again, or nil when neither is locked.
+func (t *loginThrottle) lockedUntil(ctx context.Context, email string, now time.Time) (*time.Time, error) {
+	keys := []string{accountThrottleKey(email)}
+	if ip := t.clientIP(ctx); ip != "" {
+		keys = append(keys, ipThrottleKey(ip))
+	}
+	return t.limiter.lockedUntil(ctx, keys, now)
+}
+
+// recordFailure counts a failed login for the account and the client IP and locks whichever crossed its threshold.
+func (t *loginThrottle) recordFailure(ctx context.Context, email string, now time.Time) error {
+	if err := t.limiter.hit(ctx, accountThrottleKey(email), accountLoginThrottlePolicy, now); err != nil {
+		return err
+	}
+	if ip := t.clientIP(ctx); ip != "" {
+		return t.limiter.hit(ctx, ipThrottleKey(ip), ipLoginThrottlePolicy, now)
+	}
+	return nil
+}
+
+// reset clears the failures of an account after a successful login or an admin unlock.
+// The IP counter is left alone so one valid account cannot be used to keep guessing others.
+func (t *loginThrottle) reset(ctx context.Context, email string) error {
+	return t.limiter.reset(ctx, accountThrottleKey(email))
+}
+
+func (t *loginThrottle) clientIP(ctx context.Context) string {
+	return clientIP(ctx, t.trustedProxyHops)
+}
+
+// ParseTrustedProxyHops parses TRUSTED_PROXY_HOPS, the number of reverse proxies that append to x-forwarded-for.
+// An empty value means the server is reached directly.
+func ParseTrustedProxyHops(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	hops, err := strconv.Atoi(value)
+	if err != nil || hops < 0 {
+		return 0, fmt.Errorf("invalid trusted proxy hops %q", value)
+	}
+	return hops, nil
+}
+
+// clientIP returns the IP address of the client behind trustedProxyHops reverse proxies.
+// Every trusted proxy appends the address it received the request from to x-forwarded-for, so the client is the
+// entry trustedProxyHops from the right; entries further left are set by the client and ignored.
+// Without trusted proxies, or when the header has fewer entries than expected, the peer address is used.
+func clientIP(ctx context.Context, trustedProxyHops int) string {
+	if trustedProxyHops > 0 {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			var entries []string
+			for _, value := range md.Get("x-forwarded-for") {
+				entries = append(entries, strings.Split(value, ",")...)
+			}
+			if len(entries) >= trustedProxyHops {
+				if ip := net.ParseIP(strings.TrimSpace(entries[len(entries)-trustedProxyHops])); ip != nil {
+					return ip.String()
+				}
+			}
+		}
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
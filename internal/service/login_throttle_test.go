@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/auth"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestClientIP(t *testing.T) {
+	peerAddr := &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 50000}
+	tests := []struct {
+		name      string
+		hops      int
+		forwarded []string
+		want      string
+	}{
+		{name: "no proxy ignores header", hops: 0, forwarded: []string{"203.0.113.7"}, want: "10.0.0.2"},
+		{name: "one proxy takes rightmost entry", hops: 1, forwarded: []string{"198.51.100.1, 203.0.113.7"}, want: "203.0.113.7"},
+		{name: "spoofed leftmost entry ignored", hops: 1, forwarded: []string{"1.2.3.4, 5.6.7.8, 203.0.113.7"}, want: "203.0.113.7"},
+		{name: "two proxies", hops: 2, forwarded: []string{"1.2.3.4, 203.0.113.7, 10.0.0.5"}, want: "203.0.113.7"},
+		{name: "repeated headers", hops: 2, forwarded: []string{"1.2.3.4, 203.0.113.7", "10.0.0.5"}, want: "203.0.113.7"},
+		{name: "fewer entries than hops", hops: 2, forwarded: []string{"203.0.113.7"}, want: "10.0.0.2"},
+		{name: "missing header", hops: 1, want: "10.0.0.2"},
+		{name: "invalid entry", hops: 1, forwarded: []string{"not-an-ip"}, want: "10.0.0.2"},
+		{name: "ipv6", hops: 1, forwarded: []string{"2001:db8::1"}, want: "2001:db8::1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: peerAddr})
+			if tt.forwarded != nil {
+				md := metadata.MD{}
+				md.Append("x-forwarded-for", tt.forwarded...)
+				ctx = metadata.NewIncomingContext(ctx, md)
+			}
+			if got := clientIP(ctx, tt.hops); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTrustedProxyHops(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{value: "", want: 0},
+		{value: "0", want: 0},
+		{value: "2", want: 2},
+		{value: "-1", wantErr: true},
+		{value: "true", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseTrustedProxyHops(tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseTrustedProxyHops(%q) = %d, %v; want %d, error %v", tt.value, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func newLoginThrottleTestService(t *testing.T) *authService {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &authService{
+		authRepository:         newFakeAuthRepository(&entity.User{Id: "u1", Email: "user@example.com", FullName: "User", Password: string(hash)}),
+		refreshTokenRepository: &fakeRefreshTokenRepository{},
+		userTOTPRepository:     newFakeUserTOTPRepository(),
+		loginThrottle:          &loginThrottle{limiter: &rateLimiter{repository: newMemoryRateLimitRepository()}},
+	}
+}
+
+func TestLoginLockout(t *testing.T) {
+	svc := newLoginThrottleTestService(t)
+	ctx := contextFromIP("203.0.113.7")
+	wrong := &auth.LoginRequest{Email: "user@example.com", Password: "wrong"}
+
+	for i := 0; i < accountLoginThrottlePolicy.Threshold; i++ {
+		_, err := svc.Login(ctx, wrong)
+		if status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("attempt %d: got %v, want Unauthenticated", i+1, err)
+		}
+	}
+
+	// Password benar pun ditolak selama akun terkunci
+	_, err := svc.Login(ctx, &auth.LoginRequest{Email: "User@Example.com", Password: "password"})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("got %v, want ResourceExhausted while the account is locked", err)
+	}
+
+	res, err := svc.UnlockAccount(contextWithUser("admin"), &auth.UnlockAccountRequest{UserId: "u1"})
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("UnlockAccount: %v / %q", err, res.GetBase().GetMessage())
+	}
+	if _, err = svc.Login(ctx, &auth.LoginRequest{Email: "user@example.com", Password: "password"}); err != nil {
+		t.Errorf("got %v, want the unlocked account to log in", err)
+	}
+}
+
+func TestLoginSuccessResetsAccountFailures(t *testing.T) {
+	svc := newLoginThrottleTestService(t)
+	ctx := contextFromIP("203.0.113.7")
+	wrong := &auth.LoginRequest{Email: "user@example.com", Password: "wrong"}
+
+	for round := 0; round < 2; round++ {
+		for i := 0; i < accountLoginThrottlePolicy.Threshold-1; i++ {
+			if _, err := svc.Login(ctx, wrong); status.Code(err) != codes.Unauthenticated {
+				t.Fatalf("got %v, want Unauthenticated", err)
+			}
+		}
+		if _, err := svc.Login(ctx, &auth.LoginRequest{Email: "user@example.com", Password: "password"}); err != nil {
+			t.Fatalf("round %d: got %v, want the login to succeed below the threshold", round+1, err)
+		}
+	}
+}
+
+func TestLoginErrorsDoNotRevealAccounts(t *testing.T) {
+	svc := newLoginThrottleTestService(t)
+
+	_, wrongPassword := svc.Login(contextFromIP("203.0.113.7"), &auth.LoginRequest{Email: "user@example.com", Password: "wrong"})
+	_, unknownEmail := svc.Login(contextFromIP("203.0.113.8"), &auth.LoginRequest{Email: "nobody@example.com", Password: "wrong"})
+	if status.Code(wrongPassword) != codes.Unauthenticated || status.Convert(wrongPassword).Message() != status.Convert(unknownEmail).Message() ||
+		status.Code(unknownEmail) != status.Code(wrongPassword) {
+		t.Errorf("got %v for a wrong password and %v for an unknown email, want the same error", wrongPassword, unknownEmail)
+	}
+}
+
+func TestLoginLockoutPerIP(t *testing.T) {
+	svc := newLoginThrottleTestService(t)
+	ctx := contextFromIP("203.0.113.7")
+
+	// Menebak banyak akun dari satu IP, masing-masing di bawah ambang akun
+	for i := 0; i < ipLoginThrottlePolicy.Threshold; i++ {
+		email := "guess" + strconv.Itoa(i) + "@example.com"
+		if _, err := svc.Login(ctx, &auth.LoginRequest{Email: email, Password: "wrong"}); status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("attempt %d: got %v, want Unauthenticated", i+1, err)
+		}
+	}
+
+	if _, err := svc.Login(ctx, &auth.LoginRequest{Email: "user@example.com", Password: "password"}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("got %v, want ResourceExhausted from the locked IP", err)
+	}
+	if _, err := svc.Login(contextFromIP("203.0.113.8"), &auth.LoginRequest{Email: "user@example.com", Password: "password"}); err != nil {
+		t.Errorf("got %v, want other IPs to be unaffected", err)
+	}
+}
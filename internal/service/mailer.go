@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+const (
+	SMTPMailerName = "smtp"
+	LogMailerName  = "log"
+)
+
+type MailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// IMailer sends transactional email such as verification links.
+type IMailer interface {
+	Send(ctx context.Context, message *MailMessage) error
+}
+
+type MailerConfig struct {
+	From         string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	// LogDir, when set, makes the log mailer also write every message to a file in this directory.
+	LogDir string
+	// Dev allows MAILER to be left empty, selecting the log mailer. Elsewhere the mailer must be chosen explicitly.
+	Dev bool
+}
+
+// NewMailer returns the mailer selected by name (MAILER).
+func NewMailer(name string, config MailerConfig) (IMailer, error) {
+	if name == "" {
+		if !config.Dev {
+			return nil, fmt.Errorf("MAILER is required outside development")
+		}
+		name = LogMailerName
+	}
+	switch name {
+	case LogMailerName:
+		return NewLogMailer(config.LogDir), nil
+	case SMTPMailerName:
+		if config.SMTPHost == "" || config.From == "" {
+			return nil, fmt.Errorf("smtp mailer requires SMTP_HOST and MAIL_FROM")
+		}
+		return NewSMTPMailer(config), nil
+	default:
+		return nil, fmt.Errorf("unknown mailer %q", name)
+	}
+}
+
+type SMTPMailer struct {
+	config MailerConfig
+}
+
+func NewSMTPMailer(config MailerConfig) *SMTPMailer {
+	if config.SMTPPort == "" {
+		config.SMTPPort = "587"
+	}
+	return &SMTPMailer{config: config}
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, message *MailMessage) error {
+	var auth smtp.Auth
+	if m.config.SMTPUsername != "" {
+		auth = smtp.PlainAuth("", m.config.SMTPUsername, m.config.SMTPPassword, m.config.SMTPHost)
+	}
+	addr := net.JoinHostPort(m.config.SMTPHost, m.config.SMTPPort)
+	if err := smtp.SendMail(addr, auth, m.config.From, []string{message.To}, buildMailBody(m.config.From, message)); err != nil {
+		return fmt.Errorf("failed to send mail to %s: %w", message.To, err)
+	}
+	return nil
+}
+
+func buildMailBody(from string, message *MailMessage) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + message.To + "\r\n")
+	b.WriteString("Subject: " + message.Subject + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewMailer(t *testing.T) {
+	smtpConfig := MailerConfig{From: "noreply@example.com", SMTPHost: "smtp.example.com"}
+	tests := []struct {
+		name    string
+		mailer  string
+		config  MailerConfig
+		want    any
+		wantErr bool
+	}{
+		{name: "empty in dev selects log mailer", mailer: "", config: MailerConfig{Dev: true}, want: &LogMailer{}},
+		{name: "empty outside dev", mailer: "", config: MailerConfig{}, wantErr: true},
+		{name: "explicit log mailer", mailer: LogMailerName, config: MailerConfig{}, want: &LogMailer{}},
+		{name: "smtp", mailer: SMTPMailerName, config: smtpConfig, want: &SMTPMailer{}},
+		{name: "smtp without host", mailer: SMTPMailerName, config: MailerConfig{From: "noreply@example.com"}, wantErr: true},
+		{name: "unknown mailer", mailer: "sendmail", config: MailerConfig{Dev: true}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mailer, err := NewMailer(tt.mailer, tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			switch tt.want.(type) {
+			case *LogMailer:
+				if _, ok := mailer.(*LogMailer); !ok {
+					t.Errorf("got %T, want *LogMailer", mailer)
+				}
+			case *SMTPMailer:
+				if _, ok := mailer.(*SMTPMailer); !ok {
+					t.Errorf("got %T, want *SMTPMailer", mailer)
+				}
+			}
+		})
+	}
+}
+
+func TestLogMailerDoesNotLogBody(t *testing.T) {
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	dir := filepath.Join(t.TempDir(), "mail")
+	body := "Open https://example.com/verify-email?token=secret-token"
+	err := NewLogMailer(dir).Send(context.Background(), &MailMessage{To: "user@example.com", Subject: "Verify", Body: body})
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+
+	if strings.Contains(logged.String(), "secret-token") {
+		t.Errorf("log contains the message body: %q", logged.String())
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil || len(files) != 1 {
+		t.Fatalf("got %d mail files (%v), want 1", len(files), err)
+	}
+	info, err := files[0].Info()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("got mail file mode %o, want 600", perm)
+	}
+}
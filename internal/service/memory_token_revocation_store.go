@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+)
+
+// MemoryTokenRevocationStore keeps revocations in process memory.
+// Revocations are lost on restart and are not visible to other replicas.
+type MemoryTokenRevocationStore struct {
+	cache *gocache.Cache
+}
+
+func NewMemoryTokenRevocationStore(purgeInterval time.Duration) *MemoryTokenRevocationStore {
+	return &MemoryTokenRevocationStore{cache: gocache.New(gocache.NoExpiration, purgeInterval)}
+}
+
+func (s *MemoryTokenRevocationStore) Revoke(ctx context.Context, jti string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	s.cache.Set(jti, struct{}{}, ttl)
+	return nil
+}
+
+func (s *MemoryTokenRevocationStore) RevokeUser(ctx context.Context, userID string, revokedBefore time.Time, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	revokedBefore = revocationCutoff(revokedBefore)
+	if current, ok := s.cache.Get(userRevocationKey(userID)); ok && current.(time.Time).After(revokedBefore) {
+		return nil
+	}
+	s.cache.Set(userRevocationKey(userID), revokedBefore, ttl)
+	return nil
+}
+
+func (s *MemoryTokenRevocationStore) IsRevoked(ctx context.Context, jti string, userID string, issuedAt time.Time) (bool, error) {
+	if _, ok := s.cache.Get(jti); ok {
+		return true, nil
+	}
+	revokedBefore, ok := s.cache.Get(userRevocationKey(userID))
+	return ok && issuedBeforeCutoff(issuedAt, revokedBefore.(time.Time)), nil
+}
+
+// userRevocationKey keeps user entries apart from token IDs in the shared cache.
+func userRevocationKey(userID string) string {
+	return "user:" + userID
+}
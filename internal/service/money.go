@@ -0,0 +1,50 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/common"
+)
+
+func toMoneyResponse(m entity.Money) *common.Money {
+	return &common.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+// moneyFromRequest returns the price sent by a client. New clients send Money; older clients only send the
+// deprecated double field, which is in major units of the store currency.
+func moneyFromRequest(money *common.Money, legacy float64) entity.Money {
+	if money != nil {
+		return entity.NewMoney(money.Amount, money.Currency)
+	}
+	return entity.MoneyFromMajor(legacy, entity.DefaultCurrency)
+}
+
+// optionalMoneyFromRequest is moneyFromRequest for optional prices such as a variant price override.
+// It returns nil when the client sent neither field.
+func optionalMoneyFromRequest(money *common.Money, legacy *float64) *entity.Money {
+	if money == nil && legacy == nil {
+		return nil
+	}
+	var legacyPrice float64
+	if legacy != nil {
+		legacyPrice = *legacy
+	}
+	price := moneyFromRequest(money, legacyPrice)
+	return &price
+}
+
+// validatePrice returns the message for the client when price cannot be used as a product or variant price,
+// or an empty string. Prices must be positive and in the store currency.
+func validatePrice(price entity.Money) string {
+	if price.Amount <= 0 {
+		return "Price must be greater than 0"
+	}
+	if price.Currency != entity.DefaultCurrency {
+		return fmt.Sprintf("Price currency must be %s", entity.DefaultCurrency)
+	}
+	return ""
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/common"
+)
+
+func TestOptionalMoneyFromRequest(t *testing.T) {
+	legacy := 12500.5
+	tests := []struct {
+		name   string
+		money  *common.Money
+		legacy *float64
+		want   *entity.Money
+	}{
+		{name: "money field", money: &common.Money{Amount: 1250000, Currency: "IDR"}, legacy: &legacy, want: &entity.Money{Amount: 1250000, Currency: "IDR"}},
+		{name: "legacy double in the store currency", legacy: &legacy, want: &entity.Money{Amount: 1250050, Currency: entity.DefaultCurrency}},
+		{name: "neither field"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := optionalMoneyFromRequest(tt.money, tt.legacy)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatePrice(t *testing.T) {
+	tests := []struct {
+		price     entity.Money
+		wantValid bool
+	}{
+		{price: entity.NewMoney(1, entity.DefaultCurrency), wantValid: true},
+		{price: entity.NewMoney(0, entity.DefaultCurrency)},
+		{price: entity.NewMoney(-100, entity.DefaultCurrency)},
+		{price: entity.NewMoney(1000, "USD")},
+	}
+	for _, tt := range tests {
+		if got := validatePrice(tt.price) == ""; got != tt.wantValid {
+			t.Errorf("validatePrice(%v) valid = %v, want %v", tt.price, got, tt.wantValid)
+		}
+	}
+}
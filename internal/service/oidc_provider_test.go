@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	stubOIDCClientID = "stub-client"
+	stubOIDCKeyID    = "stub-key"
+)
+
+// stubOIDCServer serves a discovery document, a JWKS and a token endpoint that returns idToken.
+type stubOIDCServer struct {
+	*httptest.Server
+
+	key     *rsa.PrivateKey
+	idToken string
+}
+
+func newStubOIDCServer(t *testing.T) *stubOIDCServer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &stubOIDCServer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(oidcDiscovery{
+			Issuer:                s.URL,
+			AuthorizationEndpoint: s.URL + "/authorize",
+			TokenEndpoint:         s.URL + "/token",
+			JWKSURI:               s.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jwtentity.JSONWebKeySet{Keys: []jwtentity.JSONWebKey{{
+			Kty: "RSA",
+			Kid: stubOIDCKeyID,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"id_token": s.idToken})
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *stubOIDCServer) provider() *OIDCProvider {
+	return NewOIDCProvider(OIDCProviderConfig{Name: "stub", Issuer: s.URL, ClientID: stubOIDCClientID, RedirectURL: "https://shop.example.com/callback"})
+}
+
+// claims returns valid ID token claims for nonce that tests then break one at a time.
+func (s *stubOIDCServer) claims(nonce string) *oidcIDTokenClaims {
+	now := time.Now()
+	return &oidcIDTokenClaims{
+		Nonce:         nonce,
+		Email:         "oidc@example.com",
+		EmailVerified: true,
+		Name:          "OIDC User",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.URL,
+			Subject:   "subject-1",
+			Audience:  jwt.ClaimStrings{stubOIDCClientID},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(5 * time.Minute)),
+		},
+	}
+}
+
+func (s *stubOIDCServer) sign(t *testing.T, claims *oidcIDTokenClaims, key *rsa.PrivateKey) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = stubOIDCKeyID
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	server := newStubOIDCServer(t)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		nonce   string
+		modify  func(c *oidcIDTokenClaims)
+		key     *rsa.PrivateKey
+		wantErr bool
+	}{
+		{name: "valid", nonce: "nonce-1"},
+		{name: "bad issuer", nonce: "nonce-1", modify: func(c *oidcIDTokenClaims) { c.Issuer = "https://evil.example.com" }, wantErr: true},
+		{name: "bad audience", nonce: "nonce-1", modify: func(c *oidcIDTokenClaims) { c.Audience = jwt.ClaimStrings{"other-client"} }, wantErr: true},
+		{name: "bad authorized party", nonce: "nonce-1", modify: func(c *oidcIDTokenClaims) { c.AuthorizedParty = "other-client" }, wantErr: true},
+		{name: "bad nonce", nonce: "nonce-1", modify: func(c *oidcIDTokenClaims) { c.Nonce = "nonce-2" }, wantErr: true},
+		{name: "missing nonce", nonce: "", modify: func(c *oidcIDTokenClaims) { c.Nonce = "" }, wantErr: true},
+		{name: "expired", nonce: "nonce-1", modify: func(c *oidcIDTokenClaims) {
+			c.IssuedAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+			c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-10 * time.Minute))
+		}, wantErr: true},
+		{name: "missing expiry", nonce: "nonce-1", modify: func(c *oidcIDTokenClaims) { c.ExpiresAt = nil }, wantErr: true},
+		{name: "missing subject", nonce: "nonce-1", modify: func(c *oidcIDTokenClaims) { c.Subject = "" }, wantErr: true},
+		{name: "signed by another key", nonce: "nonce-1", key: otherKey, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := server.claims("nonce-1")
+			if tt.modify != nil {
+				tt.modify(claims)
+			}
+			key := server.key
+			if tt.key != nil {
+				key = tt.key
+			}
+
+			identity, err := server.provider().VerifyIDToken(context.Background(), server.sign(t, claims, key), tt.nonce)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidIDToken) {
+					t.Errorf("got %v, want ErrInvalidIDToken", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyIDToken returned error: %v", err)
+			}
+			if identity.Subject != "subject-1" || identity.Email != "oidc@example.com" || !identity.EmailVerified {
+				t.Errorf("got identity %+v", identity)
+			}
+		})
+	}
+}
+
+func TestOIDCBoolAcceptsStrings(t *testing.T) {
+	for _, tt := range []struct {
+		value string
+		want  bool
+	}{{`"true"`, true}, {`"false"`, false}, {`false`, false}} {
+		var claims oidcIDTokenClaims
+		if err := json.Unmarshal([]byte(`{"email_verified":`+tt.value+`}`), &claims); err != nil {
+			t.Fatal(err)
+		}
+		if bool(claims.EmailVerified) != tt.want {
+			t.Errorf("email_verified %s: got %v, want %v", tt.value, claims.EmailVerified, tt.want)
+		}
+	}
+}
+
+func TestDiscoveryIssuerMismatch(t *testing.T) {
+	server := newStubOIDCServer(t)
+	provider := NewOIDCProvider(OIDCProviderConfig{Name: "stub", Issuer: server.URL + "/tenant", ClientID: stubOIDCClientID})
+
+	if _, err := provider.AuthCodeURL(context.Background(), "state", "nonce", "verifier"); err == nil {
+		t.Error("got no error for a discovery document with another issuer")
+	}
+}
@@ -0,0 +1,435 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/common"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/order"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errEmptyCart = errors.New("cart is empty")
+
+// IOrderService defines the interface for order-related business logic.
+type IOrderService interface {
+	// Checkout converts the user's cart into a new order.
+	Checkout(ctx context.Context, request *order.CheckoutRequest) (*order.CheckoutResponse, error)
+	// GetOrder retrieves a single order owned by the user.
+	GetOrder(ctx context.Context, request *order.GetOrderRequest) (*order.GetOrderResponse, error)
+	// ListMyOrders retrieves a page of the user's orders.
+	ListMyOrders(ctx context.Context, request *order.ListMyOrdersRequest) (*order.ListMyOrdersResponse, error)
+	// UpdateOrderStatus moves an order to a new status (requires order:write).
+	UpdateOrderStatus(ctx context.Context, request *order.UpdateOrderStatusRequest) (*order.UpdateOrderStatusResponse, error)
+	// GetOrderTimeline retrieves the status history of an order.
+	GetOrderTimeline(ctx context.Context, request *order.GetOrderTimelineRequest) (*order.GetOrderTimelineResponse, error)
+}
+
+// OrderService implements IOrderService.
+type OrderService struct {
+	orderRepository   repository.IOrderRepository
+	addressRepository repository.IAddressRepository
+	pricingEngine     *PricingEngine
+}
+
+// NewOrderService creates a new instance of OrderService.
+func NewOrderService(orderRepository repository.IOrderRepository, addressRepository repository.IAddressRepository, pricingEngine *PricingEngine) IOrderService {
+	return &OrderService{
+		orderRepository:   orderRepository,
+		addressRepository: addressRepository,
+		pricingEngine:     pricingEngine,
+	}
+}
+
+// Checkout snapshots the user's cart into an order and clears the cart atomically.
+func (s *OrderService) Checkout(ctx context.Context, request *order.CheckoutRequest) (*order.CheckoutResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	// Tanpa id, alamat default yang dipakai; alamat penagihan jatuh ke alamat pengiriman
+	shippingAddress, err := s.resolveCheckoutAddress(ctx, claims.Subject, request.ShippingAddressId, entity.AddressTypeShipping)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if shippingAddress == nil {
+		if request.ShippingAddressId != "" {
+			return &order.CheckoutResponse{
+				Base: utils.NotFoundResponse("Shipping address not found"),
+			}, nil
+		}
+		return &order.CheckoutResponse{
+			Base: utils.BadRequestResponse("Shipping address is required"),
+		}, nil
+	}
+	billingAddress, err := s.resolveCheckoutAddress(ctx, claims.Subject, request.BillingAddressId, entity.AddressTypeBilling)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if billingAddress == nil {
+		if request.BillingAddressId != "" {
+			return &order.CheckoutResponse{
+				Base: utils.NotFoundResponse("Billing address not found"),
+			}, nil
+		}
+		billingAddress = shippingAddress
+	}
+
+	var breakdown *PriceBreakdown
+	newOrder, err := s.orderRepository.Checkout(ctx, claims.Subject, func(lines []*entity.CartLine, coupon *entity.CartCoupon) (*entity.Order, error) {
+		if len(lines) == 0 {
+			return nil, errEmptyCart
+		}
+
+		now := time.Now()
+		o := &entity.Order{
+			Id:        uuid.NewString(),
+			UserId:    claims.Subject,
+			Status:    entity.OrderStatusPendingPayment,
+			CreatedAt: now,
+			CreatedBy: claims.FullName,
+		}
+		o.ShippingAddress = shippingAddress.Snapshot(o.Id, entity.AddressTypeShipping)
+		o.BillingAddress = billingAddress.Snapshot(o.Id, entity.AddressTypeBilling)
+
+		// Harga dihitung dengan pricing engine yang sama dengan ListCart, dari harga yang dibaca di dalam transaksi
+		pricingLines := make([]PricingLine, 0, len(lines))
+		for _, line := range lines {
+			pricingLines = append(pricingLines, PricingLine{
+				UnitPrice:    line.Price,
+				Quantity:     int64(line.Quantity),
+				Discountable: coupon != nil && coupon.EligibleProductIds[line.ProductID],
+			})
+		}
+
+		// Kupon yang tidak lagi berlaku menggagalkan checkout, bukan diabaikan diam-diam
+		var discount int64
+		if coupon != nil {
+			var message string
+			if discount, message = couponDiscount(coupon, pricingLines, now); message != "" {
+				return nil, &couponRejection{message: message}
+			}
+			o.CouponId = &coupon.Coupon.Id
+			o.CouponCode = &coupon.Coupon.Code
+		}
+
+		var err error
+		if breakdown, err = s.pricingEngine.Price(pricingLines, discount); err != nil {
+			return nil, err
+		}
+
+		for i, line := range lines {
+			priced := breakdown.Lines[i]
+			o.Items = append(o.Items, &entity.OrderItem{
+				Id:          uuid.NewString(),
+				OrderId:     o.Id,
+				ProductId:   line.ProductID,
+				ProductName: line.ProductName,
+				VariantId:   line.VariantID,
+				Sku:         line.VariantSku,
+				VariantName: line.VariantName,
+				Price:       breakdown.Money(priced.UnitPrice),
+				Quantity:    line.Quantity,
+				Discount:    breakdown.Money(priced.Discount),
+				Tax:         breakdown.Money(priced.Tax),
+				Total:       breakdown.Money(priced.Total),
+				CreatedAt:   now,
+			})
+			o.TotalQuantity += line.Quantity
+		}
+		o.Subtotal = breakdown.Money(breakdown.Subtotal)
+		o.DiscountTotal = breakdown.Money(breakdown.Discount)
+		o.TaxTotal = breakdown.Money(breakdown.Tax)
+		o.ShippingTotal = breakdown.Money(breakdown.Shipping)
+		o.TotalPrice = breakdown.Money(breakdown.GrandTotal)
+		return o, nil
+	})
+	if err != nil {
+		if errors.Is(err, errEmptyCart) {
+			return &order.CheckoutResponse{
+				Base: utils.BadRequestResponse("Cart is empty"),
+			}, nil
+		}
+		if errors.Is(err, repository.ErrInsufficientStock) {
+			return &order.CheckoutResponse{
+				Base: utils.BadRequestResponse(err.Error()),
+			}, nil
+		}
+		if errors.Is(err, ErrCurrencyMismatch) {
+			return &order.CheckoutResponse{
+				Base: utils.BadRequestResponse("Cart contains items priced in another currency"),
+			}, nil
+		}
+		var rejection *couponRejection
+		if errors.As(err, &rejection) {
+			return &order.CheckoutResponse{
+				Base: utils.BadRequestResponse(rejection.message),
+			}, nil
+		}
+		if errors.Is(err, repository.ErrCouponUsageLimitReached) {
+			return &order.CheckoutResponse{
+				Base: utils.BadRequestResponse("Coupon usage limit has been reached"),
+			}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &order.CheckoutResponse{
+		Base:            utils.SuccessResponse("Checkout successful"),
+		Id:              newOrder.Id,
+		TotalPrice:      newOrder.TotalPrice.Major(),
+		PriceBreakdown:  breakdown.toResponse(),
+		TotalPriceMoney: toMoneyResponse(newOrder.TotalPrice),
+	}, nil
+}
+
+// resolveCheckoutAddress returns the user's address with the given id, or their default address of addressType when id is empty.
+// It returns nil when there is no such address.
+func (s *OrderService) resolveCheckoutAddress(ctx context.Context, userID string, addressID string, addressType string) (*entity.Address, error) {
+	if addressID != "" {
+		return s.addressRepository.GetAddress(ctx, userID, addressID)
+	}
+	return s.addressRepository.GetDefaultAddress(ctx, userID, addressType)
+}
+
+// GetOrder retrieves an order with its items for the authenticated user.
+func (s *OrderService) GetOrder(ctx context.Context, request *order.GetOrderRequest) (*order.GetOrderResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	orderData, err := s.orderRepository.GetOrderById(ctx, request.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// Order milik user lain diperlakukan sama seperti order yang tidak ada
+	if orderData == nil || orderData.UserId != claims.Subject {
+		return &order.GetOrderResponse{
+			Base: utils.NotFoundResponse("Order not found"),
+		}, nil
+	}
+
+	return &order.GetOrderResponse{
+		Base:  utils.SuccessResponse("Order retrieved successfully"),
+		Order: toOrderResponse(orderData),
+	}, nil
+}
+
+// ListMyOrders retrieves a paginated list of the authenticated user's orders.
+func (s *OrderService) ListMyOrders(ctx context.Context, request *order.ListMyOrdersRequest) (*order.ListMyOrdersResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	const DefaultPage int32 = 1
+	const DefaultLimit int32 = 10
+
+	paginationReq := request.GetPagination()
+	page := paginationReq.GetPage()
+	limit := paginationReq.GetLimit()
+	sort := paginationReq.GetSort()
+
+	if page == 0 {
+		page = DefaultPage
+	}
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+
+	orders, totalElements, err := s.orderRepository.ListOrdersByUserID(ctx, claims.Subject, page, limit, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int32(math.Ceil(float64(totalElements) / float64(limit)))
+	if totalElements == 0 {
+		totalPages = 0
+	}
+
+	ordersData := make([]*order.Order, 0, len(orders))
+	for _, o := range orders {
+		ordersData = append(ordersData, toOrderResponse(o))
+	}
+
+	return &order.ListMyOrdersResponse{
+		Base: utils.SuccessResponse("Orders retrieved successfully"),
+		Pagination: &common.PaginationResponse{
+			Page:          page,
+			Limit:         limit,
+			TotalPages:    totalPages,
+			TotalElements: totalElements,
+		},
+		Orders: ordersData,
+	}, nil
+}
+
+// UpdateOrderStatus applies an admin status transition, rejecting moves the state machine does not allow and moves to
+// PAID or REFUNDED, which only the payment service makes.
+func (s *OrderService) UpdateOrderStatus(ctx context.Context, request *order.UpdateOrderStatusRequest) (*order.UpdateOrderStatusResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	orderData, err := s.orderRepository.GetOrderById(ctx, request.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if orderData == nil {
+		return &order.UpdateOrderStatusResponse{
+			Base: utils.NotFoundResponse("Order not found"),
+		}, nil
+	}
+
+	if !entity.CanAdminTransitionOrderStatus(orderData.Status, request.Status) {
+		return &order.UpdateOrderStatusResponse{
+			Base: utils.BadRequestResponse(fmt.Sprintf("Cannot change order status from %s to %s", orderData.Status, request.Status)),
+		}, nil
+	}
+
+	fromStatus := orderData.Status
+	updated, err := s.orderRepository.UpdateOrderStatus(ctx, orderData.Id, fromStatus, &entity.OrderStatusHistory{
+		Id:              uuid.NewString(),
+		OrderId:         orderData.Id,
+		FromStatus:      &fromStatus,
+		ToStatus:        request.Status,
+		Reason:          request.Reason,
+		ChangedByUserId: claims.Subject,
+		ChangedBy:       claims.FullName,
+		CreatedAt:       time.Now(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !updated {
+		return &order.UpdateOrderStatusResponse{
+			Base: utils.BadRequestResponse("Order status has been changed by another request, please retry"),
+		}, nil
+	}
+
+	return &order.UpdateOrderStatusResponse{
+		Base:   utils.SuccessResponse("Order status updated successfully"),
+		Id:     orderData.Id,
+		Status: request.Status,
+	}, nil
+}
+
+// GetOrderTimeline retrieves the status history of an order owned by the user, or any order for callers with order:read.
+func (s *OrderService) GetOrderTimeline(ctx context.Context, request *order.GetOrderTimelineRequest) (*order.GetOrderTimelineResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	orderData, err := s.orderRepository.GetOrderById(ctx, request.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if orderData == nil || (orderData.UserId != claims.Subject && !entity.HasPermission(ctx, entity.PermissionOrderRead)) {
+		return &order.GetOrderTimelineResponse{
+			Base: utils.NotFoundResponse("Order not found"),
+		}, nil
+	}
+
+	histories, err := s.orderRepository.GetOrderStatusHistory(ctx, orderData.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	historiesData := make([]*order.OrderStatusHistory, 0, len(histories))
+	for _, h := range histories {
+		historiesData = append(historiesData, &order.OrderStatusHistory{
+			FromStatus:      utils.SafeDerefString(h.FromStatus),
+			ToStatus:        h.ToStatus,
+			Reason:          h.Reason,
+			ChangedByUserId: h.ChangedByUserId,
+			ChangedBy:       h.ChangedBy,
+			ChangedAt:       timestamppb.New(h.CreatedAt),
+		})
+	}
+
+	return &order.GetOrderTimelineResponse{
+		Base:      utils.SuccessResponse("Order timeline retrieved successfully"),
+		Id:        orderData.Id,
+		Status:    orderData.Status,
+		Histories: historiesData,
+	}, nil
+}
+
+func toOrderResponse(o *entity.Order) *order.Order {
+	items := make([]*order.OrderItem, 0, len(o.Items))
+	for _, item := range o.Items {
+		subtotal := item.Price.Times(int64(item.Quantity))
+		items = append(items, &order.OrderItem{
+			Id:            item.Id,
+			ProductId:     item.ProductId,
+			ProductName:   item.ProductName,
+			Price:         item.Price.Major(),
+			Quantity:      int32(item.Quantity),
+			Subtotal:      subtotal.Major(),
+			VariantId:     utils.SafeDerefString(item.VariantId),
+			Sku:           utils.SafeDerefString(item.Sku),
+			VariantName:   utils.SafeDerefString(item.VariantName),
+			Discount:      item.Discount.Major(),
+			Tax:           item.Tax.Major(),
+			Total:         item.Total.Major(),
+			PriceMoney:    toMoneyResponse(item.Price),
+			SubtotalMoney: toMoneyResponse(subtotal),
+			DiscountMoney: toMoneyResponse(item.Discount),
+			TaxMoney:      toMoneyResponse(item.Tax),
+			TotalMoney:    toMoneyResponse(item.Total),
+		})
+	}
+
+	// Breakdown disusun dari total yang tersimpan, bukan dihitung ulang
+	breakdown := &PriceBreakdown{
+		Currency:   o.TotalPrice.Currency,
+		Subtotal:   o.Subtotal.Amount,
+		Discount:   o.DiscountTotal.Amount,
+		Tax:        o.TaxTotal.Amount,
+		Shipping:   o.ShippingTotal.Amount,
+		GrandTotal: o.TotalPrice.Amount,
+	}
+	return &order.Order{
+		Id:              o.Id,
+		Status:          o.Status,
+		TotalPrice:      o.TotalPrice.Major(),
+		TotalQuantity:   int32(o.TotalQuantity),
+		Items:           items,
+		CreatedAt:       timestamppb.New(o.CreatedAt),
+		ShippingAddress: toOrderAddressResponse(o.ShippingAddress),
+		BillingAddress:  toOrderAddressResponse(o.BillingAddress),
+		PriceBreakdown:  breakdown.toResponse(),
+		TotalPriceMoney: toMoneyResponse(o.TotalPrice),
+		CouponCode:      utils.SafeDerefString(o.CouponCode),
+	}
+}
+
+func toOrderAddressResponse(a *entity.OrderAddress) *order.OrderAddress {
+	if a == nil {
+		return nil
+	}
+	return &order.OrderAddress{
+		RecipientName: a.RecipientName,
+		Phone:         a.Phone,
+		Line1:         a.Line1,
+		Line2:         a.Line2,
+		City:          a.City,
+		Region:        a.Region,
+		PostalCode:    a.PostalCode,
+		CountryCode:   a.CountryCode,
+	}
+}
@@ -0,0 +1,351 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/order"
+	"github.com/google/uuid"
+)
+
+// fakeOrderRepository gives every user the same cart, with coupon applied when set.
+// Like the real repository, checkouts run one after another and a coupon redemption is counted
+// against the usage limit before the order is stored.
+type fakeOrderRepository struct {
+	repository.IOrderRepository
+
+	mu         sync.Mutex
+	lines      []*entity.CartLine
+	coupon     *entity.Coupon
+	reserveErr error
+	orders     map[string]*entity.Order
+	histories  []*entity.OrderStatusHistory
+}
+
+func (r *fakeOrderRepository) Checkout(ctx context.Context, userID string, build repository.CheckoutFunc) (*entity.Order, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var cartCoupon *entity.CartCoupon
+	if r.coupon != nil {
+		coupon := *r.coupon
+		eligible := map[string]bool{}
+		for _, line := range r.lines {
+			eligible[line.ProductID] = true
+		}
+		cartCoupon = &entity.CartCoupon{Coupon: &coupon, EligibleProductIds: eligible}
+	}
+	o, err := build(r.lines, cartCoupon)
+	if err != nil {
+		return nil, err
+	}
+	if r.reserveErr != nil {
+		return nil, r.reserveErr
+	}
+	if o.CouponId != nil {
+		if r.coupon.IsUsageLimitReached() {
+			return nil, repository.ErrCouponUsageLimitReached
+		}
+		r.coupon.RedemptionCount++
+	}
+	r.orders[o.Id] = o
+	return o, nil
+}
+
+func (r *fakeOrderRepository) GetOrderById(ctx context.Context, orderID string) (*entity.Order, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.orders[orderID], nil
+}
+
+func (r *fakeOrderRepository) UpdateOrderStatus(ctx context.Context, orderID string, fromStatus string, history *entity.OrderStatusHistory) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	o := r.orders[orderID]
+	if o == nil || o.Status != fromStatus {
+		return false, nil
+	}
+	o.Status = history.ToStatus
+	r.histories = append(r.histories, history)
+	return true, nil
+}
+
+// fakeCheckoutAddressRepository returns address, owned by any user, as their default address; nil means none.
+type fakeCheckoutAddressRepository struct {
+	repository.IAddressRepository
+
+	address *entity.Address
+}
+
+func (r *fakeCheckoutAddressRepository) GetDefaultAddress(ctx context.Context, userID string, addressType string) (*entity.Address, error) {
+	if r.address == nil {
+		return nil, nil
+	}
+	a := *r.address
+	a.UserId = userID
+	return &a, nil
+}
+
+func (r *fakeCheckoutAddressRepository) GetAddress(ctx context.Context, userID string, addressID string) (*entity.Address, error) {
+	if r.address == nil || r.address.Id != addressID {
+		return nil, nil
+	}
+	return r.GetDefaultAddress(ctx, userID, "")
+}
+
+func testAddress() *entity.Address {
+	return &entity.Address{Id: "a1", RecipientName: "User", Phone: "0800000000", Line1: "Street 1", City: "Jakarta", PostalCode: "10110", CountryCode: "ID"}
+}
+
+func testCartLines() []*entity.CartLine {
+	return []*entity.CartLine{{CartID: uuid.New(), ProductID: "p1", ProductName: "Product", Price: idr(100000), Quantity: 1}}
+}
+
+func newTestOrderService(repo *fakeOrderRepository, address *entity.Address) IOrderService {
+	if repo.orders == nil {
+		repo.orders = map[string]*entity.Order{}
+	}
+	return NewOrderService(repo, &fakeCheckoutAddressRepository{address: address}, NewPricingEngine(PricingConfig{Currency: "IDR"}))
+}
+
+func newTestCheckout(coupon *entity.Coupon) (IOrderService, *fakeOrderRepository) {
+	repo := &fakeOrderRepository{lines: testCartLines(), coupon: coupon}
+	return newTestOrderService(repo, testAddress()), repo
+}
+
+func testCoupon() *entity.Coupon {
+	return &entity.Coupon{Id: "c1", Code: "ONCE", IsActive: true, DiscountType: entity.CouponDiscountFixedAmount,
+		AmountOff: idr(10000), MinCartValue: idr(0), UsageLimit: intPtr(1)}
+}
+
+func TestCheckoutConcurrentSingleUseCoupon(t *testing.T) {
+	svc, repo := newTestCheckout(testCoupon())
+
+	const checkouts = 10
+	responses := make([]*order.CheckoutResponse, checkouts)
+	errs := make([]error, checkouts)
+	var wg sync.WaitGroup
+	for i := 0; i < checkouts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			responses[i], errs[i] = svc.Checkout(contextWithUser(fmt.Sprintf("u%d", i)), &order.CheckoutRequest{})
+		}(i)
+	}
+	wg.Wait()
+
+	var succeeded int
+	for i, res := range responses {
+		if errs[i] != nil {
+			t.Fatalf("checkout %d: %v", i, errs[i])
+		}
+		if !res.GetBase().GetIsError() {
+			succeeded++
+			if got := res.GetPriceBreakdown().GetDiscountMoney().GetAmount(); got != 10000 {
+				t.Errorf("checkout %d: got discount %d, want 10000", i, got)
+			}
+			continue
+		}
+		if res.GetBase().GetMessage() != "Coupon usage limit has been reached" {
+			t.Errorf("checkout %d: got %q", i, res.GetBase().GetMessage())
+		}
+	}
+	if succeeded != 1 || repo.coupon.RedemptionCount != 1 || len(repo.orders) != 1 {
+		t.Errorf("got %d successful checkouts, %d redemptions and %d orders, want one of each", succeeded, repo.coupon.RedemptionCount, len(repo.orders))
+	}
+}
+
+func TestCheckoutRejectsUnusableCoupon(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *entity.Coupon)
+		want   string
+	}{
+		{name: "expired", modify: func(c *entity.Coupon) { c.ValidTo = timePtr(time.Now().Add(-time.Minute)) }, want: "Coupon has expired"},
+		{name: "inactive", modify: func(c *entity.Coupon) { c.IsActive = false }, want: "Coupon is not active"},
+		{name: "not valid yet", modify: func(c *entity.Coupon) { c.ValidFrom = timePtr(time.Now().Add(time.Hour)) }, want: "Coupon is not valid yet"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coupon := testCoupon()
+			tt.modify(coupon)
+			svc, repo := newTestCheckout(coupon)
+
+			res, err := svc.Checkout(contextWithUser("u1"), &order.CheckoutRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !res.GetBase().GetIsError() || res.GetBase().GetMessage() != tt.want {
+				t.Errorf("got %q, want rejection %q", res.GetBase().GetMessage(), tt.want)
+			}
+			if len(repo.orders) != 0 || repo.coupon.RedemptionCount != 0 {
+				t.Errorf("rejected checkout stored %d orders and %d redemptions", len(repo.orders), repo.coupon.RedemptionCount)
+			}
+		})
+	}
+}
+
+func TestCheckout(t *testing.T) {
+	lines := []*entity.CartLine{
+		{CartID: uuid.New(), ProductID: "p1", ProductName: "Shirt", Price: idr(150000), Quantity: 2},
+		{CartID: uuid.New(), ProductID: "p2", ProductName: "Hat", Price: idr(50000), Quantity: 1},
+	}
+	repo := &fakeOrderRepository{lines: lines}
+	svc := newTestOrderService(repo, testAddress())
+
+	res, err := svc.Checkout(contextWithUser("u1"), &order.CheckoutRequest{})
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("checkout failed: %v / %q", err, res.GetBase().GetMessage())
+	}
+	o := repo.orders[res.GetId()]
+	if o == nil {
+		t.Fatalf("order %q was not stored", res.GetId())
+	}
+	if o.UserId != "u1" || o.Status != entity.OrderStatusPendingPayment || o.TotalQuantity != 3 || len(o.Items) != 2 {
+		t.Errorf("got order %+v", o)
+	}
+	if o.TotalPrice.Amount != 350000 || res.GetTotalPriceMoney().GetAmount() != 350000 {
+		t.Errorf("got total %d, response %d, want 350000", o.TotalPrice.Amount, res.GetTotalPriceMoney().GetAmount())
+	}
+	if o.Items[0].ProductName != "Shirt" || o.Items[0].Price.Amount != 150000 || o.Items[0].Quantity != 2 {
+		t.Errorf("got first item %+v, want a snapshot of the cart line", o.Items[0])
+	}
+	if o.ShippingAddress == nil || o.BillingAddress == nil || o.BillingAddress.AddressType != entity.AddressTypeBilling {
+		t.Errorf("got shipping %+v and billing %+v, want the default address for both", o.ShippingAddress, o.BillingAddress)
+	}
+}
+
+func TestCheckoutRejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		repo    *fakeOrderRepository
+		address *entity.Address
+		request *order.CheckoutRequest
+		want    string
+	}{
+		{name: "empty cart", repo: &fakeOrderRepository{}, address: testAddress(), request: &order.CheckoutRequest{}, want: "Cart is empty"},
+		{name: "no shipping address", repo: &fakeOrderRepository{lines: testCartLines()}, request: &order.CheckoutRequest{}, want: "Shipping address is required"},
+		{name: "unknown shipping address", repo: &fakeOrderRepository{lines: testCartLines()}, address: testAddress(),
+			request: &order.CheckoutRequest{ShippingAddressId: "other"}, want: "Shipping address not found"},
+		{name: "insufficient stock", repo: &fakeOrderRepository{lines: testCartLines(), reserveErr: fmt.Errorf("%w for Product", repository.ErrInsufficientStock)},
+			address: testAddress(), request: &order.CheckoutRequest{}, want: "insufficient stock for Product"},
+		{name: "line in another currency", repo: &fakeOrderRepository{lines: []*entity.CartLine{{CartID: uuid.New(), ProductID: "p1", Price: entity.NewMoney(100, "USD"), Quantity: 1}}},
+			address: testAddress(), request: &order.CheckoutRequest{}, want: "Cart contains items priced in another currency"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestOrderService(tt.repo, tt.address)
+			res, err := svc.Checkout(contextWithUser("u1"), tt.request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !res.GetBase().GetIsError() || res.GetBase().GetMessage() != tt.want {
+				t.Errorf("got %q, want rejection %q", res.GetBase().GetMessage(), tt.want)
+			}
+			if len(tt.repo.orders) != 0 {
+				t.Errorf("rejected checkout stored %d orders", len(tt.repo.orders))
+			}
+		})
+	}
+}
+
+func TestGetOrderOfAnotherUser(t *testing.T) {
+	repo := &fakeOrderRepository{orders: map[string]*entity.Order{"o1": {Id: "o1", UserId: "u1", Status: entity.OrderStatusPendingPayment}}}
+	svc := newTestOrderService(repo, nil)
+
+	res, err := svc.GetOrder(contextWithUser("u2"), &order.GetOrderRequest{Id: "o1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.GetBase().GetStatusCode() != 404 || res.GetOrder() != nil {
+		t.Errorf("got %d / %v, want not found", res.GetBase().GetStatusCode(), res.GetOrder())
+	}
+
+	res, err = svc.GetOrder(contextWithUser("u1"), &order.GetOrderRequest{Id: "o1"})
+	if err != nil || res.GetOrder().GetId() != "o1" {
+		t.Errorf("owner could not read the order: %v / %q", err, res.GetBase().GetMessage())
+	}
+}
+
+func TestUpdateOrderStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		toStatus   string
+		wantCode   int64
+		wantStatus string
+	}{
+		{name: "allowed transition", status: entity.OrderStatusPaid, toStatus: entity.OrderStatusProcessing, wantCode: 200, wantStatus: entity.OrderStatusProcessing},
+		{name: "paid without a payment", status: entity.OrderStatusPendingPayment, toStatus: entity.OrderStatusPaid, wantCode: 400, wantStatus: entity.OrderStatusPendingPayment},
+		{name: "refunded without a refund", status: entity.OrderStatusDelivered, toStatus: entity.OrderStatusRefunded, wantCode: 400, wantStatus: entity.OrderStatusDelivered},
+		{name: "skipping a step", status: entity.OrderStatusPendingPayment, toStatus: entity.OrderStatusShipped, wantCode: 400, wantStatus: entity.OrderStatusPendingPayment},
+		{name: "leaving a final status", status: entity.OrderStatusCancelled, toStatus: entity.OrderStatusPaid, wantCode: 400, wantStatus: entity.OrderStatusCancelled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeOrderRepository{orders: map[string]*entity.Order{"o1": {Id: "o1", UserId: "u1", Status: tt.status}}}
+			svc := newTestOrderService(repo, nil)
+
+			res, err := svc.UpdateOrderStatus(contextWithUser("admin"), &order.UpdateOrderStatusRequest{Id: "o1", Status: tt.toStatus, Reason: "Test"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.GetBase().GetStatusCode() != tt.wantCode || repo.orders["o1"].Status != tt.wantStatus {
+				t.Fatalf("got %d %q and status %s, want %d and status %s", res.GetBase().GetStatusCode(), res.GetBase().GetMessage(),
+					repo.orders["o1"].Status, tt.wantCode, tt.wantStatus)
+			}
+			if tt.wantCode != 200 {
+				if len(repo.histories) != 0 {
+					t.Errorf("rejected transition recorded %d history entries", len(repo.histories))
+				}
+				return
+			}
+			if len(repo.histories) != 1 {
+				t.Fatalf("got %d history entries, want 1", len(repo.histories))
+			}
+			h := repo.histories[0]
+			if h.FromStatus == nil || *h.FromStatus != tt.status || h.ToStatus != tt.toStatus || h.ChangedByUserId != "admin" || h.Reason != "Test" {
+				t.Errorf("got history %+v", h)
+			}
+		})
+	}
+}
+
+func TestUpdateOrderStatusNotFound(t *testing.T) {
+	svc := newTestOrderService(&fakeOrderRepository{}, nil)
+	res, err := svc.UpdateOrderStatus(contextWithUser("admin"), &order.UpdateOrderStatusRequest{Id: "missing", Status: entity.OrderStatusProcessing})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.GetBase().GetStatusCode() != 404 {
+		t.Errorf("got %d, want 404", res.GetBase().GetStatusCode())
+	}
+}
+
+// concurrentStatusRepository changes the order status between the read and the conditional update.
+type concurrentStatusRepository struct {
+	*fakeOrderRepository
+}
+
+func (r *concurrentStatusRepository) UpdateOrderStatus(ctx context.Context, orderID string, fromStatus string, history *entity.OrderStatusHistory) (bool, error) {
+	r.orders[orderID].Status = entity.OrderStatusCancelled
+	return r.fakeOrderRepository.UpdateOrderStatus(ctx, orderID, fromStatus, history)
+}
+
+func TestUpdateOrderStatusChangedConcurrently(t *testing.T) {
+	repo := &concurrentStatusRepository{&fakeOrderRepository{orders: map[string]*entity.Order{"o1": {Id: "o1", Status: entity.OrderStatusPaid}}}}
+	svc := NewOrderService(repo, &fakeCheckoutAddressRepository{}, NewPricingEngine(PricingConfig{Currency: "IDR"}))
+
+	res, err := svc.UpdateOrderStatus(contextWithUser("admin"), &order.UpdateOrderStatusRequest{Id: "o1", Status: entity.OrderStatusProcessing})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.GetBase().GetIsError() || repo.orders["o1"].Status != entity.OrderStatusCancelled {
+		t.Errorf("got %q and status %s, want a retry error and the concurrent status kept", res.GetBase().GetMessage(), repo.orders["o1"].Status)
+	}
+}
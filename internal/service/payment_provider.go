@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+)
+
+const (
+	PaymentEventSucceeded = "payment.succeeded"
+	PaymentEventFailed    = "payment.failed"
+)
+
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+// IPaymentProvider abstracts an external payment gateway.
+type IPaymentProvider interface {
+	// Name returns the provider identifier stored on each payment row.
+	Name() string
+	// CreatePaymentIntent registers a new payment attempt with the provider.
+	CreatePaymentIntent(ctx context.Context, request *PaymentIntentRequest) (*PaymentIntent, error)
+	// CapturePayment captures a previously authorized payment intent.
+	CapturePayment(ctx context.Context, intentID string) (*PaymentIntent, error)
+	// VoidPayment cancels an authorized payment intent that was never captured, releasing the hold on the funds.
+	VoidPayment(ctx context.Context, intentID string) error
+	// RefundPayment refunds a captured payment intent.
+	RefundPayment(ctx context.Context, intentID string, amount entity.Money) (*PaymentRefund, error)
+	// VerifyWebhook checks the webhook signature and decodes the event.
+	VerifyWebhook(payload []byte, signature string) (*PaymentWebhookEvent, error)
+}
+
+type PaymentIntentRequest struct {
+	// Reference is our payment ID, echoed back by the provider.
+	Reference string
+	OrderID   string
+	// Amount is in minor units, as most gateways expect.
+	Amount entity.Money
+}
+
+type PaymentIntent struct {
+	ID           string
+	ClientSecret string
+	Status       string
+}
+
+type PaymentRefund struct {
+	ID     string
+	Status string
+}
+
+type PaymentWebhookEvent struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	IntentID string `json:"intent_id"`
+}
+
+// NewPaymentProvider returns the provider selected by name (PAYMENT_PROVIDER). There is no default provider,
+// and the webhook secret is required: with an empty HMAC key anyone could sign webhooks that mark orders as PAID.
+func NewPaymentProvider(name string, webhookSecret string) (IPaymentProvider, error) {
+	if name == "" {
+		return nil, errors.New("PAYMENT_PROVIDER is required")
+	}
+	if webhookSecret == "" {
+		return nil, errors.New("PAYMENT_WEBHOOK_SECRET is required")
+	}
+	switch name {
+	case FakePaymentProviderName:
+		return NewFakePaymentProvider(webhookSecret), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", name)
+	}
+}
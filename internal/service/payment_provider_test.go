@@ -0,0 +1,28 @@
+package service
+
+import "testing"
+
+func TestNewPaymentProvider(t *testing.T) {
+	tests := []struct {
+		name          string
+		provider      string
+		webhookSecret string
+		wantErr       bool
+	}{
+		{name: "fake with secret", provider: FakePaymentProviderName, webhookSecret: "secret"},
+		{name: "no provider", provider: "", webhookSecret: "secret", wantErr: true},
+		{name: "no webhook secret", provider: FakePaymentProviderName, webhookSecret: "", wantErr: true},
+		{name: "unknown provider", provider: "stripe", webhookSecret: "secret", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := NewPaymentProvider(tt.provider, tt.webhookSecret)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && provider.Name() != tt.provider {
+				t.Errorf("got provider %q, want %q", provider.Name(), tt.provider)
+			}
+		})
+	}
+}
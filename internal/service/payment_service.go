@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/payment"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrPaymentNotFound = errors.New("payment not found")
+
+// IPaymentService defines the interface for payment-related business logic.
+type IPaymentService interface {
+	// CreatePayment starts a payment for one of the user's pending orders.
+	CreatePayment(ctx context.Context, request *payment.CreatePaymentRequest) (*payment.CreatePaymentResponse, error)
+	// CapturePayment captures the pending payment of an order (requires payment:write).
+	CapturePayment(ctx context.Context, request *payment.CapturePaymentRequest) (*payment.CapturePaymentResponse, error)
+	// RefundPayment refunds the captured payment of an order (requires payment:write).
+	RefundPayment(ctx context.Context, request *payment.RefundPaymentRequest) (*payment.RefundPaymentResponse, error)
+	// HandleWebhook verifies and applies a provider webhook delivery.
+	HandleWebhook(ctx context.Context, payload []byte, signature string) error
+}
+
+// PaymentService implements IPaymentService.
+type PaymentService struct {
+	paymentRepository repository.IPaymentRepository
+	orderRepository   repository.IOrderRepository
+	paymentProvider   IPaymentProvider
+}
+
+// NewPaymentService creates a new instance of PaymentService.
+func NewPaymentService(paymentRepository repository.IPaymentRepository, orderRepository repository.IOrderRepository, paymentProvider IPaymentProvider) IPaymentService {
+	return &PaymentService{
+		paymentRepository: paymentRepository,
+		orderRepository:   orderRepository,
+		paymentProvider:   paymentProvider,
+	}
+}
+
+// CreatePayment creates a payment intent for an order that is still awaiting payment.
+func (s *PaymentService) CreatePayment(ctx context.Context, request *payment.CreatePaymentRequest) (*payment.CreatePaymentResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	orderData, err := s.orderRepository.GetOrderById(ctx, request.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if orderData == nil || orderData.UserId != claims.Subject {
+		return &payment.CreatePaymentResponse{
+			Base: utils.NotFoundResponse("Order not found"),
+		}, nil
+	}
+	if orderData.Status != entity.OrderStatusPendingPayment {
+		return &payment.CreatePaymentResponse{
+			Base: utils.BadRequestResponse("Order is not awaiting payment"),
+		}, nil
+	}
+
+	newPayment := &entity.Payment{
+		Id:        uuid.NewString(),
+		OrderId:   orderData.Id,
+		Provider:  s.paymentProvider.Name(),
+		Amount:    orderData.TotalPrice,
+		Status:    entity.PaymentStatusPending,
+		CreatedAt: time.Now(),
+		CreatedBy: claims.FullName,
+	}
+	intent, err := s.paymentProvider.CreatePaymentIntent(ctx, &PaymentIntentRequest{
+		Reference: newPayment.Id,
+		OrderID:   orderData.Id,
+		Amount:    newPayment.Amount,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Payment provider failed: %v", err)
+	}
+	newPayment.ProviderIntentId = intent.ID
+
+	if err = s.paymentRepository.InsertPayment(ctx, newPayment); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &payment.CreatePaymentResponse{
+		Base:         utils.SuccessResponse("Payment created successfully"),
+		PaymentId:    newPayment.Id,
+		Provider:     newPayment.Provider,
+		IntentId:     intent.ID,
+		ClientSecret: intent.ClientSecret,
+		Amount:       newPayment.Amount.Major(),
+		Currency:     newPayment.Amount.Currency,
+		Status:       newPayment.Status,
+		AmountMoney:  toMoneyResponse(newPayment.Amount),
+	}, nil
+}
+
+// CapturePayment captures the latest pending payment of an order and marks the order as PAID.
+func (s *PaymentService) CapturePayment(ctx context.Context, request *payment.CapturePaymentRequest) (*payment.CapturePaymentResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	paymentData, err := s.paymentRepository.GetLatestPaymentByOrderId(ctx, request.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if paymentData == nil {
+		return &payment.CapturePaymentResponse{
+			Base: utils.NotFoundResponse("Payment not found"),
+		}, nil
+	}
+	if paymentData.Status != entity.PaymentStatusPending {
+		return &payment.CapturePaymentResponse{
+			Base:      utils.SuccessResponse("Payment already processed"),
+			PaymentId: paymentData.Id,
+			Status:    paymentData.Status,
+		}, nil
+	}
+
+	// Capture di provider dijalankan setelah order dikunci, sehingga order yang dibatalkan tidak ikut ditagih
+	var providerErr error
+	_, err = s.markCaptured(ctx, paymentData, claims.Subject, claims.FullName, func() error {
+		_, providerErr = s.paymentProvider.CapturePayment(ctx, paymentData.ProviderIntentId)
+		return providerErr
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotPendingPayment) {
+			// Otorisasi di provider dilepas agar dana pembeli tidak tertahan untuk order yang tidak bisa dibayar lagi
+			if err = s.paymentProvider.VoidPayment(ctx, paymentData.ProviderIntentId); err != nil {
+				return nil, status.Errorf(codes.Internal, "Payment provider failed: %v", err)
+			}
+			if _, err = s.paymentRepository.MarkPaymentVoided(ctx, paymentData.Id, claims.FullName); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			return &payment.CapturePaymentResponse{
+				Base: utils.BadRequestResponse("Order is not awaiting payment"),
+			}, nil
+		}
+		if providerErr != nil {
+			return nil, status.Errorf(codes.Internal, "Payment provider failed: %v", providerErr)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &payment.CapturePaymentResponse{
+		Base:      utils.SuccessResponse("Payment captured successfully"),
+		PaymentId: paymentData.Id,
+		Status:    entity.PaymentStatusCaptured,
+	}, nil
+}
+
+// RefundPayment refunds the captured payment of an order and moves the order to REFUNDED.
+func (s *PaymentService) RefundPayment(ctx context.Context, request *payment.RefundPaymentRequest) (*payment.RefundPaymentResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	orderData, err := s.orderRepository.GetOrderById(ctx, request.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if orderData == nil {
+		return &payment.RefundPaymentResponse{
+			Base: utils.NotFoundResponse("Order not found"),
+		}, nil
+	}
+	if !entity.CanTransitionOrderStatus(orderData.Status, entity.OrderStatusRefunded) {
+		return &payment.RefundPaymentResponse{
+			Base: utils.BadRequestResponse(fmt.Sprintf("Cannot refund an order with status %s", orderData.Status)),
+		}, nil
+	}
+
+	paymentData, err := s.paymentRepository.GetLatestPaymentByOrderId(ctx, orderData.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if paymentData == nil || paymentData.Status != entity.PaymentStatusCaptured {
+		return &payment.RefundPaymentResponse{
+			Base: utils.BadRequestResponse("Order has no captured payment"),
+		}, nil
+	}
+
+	refund, err := s.paymentProvider.RefundPayment(ctx, paymentData.ProviderIntentId, paymentData.Amount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Payment provider failed: %v", err)
+	}
+
+	fromStatus := orderData.Status
+	refunded, err := s.paymentRepository.MarkPaymentRefunded(ctx, paymentData, refund.ID, &entity.OrderStatusHistory{
+		Id:              uuid.NewString(),
+		OrderId:         orderData.Id,
+		FromStatus:      &fromStatus,
+		ToStatus:        entity.OrderStatusRefunded,
+		Reason:          request.Reason,
+		ChangedByUserId: claims.Subject,
+		ChangedBy:       claims.FullName,
+		CreatedAt:       time.Now(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !refunded {
+		log.Printf("WARNING: refund %s succeeded at provider but payment %s was changed concurrently", refund.ID, paymentData.Id)
+		return &payment.RefundPaymentResponse{
+			Base: utils.BadRequestResponse("Payment has been changed by another request, please retry"),
+		}, nil
+	}
+
+	return &payment.RefundPaymentResponse{
+		Base:      utils.SuccessResponse("Payment refunded successfully"),
+		PaymentId: paymentData.Id,
+		RefundId:  refund.ID,
+		Status:    entity.PaymentStatusRefunded,
+	}, nil
+}
+
+// HandleWebhook applies a verified provider event. Redelivered events are no-ops. A successful payment for an order
+// that is no longer awaiting payment is refunded through the provider.
+func (s *PaymentService) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	event, err := s.paymentProvider.VerifyWebhook(payload, signature)
+	if err != nil {
+		return err
+	}
+
+	paymentData, err := s.paymentRepository.GetPaymentByIntentId(ctx, s.paymentProvider.Name(), event.IntentID)
+	if err != nil {
+		return err
+	}
+	if paymentData == nil {
+		return ErrPaymentNotFound
+	}
+
+	actor := "payment:" + s.paymentProvider.Name()
+	switch event.Type {
+	case PaymentEventSucceeded:
+		_, err = s.markCaptured(ctx, paymentData, actor, actor, nil)
+		if errors.Is(err, repository.ErrOrderNotPendingPayment) {
+			// Dana sudah diterima provider untuk order yang tidak bisa dibayar lagi, jadi dikembalikan ke pembeli
+			return s.refundUnpaidPayment(ctx, paymentData, actor)
+		}
+		return err
+	case PaymentEventFailed:
+		_, err = s.paymentRepository.MarkPaymentFailed(ctx, paymentData.Id, actor)
+		return err
+	default:
+		log.Printf("Ignoring payment webhook event %s of type %s", event.ID, event.Type)
+		return nil
+	}
+}
+
+func (s *PaymentService) refundUnpaidPayment(ctx context.Context, paymentData *entity.Payment, actor string) error {
+	refund, err := s.paymentProvider.RefundPayment(ctx, paymentData.ProviderIntentId, paymentData.Amount)
+	if err != nil {
+		return fmt.Errorf("failed to refund payment %s: %w", paymentData.Id, err)
+	}
+	if _, err = s.paymentRepository.MarkPendingPaymentRefunded(ctx, paymentData.Id, refund.ID, actor); err != nil {
+		return err
+	}
+	log.Printf("WARNING: payment %s succeeded but order %s is not awaiting payment, refunded as %s", paymentData.Id, paymentData.OrderId, refund.ID)
+	return nil
+}
+
+func (s *PaymentService) markCaptured(ctx context.Context, paymentData *entity.Payment, userID string, changedBy string, capture repository.CaptureFunc) (bool, error) {
+	fromStatus := entity.OrderStatusPendingPayment
+	return s.paymentRepository.MarkPaymentCaptured(ctx, paymentData, &entity.OrderStatusHistory{
+		Id:              uuid.NewString(),
+		OrderId:         paymentData.OrderId,
+		FromStatus:      &fromStatus,
+		ToStatus:        entity.OrderStatusPaid,
+		Reason:          fmt.Sprintf("Payment %s captured", paymentData.Id),
+		ChangedByUserId: userID,
+		ChangedBy:       changedBy,
+		CreatedAt:       time.Now(),
+	}, capture)
+}
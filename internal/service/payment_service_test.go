@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/payment"
+)
+
+// fakePaymentRepository keeps one payment and the status of its order in memory, with the checks of the SQL repository.
+type fakePaymentRepository struct {
+	repository.IPaymentRepository
+
+	payment     entity.Payment
+	orderStatus string
+}
+
+func (r *fakePaymentRepository) GetLatestPaymentByOrderId(ctx context.Context, orderID string) (*entity.Payment, error) {
+	if r.payment.OrderId != orderID {
+		return nil, nil
+	}
+	p := r.payment
+	return &p, nil
+}
+
+func (r *fakePaymentRepository) MarkPaymentCaptured(ctx context.Context, payment *entity.Payment, history *entity.OrderStatusHistory, capture repository.CaptureFunc) (bool, error) {
+	if r.payment.Status != entity.PaymentStatusPending {
+		return false, nil
+	}
+	if r.orderStatus != entity.OrderStatusPendingPayment {
+		return false, repository.ErrOrderNotPendingPayment
+	}
+	if capture != nil {
+		if err := capture(); err != nil {
+			return false, err
+		}
+	}
+	r.payment.Status = entity.PaymentStatusCaptured
+	r.orderStatus = entity.OrderStatusPaid
+	return true, nil
+}
+
+func (r *fakePaymentRepository) MarkPaymentVoided(ctx context.Context, paymentID string, updatedBy string) (bool, error) {
+	if r.payment.Id != paymentID || r.payment.Status != entity.PaymentStatusPending {
+		return false, nil
+	}
+	r.payment.Status = entity.PaymentStatusVoided
+	return true, nil
+}
+
+// recordingPaymentProvider records the intents captured and voided through the fake gateway.
+type recordingPaymentProvider struct {
+	*FakePaymentProvider
+
+	captured []string
+	voided   []string
+}
+
+func (p *recordingPaymentProvider) CapturePayment(ctx context.Context, intentID string) (*PaymentIntent, error) {
+	p.captured = append(p.captured, intentID)
+	return p.FakePaymentProvider.CapturePayment(ctx, intentID)
+}
+
+func (p *recordingPaymentProvider) VoidPayment(ctx context.Context, intentID string) error {
+	p.voided = append(p.voided, intentID)
+	return p.FakePaymentProvider.VoidPayment(ctx, intentID)
+}
+
+func TestCapturePayment(t *testing.T) {
+	tests := []struct {
+		name            string
+		orderStatus     string
+		wantCode        int64
+		wantPayment     string
+		wantOrderStatus string
+		wantCaptured    int
+		wantVoided      int
+	}{
+		{name: "pending order", orderStatus: entity.OrderStatusPendingPayment, wantCode: 200,
+			wantPayment: entity.PaymentStatusCaptured, wantOrderStatus: entity.OrderStatusPaid, wantCaptured: 1},
+		{name: "cancelled order", orderStatus: entity.OrderStatusCancelled, wantCode: 400,
+			wantPayment: entity.PaymentStatusVoided, wantOrderStatus: entity.OrderStatusCancelled, wantVoided: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakePaymentRepository{
+				payment: entity.Payment{
+					Id:               "pay-1",
+					OrderId:          "order-1",
+					Provider:         FakePaymentProviderName,
+					ProviderIntentId: "fake_pi_pay-1",
+					Status:           entity.PaymentStatusPending,
+				},
+				orderStatus: tt.orderStatus,
+			}
+			provider := &recordingPaymentProvider{FakePaymentProvider: NewFakePaymentProvider("test-secret")}
+			svc := NewPaymentService(repo, nil, provider)
+
+			res, err := svc.CapturePayment(contextWithUser("admin"), &payment.CapturePaymentRequest{OrderId: "order-1"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.GetBase().GetStatusCode() != tt.wantCode {
+				t.Errorf("got %d %q, want %d", res.GetBase().GetStatusCode(), res.GetBase().GetMessage(), tt.wantCode)
+			}
+			if repo.payment.Status != tt.wantPayment || repo.orderStatus != tt.wantOrderStatus {
+				t.Errorf("got payment %s / order %s, want %s / %s", repo.payment.Status, repo.orderStatus, tt.wantPayment, tt.wantOrderStatus)
+			}
+			if len(provider.captured) != tt.wantCaptured || len(provider.voided) != tt.wantVoided {
+				t.Errorf("got %d captures and %d voids at the provider, want %d and %d", len(provider.captured), len(provider.voided), tt.wantCaptured, tt.wantVoided)
+			}
+		})
+	}
+}
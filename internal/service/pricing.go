@@ -0,0 +1,189 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/common"
+)
+
+// basisPointsPerUnit is 100%, expressed in basis points (1 bp = 0.01%).
+const basisPointsPerUnit = 10000
+
+// ErrCurrencyMismatch is returned when a line is not priced in the currency of the pricing engine.
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// PricingConfig holds the store-wide pricing rules. Amounts are in minor units of Currency.
+type PricingConfig struct {
+	Currency string
+	// TaxRateBasisPoints adalah tarif pajak dalam basis point, mis. 1100 untuk 11%
+	TaxRateBasisPoints int64
+	// ShippingFee adalah ongkos kirim flat per order
+	ShippingFee int64
+	// FreeShippingThreshold: ongkos kirim gratis jika subtotal setelah diskon mencapai nilai ini; 0 berarti tidak pernah gratis
+	FreeShippingThreshold int64
+}
+
+// ParsePricingConfig parses the pricing settings of the store currency from their decimal string form: the tax rate
+// in percent (e.g. "11" or "11.5") and the amounts in major units (e.g. "15000" or "4.99"). Empty values are zero.
+func ParsePricingConfig(taxRatePercent string, shippingFee string, freeShippingThreshold string) (PricingConfig, error) {
+	config := PricingConfig{Currency: entity.DefaultCurrency}
+	exponent := entity.CurrencyExponent(config.Currency)
+	var err error
+	// persen dengan dua desimal sama dengan basis point
+	if config.TaxRateBasisPoints, err = utils.ParseDecimal(taxRatePercent, 2); err != nil {
+		return PricingConfig{}, fmt.Errorf("invalid tax rate: %w", err)
+	}
+	if config.TaxRateBasisPoints > basisPointsPerUnit {
+		return PricingConfig{}, fmt.Errorf("invalid tax rate: %s%% is more than 100%%", taxRatePercent)
+	}
+	if config.ShippingFee, err = utils.ParseDecimal(shippingFee, exponent); err != nil {
+		return PricingConfig{}, fmt.Errorf("invalid shipping fee: %w", err)
+	}
+	if config.FreeShippingThreshold, err = utils.ParseDecimal(freeShippingThreshold, exponent); err != nil {
+		return PricingConfig{}, fmt.Errorf("invalid free shipping threshold: %w", err)
+	}
+	return config, nil
+}
+
+// PricingLine is a cart line to be priced. Only Discountable lines share the order-level discount.
+type PricingLine struct {
+	UnitPrice    entity.Money
+	Quantity     int64
+	Discountable bool
+}
+
+// PricedLine is the breakdown of one line. Total = Subtotal - Discount + Tax.
+type PricedLine struct {
+	UnitPrice int64
+	Quantity  int64
+	Subtotal  int64
+	Discount  int64
+	Tax       int64
+	Total     int64
+}
+
+// PriceBreakdown is the priced cart. The order-level amounts are the sums of the line amounts, plus shipping,
+// so the lines always add up to the grand total. All amounts are in minor units of Currency.
+type PriceBreakdown struct {
+	Currency   string
+	Lines      []*PricedLine
+	Subtotal   int64
+	Discount   int64
+	Tax        int64
+	Shipping   int64
+	GrandTotal int64
+}
+
+// PricingEngine computes cart and order totals with integer minor-unit arithmetic.
+// ListCart and Checkout share it, so the total shown in the cart is the amount charged.
+type PricingEngine struct {
+	config PricingConfig
+}
+
+// NewPricingEngine creates a new instance of PricingEngine.
+func NewPricingEngine(config PricingConfig) *PricingEngine {
+	return &PricingEngine{config: config}
+}
+
+// Price prices the lines, in order. discount is an order-level discount in minor units; it is capped at the subtotal
+// of the discountable lines and spread over them in proportion to their subtotals. Tax is computed per line on the
+// discounted subtotal, rounded half up, and shipping is not taxed. It returns ErrCurrencyMismatch if a line is in
+// another currency.
+func (e *PricingEngine) Price(lines []PricingLine, discount int64) (*PriceBreakdown, error) {
+	breakdown := &PriceBreakdown{Currency: e.config.Currency, Lines: make([]*PricedLine, 0, len(lines))}
+	var discountable []*PricedLine
+	var discountableSubtotal int64
+	for _, line := range lines {
+		if line.UnitPrice.Currency != e.config.Currency {
+			return nil, fmt.Errorf("%w: %s price in a %s cart", ErrCurrencyMismatch, line.UnitPrice.Currency, e.config.Currency)
+		}
+		priced := &PricedLine{
+			UnitPrice: line.UnitPrice.Amount,
+			Quantity:  line.Quantity,
+			Subtotal:  line.UnitPrice.Amount * line.Quantity,
+		}
+		breakdown.Lines = append(breakdown.Lines, priced)
+		breakdown.Subtotal += priced.Subtotal
+		if line.Discountable {
+			discountable = append(discountable, priced)
+			discountableSubtotal += priced.Subtotal
+		}
+	}
+
+	if discount > discountableSubtotal {
+		discount = discountableSubtotal
+	}
+	if discount > 0 {
+		allocateDiscount(discountable, discountableSubtotal, discount)
+	}
+
+	for _, line := range breakdown.Lines {
+		taxable := line.Subtotal - line.Discount
+		line.Tax = (taxable*e.config.TaxRateBasisPoints + basisPointsPerUnit/2) / basisPointsPerUnit
+		line.Total = taxable + line.Tax
+
+		breakdown.Discount += line.Discount
+		breakdown.Tax += line.Tax
+	}
+
+	if len(lines) > 0 {
+		breakdown.Shipping = e.config.ShippingFee
+		if e.config.FreeShippingThreshold > 0 && breakdown.Subtotal-breakdown.Discount >= e.config.FreeShippingThreshold {
+			breakdown.Shipping = 0
+		}
+	}
+
+	breakdown.GrandTotal = breakdown.Subtotal - breakdown.Discount + breakdown.Tax + breakdown.Shipping
+	return breakdown, nil
+}
+
+// Money returns an amount of the breakdown as Money in its currency.
+func (b *PriceBreakdown) Money(amount int64) entity.Money {
+	return entity.NewMoney(amount, b.Currency)
+}
+
+func (b *PriceBreakdown) toResponse() *common.PriceBreakdown {
+	return &common.PriceBreakdown{
+		Subtotal:        b.Money(b.Subtotal).Major(),
+		Discount:        b.Money(b.Discount).Major(),
+		Tax:             b.Money(b.Tax).Major(),
+		Shipping:        b.Money(b.Shipping).Major(),
+		GrandTotal:      b.Money(b.GrandTotal).Major(),
+		SubtotalMoney:   toMoneyResponse(b.Money(b.Subtotal)),
+		DiscountMoney:   toMoneyResponse(b.Money(b.Discount)),
+		TaxMoney:        toMoneyResponse(b.Money(b.Tax)),
+		ShippingMoney:   toMoneyResponse(b.Money(b.Shipping)),
+		GrandTotalMoney: toMoneyResponse(b.Money(b.GrandTotal)),
+	}
+}
+
+// allocateDiscount spreads discount over the lines in proportion to their subtotals (largest remainder method),
+// so the line discounts add up to exactly discount. discount must not exceed subtotal.
+func allocateDiscount(lines []*PricedLine, subtotal int64, discount int64) {
+	remainders := make([]uint64, len(lines))
+	allocated := int64(0)
+	for i, line := range lines {
+		// discount*line.Subtotal bisa melebihi int64, jadi dihitung dengan 128 bit
+		hi, lo := bits.Mul64(uint64(discount), uint64(line.Subtotal))
+		share, remainder := bits.Div64(hi, lo, uint64(subtotal))
+		line.Discount = int64(share)
+		remainders[i] = remainder
+		allocated += line.Discount
+	}
+
+	// Sisa pembulatan dibagikan satu per satu ke baris dengan sisa pembagian terbesar (baris pertama menang jika sama)
+	for left := discount - allocated; left > 0; left-- {
+		best := -1
+		for i := range lines {
+			if remainders[i] > 0 && (best < 0 || remainders[i] > remainders[best]) {
+				best = i
+			}
+		}
+		lines[best].Discount++
+		remainders[best] = 0
+	}
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+)
+
+func idr(amount int64) entity.Money {
+	return entity.NewMoney(amount, "IDR")
+}
+
+func TestPrice(t *testing.T) {
+	config := PricingConfig{Currency: "IDR", TaxRateBasisPoints: 1100, ShippingFee: 15000}
+	tests := []struct {
+		name          string
+		config        PricingConfig
+		lines         []PricingLine
+		discount      int64
+		wantDiscounts []int64
+		wantTaxes     []int64
+		want          PriceBreakdown
+	}{
+		{
+			name:          "no discount",
+			config:        config,
+			lines:         []PricingLine{{UnitPrice: idr(10000), Quantity: 2, Discountable: true}},
+			wantDiscounts: []int64{0},
+			wantTaxes:     []int64{2200},
+			want:          PriceBreakdown{Subtotal: 20000, Tax: 2200, Shipping: 15000, GrandTotal: 37200},
+		},
+		{
+			name:   "discount above subtotal is capped at the discountable lines",
+			config: config,
+			lines: []PricingLine{
+				{UnitPrice: idr(5000), Quantity: 1, Discountable: true},
+				{UnitPrice: idr(3000), Quantity: 1},
+			},
+			discount:      10000,
+			wantDiscounts: []int64{5000, 0},
+			wantTaxes:     []int64{0, 330},
+			want:          PriceBreakdown{Subtotal: 8000, Discount: 5000, Tax: 330, Shipping: 15000, GrandTotal: 18330},
+		},
+		{
+			name:   "discount not divisible by the lines",
+			config: config,
+			lines: []PricingLine{
+				{UnitPrice: idr(1000), Quantity: 1, Discountable: true},
+				{UnitPrice: idr(1000), Quantity: 1, Discountable: true},
+				{UnitPrice: idr(1000), Quantity: 1, Discountable: true},
+			},
+			discount:      100,
+			wantDiscounts: []int64{34, 33, 33},
+			wantTaxes:     []int64{106, 106, 106},
+			want:          PriceBreakdown{Subtotal: 3000, Discount: 100, Tax: 318, Shipping: 15000, GrandTotal: 18218},
+		},
+		{
+			name:          "no eligible lines",
+			config:        config,
+			lines:         []PricingLine{{UnitPrice: idr(2000), Quantity: 1}},
+			discount:      500,
+			wantDiscounts: []int64{0},
+			wantTaxes:     []int64{220},
+			want:          PriceBreakdown{Subtotal: 2000, Tax: 220, Shipping: 15000, GrandTotal: 17220},
+		},
+		{
+			name:   "eligible line with zero subtotal",
+			config: config,
+			lines: []PricingLine{
+				{UnitPrice: idr(0), Quantity: 1, Discountable: true},
+				{UnitPrice: idr(4000), Quantity: 1, Discountable: true},
+			},
+			discount:      1000,
+			wantDiscounts: []int64{0, 1000},
+			wantTaxes:     []int64{0, 330},
+			want:          PriceBreakdown{Subtotal: 4000, Discount: 1000, Tax: 330, Shipping: 15000, GrandTotal: 18330},
+		},
+		{
+			name:          "tax rounds half up",
+			config:        config,
+			lines:         []PricingLine{{UnitPrice: idr(50), Quantity: 1}},
+			wantDiscounts: []int64{0},
+			wantTaxes:     []int64{6},
+			want:          PriceBreakdown{Subtotal: 50, Tax: 6, Shipping: 15000, GrandTotal: 15056},
+		},
+		{
+			name:          "free shipping uses the discounted subtotal",
+			config:        PricingConfig{Currency: "IDR", ShippingFee: 15000, FreeShippingThreshold: 10000},
+			lines:         []PricingLine{{UnitPrice: idr(12000), Quantity: 1, Discountable: true}},
+			discount:      3000,
+			wantDiscounts: []int64{3000},
+			wantTaxes:     []int64{0},
+			want:          PriceBreakdown{Subtotal: 12000, Discount: 3000, Shipping: 15000, GrandTotal: 24000},
+		},
+		{
+			name:          "free shipping reached",
+			config:        PricingConfig{Currency: "IDR", ShippingFee: 15000, FreeShippingThreshold: 10000},
+			lines:         []PricingLine{{UnitPrice: idr(12000), Quantity: 1, Discountable: true}},
+			discount:      2000,
+			wantDiscounts: []int64{2000},
+			wantTaxes:     []int64{0},
+			want:          PriceBreakdown{Subtotal: 12000, Discount: 2000, GrandTotal: 10000},
+		},
+		{
+			name:   "empty cart has no shipping",
+			config: config,
+			want:   PriceBreakdown{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPricingEngine(tt.config).Price(tt.lines, tt.discount)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Subtotal != tt.want.Subtotal || got.Discount != tt.want.Discount || got.Tax != tt.want.Tax ||
+				got.Shipping != tt.want.Shipping || got.GrandTotal != tt.want.GrandTotal {
+				t.Errorf("got subtotal %d, discount %d, tax %d, shipping %d, grand total %d; want %d, %d, %d, %d, %d",
+					got.Subtotal, got.Discount, got.Tax, got.Shipping, got.GrandTotal,
+					tt.want.Subtotal, tt.want.Discount, tt.want.Tax, tt.want.Shipping, tt.want.GrandTotal)
+			}
+			if len(got.Lines) != len(tt.lines) {
+				t.Fatalf("got %d lines, want %d", len(got.Lines), len(tt.lines))
+			}
+
+			var lineDiscounts, lineTotals int64
+			for i, line := range got.Lines {
+				if line.Discount != tt.wantDiscounts[i] || line.Tax != tt.wantTaxes[i] {
+					t.Errorf("line %d: got discount %d, tax %d; want %d, %d", i, line.Discount, line.Tax, tt.wantDiscounts[i], tt.wantTaxes[i])
+				}
+				if line.Total != line.Subtotal-line.Discount+line.Tax {
+					t.Errorf("line %d: total %d does not add up", i, line.Total)
+				}
+				lineDiscounts += line.Discount
+				lineTotals += line.Total
+			}
+			if lineDiscounts != got.Discount {
+				t.Errorf("line discounts add up to %d, want %d", lineDiscounts, got.Discount)
+			}
+			if lineTotals+got.Shipping != got.GrandTotal {
+				t.Errorf("line totals plus shipping add up to %d, want %d", lineTotals+got.Shipping, got.GrandTotal)
+			}
+		})
+	}
+}
+
+func TestPriceCurrencyMismatch(t *testing.T) {
+	engine := NewPricingEngine(PricingConfig{Currency: "IDR"})
+	_, err := engine.Price([]PricingLine{{UnitPrice: entity.NewMoney(100, "USD"), Quantity: 1}}, 0)
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("got %v, want ErrCurrencyMismatch", err)
+	}
+}
+
+func TestAllocateDiscount(t *testing.T) {
+	tests := []struct {
+		name      string
+		subtotals []int64
+		discount  int64
+		want      []int64
+	}{
+		{name: "proportional", subtotals: []int64{6000, 4000}, discount: 1000, want: []int64{600, 400}},
+		{name: "equal remainders go to the first line", subtotals: []int64{1000, 1000, 1000}, discount: 100, want: []int64{34, 33, 33}},
+		{name: "largest remainder wins", subtotals: []int64{100, 200}, discount: 10, want: []int64{3, 7}},
+		{name: "several leftover units", subtotals: []int64{1, 1, 1, 1, 1, 1, 1}, discount: 4, want: []int64{1, 1, 1, 1, 0, 0, 0}},
+		{name: "zero subtotal line gets nothing", subtotals: []int64{0, 500, 500}, discount: 101, want: []int64{0, 51, 50}},
+		{name: "discount equals subtotal", subtotals: []int64{700, 300}, discount: 1000, want: []int64{700, 300}},
+		{name: "products beyond int64", subtotals: []int64{4e18, 4e18}, discount: 3e18, want: []int64{1.5e18, 1.5e18}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := make([]*PricedLine, len(tt.subtotals))
+			var subtotal int64
+			for i, s := range tt.subtotals {
+				lines[i] = &PricedLine{Subtotal: s}
+				subtotal += s
+			}
+			allocateDiscount(lines, subtotal, tt.discount)
+
+			var sum int64
+			for i, line := range lines {
+				if line.Discount != tt.want[i] {
+					t.Errorf("line %d: got %d, want %d", i, line.Discount, tt.want[i])
+				}
+				if line.Discount > line.Subtotal {
+					t.Errorf("line %d: discount %d exceeds subtotal %d", i, line.Discount, line.Subtotal)
+				}
+				sum += line.Discount
+			}
+			if sum != tt.discount {
+				t.Errorf("line discounts add up to %d, want %d", sum, tt.discount)
+			}
+		})
+	}
+}
+
+func TestParsePricingConfig(t *testing.T) {
+	tests := []struct {
+		name                                string
+		taxRate, shippingFee, freeThreshold string
+		want                                PricingConfig
+		wantErr                             bool
+	}{
+		{name: "empty", want: PricingConfig{Currency: "IDR"}},
+		{name: "decimals", taxRate: "11.5", shippingFee: "15000", freeThreshold: "250000.50",
+			want: PricingConfig{Currency: "IDR", TaxRateBasisPoints: 1150, ShippingFee: 1500000, FreeShippingThreshold: 25000050}},
+		{name: "tax above 100 percent", taxRate: "101", wantErr: true},
+		{name: "too many decimals", shippingFee: "1.234", wantErr: true},
+		{name: "not a number", freeThreshold: "free", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePricingConfig(tt.taxRate, tt.shippingFee, tt.freeThreshold)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("got %+v, %v; want %+v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
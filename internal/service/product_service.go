@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
@@ -27,22 +28,37 @@ type IProductService interface {
 	ListProducts(ctx context.Context, request *product.ListProductsRequest) (*product.ListProductsResponse, error)
 	ListProductsAdmin(ctx context.Context, request *product.ListProductsAdminRequest) (*product.ListProductsAdminResponse, error)
 	HighlightProducts(ctx context.Context, request *product.HighlightProductsRequest) (*product.HighlightProductsResponse, error)
+	SearchProducts(ctx context.Context, request *product.SearchProductsRequest) (*product.SearchProductsResponse, error)
+	ListProductsByCategory(ctx context.Context, request *product.ListProductsByCategoryRequest) (*product.ListProductsByCategoryResponse, error)
+	SetProductOptions(ctx context.Context, request *product.SetProductOptionsRequest) (*product.SetProductOptionsResponse, error)
+	CreateProductVariant(ctx context.Context, request *product.CreateProductVariantRequest) (*product.CreateProductVariantResponse, error)
+	UpdateProductVariant(ctx context.Context, request *product.UpdateProductVariantRequest) (*product.UpdateProductVariantResponse, error)
+	DeleteProductVariant(ctx context.Context, request *product.DeleteProductVariantRequest) (*product.DeleteProductVariantResponse, error)
+	AttachProductImage(ctx context.Context, request *product.AttachProductImageRequest) (*product.AttachProductImageResponse, error)
+	ReorderProductImages(ctx context.Context, request *product.ReorderProductImagesRequest) (*product.ReorderProductImagesResponse, error)
+	SetPrimaryProductImage(ctx context.Context, request *product.SetPrimaryProductImageRequest) (*product.SetPrimaryProductImageResponse, error)
+	DetachProductImage(ctx context.Context, request *product.DetachProductImageRequest) (*product.DetachProductImageResponse, error)
 }
 
 type productService struct {
 	productRepository repository.IProductRepository
+	categoryRepository repository.ICategoryRepository
+	variantRepository repository.IProductVariantRepository
+	imageRepository repository.IProductImageRepository
 	storageService IStorageService
 }
 
 func (ps *productService) CreateProduct(ctx context.Context, request *product.CreateProductRequest) (*product.CreateProductResponse, error) {
-	// cek apakah benar admin
+	// ambil identitas admin untuk created_by (permission sudah dicek di middleware)
 	claims, err := jwtentity.GetClaimsFromContext(ctx)
 	if err != nil {
 		return nil, utils.UnauthenticatedResponse()
 	}	
-
-	if claims.RoleCode != entity.UserRoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	price := moneyFromRequest(request.PriceMoney, request.Price)
+	if errMessage := validatePrice(price); errMessage != "" {
+		return &product.CreateProductResponse{
+			Base: utils.BadRequestResponse(errMessage),
+		}, nil
 	}
 	// TODO: Cek apakah request.ImageFileName tersedia
 	objectKey := request.ImageFileName
@@ -58,14 +74,26 @@ func (ps *productService) CreateProduct(ctx context.Context, request *product.Cr
         }, nil
     }
 
+	categoryIds := uniqueStrings(request.CategoryIds)
+	validCategories, err := ps.validateCategoryIds(ctx, categoryIds)
+	if err != nil {
+		return nil, err
+	}
+	if !validCategories {
+		return &product.CreateProductResponse{
+			Base: utils.BadRequestResponse("Category not found"),
+		}, nil
+	}
+
 	newProduct := entity.Product{
 		Id:            uuid.NewString(),
 		Name:          request.Name,
 		Description:   request.Description,
-		Price:         float64(request.Price),
+		Price:         price,
 		ImageFileName: request.ImageFileName,
 		CreatedAt:     time.Now(),
 		CreatedBy:     &claims.FullName,
+		CategoryIds:   categoryIds,
 	}
 	productId := newProduct.Id
 	err = ps.productRepository.CreateProduct(ctx, &newProduct)
@@ -90,13 +118,58 @@ func (ps *productService) DetailProduct(ctx context.Context, request *product.De
 		}, nil
 	}
 
+	categoryIds, err := ps.productRepository.GetProductCategoryIds(ctx, productData.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := ps.variantRepository.GetProductOptions(ctx, productData.Id)
+	if err != nil {
+		return nil, err
+	}
+	variants, err := ps.variantRepository.ListVariantsByProductId(ctx, productData.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	optionsData := make([]*product.ProductOption, 0, len(options))
+	for _, o := range options {
+		optionsData = append(optionsData, &product.ProductOption{
+			Name:   o.Name,
+			Values: o.Values,
+		})
+	}
+	variantsData := make([]*product.ProductVariant, 0, len(variants))
+	for _, v := range variants {
+		variantsData = append(variantsData, toProductVariantResponse(v, productData))
+	}
+
+	images, err := ps.imageRepository.ListImagesByProductId(ctx, productData.Id)
+	if err != nil {
+		return nil, err
+	}
+	imagesData := make([]*product.ProductImage, 0, len(images))
+	for _, i := range images {
+		imagesData = append(imagesData, &product.ProductImage{
+			Id:        i.Id,
+			ImageUrl:  fmt.Sprintf("%s/%s", os.Getenv("R2_PUBLIC_DOMAIN"), i.ImageFileName),
+			SortOrder: int32(i.SortOrder),
+			IsPrimary: i.IsPrimary,
+		})
+	}
+
 	return &product.DetailProductResponse{
 		Base: utils.SuccessResponse("Product retrieved successfully"),
 		Id:            productData.Id,
 		Name:          productData.Name,
 		Description:   productData.Description,
-		Price:         productData.Price,
+		Price:         productData.Price.Major(),
+		PriceMoney:    toMoneyResponse(productData.Price),
 		ImageUrl: 		fmt.Sprintf("%s/%s", os.Getenv("R2_PUBLIC_DOMAIN"), productData.ImageFileName),
+		CategoryIds:   categoryIds,
+		Options:       optionsData,
+		Variants:      variantsData,
+		Images:        imagesData,
 	}, nil
 }
 
@@ -105,9 +178,11 @@ func (ps *productService) UpdateProduct(ctx context.Context, request *product.Up
 	if err != nil {
 		return nil, utils.UnauthenticatedResponse()
 	}	
-
-	if claims.RoleCode != entity.UserRoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	price := moneyFromRequest(request.PriceMoney, request.Price)
+	if errMessage := validatePrice(price); errMessage != "" {
+		return &product.UpdateProductResponse{
+			Base: utils.BadRequestResponse(errMessage),
+		}, nil
 	}
 	// Check is the product exist
 	productData, err := ps.productRepository.GetProductById(ctx, request.Id)
@@ -120,6 +195,23 @@ func (ps *productService) UpdateProduct(ctx context.Context, request *product.Up
 		}, nil
 	}
 
+	// category_ids kosong berarti kategori tidak diubah, kecuali clear_category_ids diisi
+	var categoryIds []string
+	if len(request.CategoryIds) > 0 {
+		categoryIds = uniqueStrings(request.CategoryIds)
+	} else if request.ClearCategoryIds {
+		categoryIds = []string{}
+	}
+	validCategories, err := ps.validateCategoryIds(ctx, categoryIds)
+	if err != nil {
+		return nil, err
+	}
+	if !validCategories {
+		return &product.UpdateProductResponse{
+			Base: utils.BadRequestResponse("Category not found"),
+		}, nil
+	}
+
 	if request.ImageFileName != productData.ImageFileName {
 		objectKey := request.ImageFileName
 		exists, err := ps.storageService.CheckIfObjectExists(ctx, objectKey)
@@ -131,29 +223,27 @@ func (ps *productService) UpdateProduct(ctx context.Context, request *product.Up
 				Base: utils.NotFoundResponse("New image file not found in storage. Please upload the image first."),
 			}, nil
 		}
-		// If the image file name has changed, delete the old image from storage
-		if productData.ImageFileName != ""  {
-			delErr := ps.storageService.DeleteObject(ctx, productData.ImageFileName)
-			if delErr != nil {
-				// Log the error but don't block the product update if image deletion fails
-				fmt.Printf("Failed to delete old image %s from storage: %v\n", productData.ImageFileName, delErr)
-			}
-		}
-		
 	}
+	oldImageFileName := productData.ImageFileName
 
 	productData.Name = request.Name
 	productData.Description = request.Description
-	productData.Price = float64(request.Price)
+	productData.Price = price
 	productData.ImageFileName = request.ImageFileName
 	productData.UpdatedAt = time.Now()
 	productData.UpdatedBy = &claims.FullName
+	productData.CategoryIds = categoryIds
 
 	err = ps.productRepository.UpdateProduct(ctx, productData)
 	if err != nil {
 		return nil, err
 	}
 
+	// Gambar lama dihapus setelah update tersimpan, dan hanya jika tidak dipakai galeri atau varian
+	if oldImageFileName != "" && oldImageFileName != productData.ImageFileName {
+		ps.deleteImageIfUnused(ctx, oldImageFileName)
+	}
+
 	return &product.UpdateProductResponse{
 		Base: utils.SuccessResponse("Product updated successfully"),
 		Id: productData.Id,
@@ -166,10 +256,6 @@ func (ps *productService) DeleteProduct(ctx context.Context, request *product.De
 		return nil, utils.UnauthenticatedResponse()
 	}	
 
-	if claims.RoleCode != entity.UserRoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
-	}
-
 	productData, err := ps.productRepository.GetProductById(ctx, request.Id)
 	if err != nil {
 		return nil, err
@@ -180,6 +266,11 @@ func (ps *productService) DeleteProduct(ctx context.Context, request *product.De
 		}, nil
 	}
 
+	images, err := ps.imageRepository.ListImagesByProductId(ctx, productData.Id)
+	if err != nil {
+		return nil, err
+	}
+
 	productData.IsDeleted = true
 	productData.DeletedAt = time.Now()
 	productData.DeletedBy = &claims.FullName
@@ -189,10 +280,13 @@ func (ps *productService) DeleteProduct(ctx context.Context, request *product.De
 		return nil, err
 	}
 
-	if productData.ImageFileName != "" {
-		delErr := ps.storageService.DeleteObject(ctx, productData.ImageFileName)
-		if delErr != nil {
-			fmt.Printf("Failed to delete image %s from storage during product deletion: %v\n", productData.ImageFileName, delErr)
+	imageFileNames := []string{productData.ImageFileName}
+	for _, i := range images {
+		imageFileNames = append(imageFileNames, i.ImageFileName)
+	}
+	for _, imageFileName := range uniqueStrings(imageFileNames) {
+		if imageFileName != "" {
+			ps.deleteImageIfUnused(ctx, imageFileName)
 		}
 	}
 
@@ -224,10 +318,25 @@ func (ps *productService) ListProducts(ctx context.Context, request *product.Lis
 		}
 	}
 
-    products, totalElements, err := ps.productRepository.ListProducts(ctx, page, limit, sort)
+	filter, errMessage := toProductFilter(request.GetFilter())
+	if errMessage != "" {
+		return &product.ListProductsResponse{
+			Base: utils.BadRequestResponse(errMessage),
+		}, nil
+	}
+	// Produk yang sudah dihapus tidak pernah ditampilkan ke publik
+	notDeleted := false
+	filter.IsDeleted = &notDeleted
+
+    products, totalElements, err := ps.productRepository.ListProducts(ctx, filter, page, limit, sort)
     if err != nil {
         return nil, err
     }
+
+	facets, err := ps.productRepository.GetProductFacets(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
     
     // 3. Hitung Total Pages
     totalPages := int32(math.Ceil(float64(totalElements) / float64(limit)))
@@ -243,8 +352,9 @@ func (ps *productService) ListProducts(ctx context.Context, request *product.Lis
             Id:          p.Id,
             Name:        p.Name,
             Description: p.Description,
-            Price:       p.Price,
+            Price:       p.Price.Major(),
             ImageUrl:    fmt.Sprintf("%s/%s", os.Getenv("R2_PUBLIC_DOMAIN"), p.ImageFileName),
+            PriceMoney:  toMoneyResponse(p.Price),
         })
     }
 
@@ -258,20 +368,11 @@ func (ps *productService) ListProducts(ctx context.Context, request *product.Lis
             TotalElements: totalElements,
         },
         Products: productsData,
+        Facets:   toProductFacetsResponse(facets),
     }, nil
 }
 
 func (ps *productService) ListProductsAdmin(ctx context.Context, request *product.ListProductsAdminRequest) (*product.ListProductsAdminResponse, error){
-	claims, err := jwtentity.GetClaimsFromContext(ctx)
-	if err != nil {
-		return nil, utils.UnauthenticatedResponse()
-	}	
-
-	if claims.RoleCode != entity.UserRoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
-	}
-
-	
     const DefaultPage int32 = 1
     const DefaultLimit int32 = 10
     
@@ -293,10 +394,22 @@ func (ps *productService) ListProductsAdmin(ctx context.Context, request *produc
 		}
 	}
 
-    products, totalElements, err := ps.productRepository.ListProductsAdmin(ctx, page, limit, sort)
+	filter, errMessage := toProductFilter(request.GetFilter())
+	if errMessage != "" {
+		return &product.ListProductsAdminResponse{
+			Base: utils.BadRequestResponse(errMessage),
+		}, nil
+	}
+
+    products, totalElements, err := ps.productRepository.ListProductsAdmin(ctx, filter, page, limit, sort)
     if err != nil {
         return nil, err
     }
+
+	facets, err := ps.productRepository.GetProductFacets(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
     
     // 3. Hitung Total Pages
     totalPages := int32(math.Ceil(float64(totalElements) / float64(limit)))
@@ -312,8 +425,9 @@ func (ps *productService) ListProductsAdmin(ctx context.Context, request *produc
             Id:          p.Id,
             Name:        p.Name,
             Description: p.Description,
-            Price:       p.Price,
+            Price:       p.Price.Major(),
             ImageUrl:    fmt.Sprintf("%s/%s", os.Getenv("R2_PUBLIC_DOMAIN"), p.ImageFileName),
+            PriceMoney:  toMoneyResponse(p.Price),
 			CreatedAt:   timestamppb.New(p.CreatedAt),
 			CreatedBy:   utils.SafeDerefString(p.CreatedBy),
 			UpdatedAt:   timestamppb.New(p.UpdatedAt),
@@ -334,6 +448,7 @@ func (ps *productService) ListProductsAdmin(ctx context.Context, request *produc
             TotalElements: totalElements,
         },
         Products: productsData,
+        Facets:   toProductFacetsResponse(facets),
     }, nil
 }
 func (ps *productService) HighlightProducts(ctx context.Context, request *product.HighlightProductsRequest) (*product.HighlightProductsResponse, error){
@@ -350,8 +465,9 @@ func (ps *productService) HighlightProducts(ctx context.Context, request *produc
             Id:          p.Id,
             Name:        p.Name,
             Description: p.Description,
-            Price:       p.Price,
+            Price:       p.Price.Major(),
             ImageUrl:    fmt.Sprintf("%s/%s", os.Getenv("R2_PUBLIC_DOMAIN"), p.ImageFileName),
+            PriceMoney:  toMoneyResponse(p.Price),
         })
     }
 	return &product.HighlightProductsResponse{
@@ -360,9 +476,710 @@ func (ps *productService) HighlightProducts(ctx context.Context, request *produc
     }, nil
 }
 
-func NewProductService(productRepository repository.IProductRepository, storageService IStorageService) IProductService {
+func (ps *productService) SearchProducts(ctx context.Context, request *product.SearchProductsRequest) (*product.SearchProductsResponse, error){
+	const DefaultPage int32 = 1
+	const DefaultLimit int32 = 10
+
+	tsQuery := utils.BuildPrefixTsQuery(request.Query)
+	if tsQuery == "" {
+		return &product.SearchProductsResponse{
+			Base: utils.BadRequestResponse("Search query must contain at least one letter or digit"),
+		}, nil
+	}
+
+	paginationReq := request.GetPagination()
+	page := paginationReq.GetPage()
+	limit := paginationReq.GetLimit()
+	sort := paginationReq.GetSort()
+
+	if page == 0 {
+		page = DefaultPage
+	}
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+
+	products, totalElements, err := ps.productRepository.SearchProducts(ctx, tsQuery, page, limit, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int32(math.Ceil(float64(totalElements) / float64(limit)))
+	if totalElements == 0 {
+		totalPages = 0
+	}
+
+	productsData := make([]*product.Product, 0, len(products))
+	for _, p := range products {
+		productsData = append(productsData, &product.Product{
+			Id:          p.Id,
+			Name:        p.Name,
+			Description: p.Description,
+			Price:       p.Price.Major(),
+			ImageUrl:    fmt.Sprintf("%s/%s", os.Getenv("R2_PUBLIC_DOMAIN"), p.ImageFileName),
+			PriceMoney:  toMoneyResponse(p.Price),
+		})
+	}
+
+	return &product.SearchProductsResponse{
+		Base: utils.SuccessResponse("Products retrieved successfully"),
+		Pagination: &common.PaginationResponse{
+			Page:          page,
+			Limit:         limit,
+			TotalPages:    totalPages,
+			TotalElements: totalElements,
+		},
+		Products: productsData,
+	}, nil
+}
+
+func NewProductService(productRepository repository.IProductRepository, categoryRepository repository.ICategoryRepository, variantRepository repository.IProductVariantRepository, imageRepository repository.IProductImageRepository, storageService IStorageService) IProductService {
 	return &productService{
 		productRepository: productRepository,
+		categoryRepository: categoryRepository,
+		variantRepository: variantRepository,
+		imageRepository: imageRepository,
 		storageService: storageService,
 	}
-}
\ No newline at end of file
+}
+
+func (ps *productService) ListProductsByCategory(ctx context.Context, request *product.ListProductsByCategoryRequest) (*product.ListProductsByCategoryResponse, error) {
+	const DefaultPage int32 = 1
+	const DefaultLimit int32 = 10
+
+	paginationReq := request.GetPagination()
+	page := paginationReq.GetPage()
+	limit := paginationReq.GetLimit()
+	sort := paginationReq.GetSort()
+
+	if page == 0 {
+		page = DefaultPage
+	}
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+
+	if len(sort) == 0 {
+		sort = []*common.PaginationSortRequest{
+			{Field: "created_at", Order: "DESC"},
+		}
+	}
+
+	categoryData, err := ps.categoryRepository.GetCategoryById(ctx, request.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	if categoryData == nil {
+		return &product.ListProductsByCategoryResponse{
+			Base: utils.NotFoundResponse("Category not found"),
+		}, nil
+	}
+
+	// Filter kategori sudah mencakup seluruh sub-kategori
+	products, totalElements, err := ps.productRepository.ListProducts(ctx, &entity.ProductFilter{CategoryId: categoryData.Id}, page, limit, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int32(math.Ceil(float64(totalElements) / float64(limit)))
+	if totalElements == 0 {
+		totalPages = 0
+	}
+
+	productsData := make([]*product.Product, 0, len(products))
+	for _, p := range products {
+		productsData = append(productsData, &product.Product{
+			Id:          p.Id,
+			Name:        p.Name,
+			Description: p.Description,
+			Price:       p.Price.Major(),
+			ImageUrl:    fmt.Sprintf("%s/%s", os.Getenv("R2_PUBLIC_DOMAIN"), p.ImageFileName),
+			PriceMoney:  toMoneyResponse(p.Price),
+		})
+	}
+
+	return &product.ListProductsByCategoryResponse{
+		Base: utils.SuccessResponse("Products retrieved successfully"),
+		Pagination: &common.PaginationResponse{
+			Page:          page,
+			Limit:         limit,
+			TotalPages:    totalPages,
+			TotalElements: totalElements,
+		},
+		Products: productsData,
+	}, nil
+}
+
+// validateCategoryIds memastikan semua kategori yang di-assign ke produk ada dan aktif.
+// categoryIds harus sudah bebas duplikat, lihat uniqueStrings.
+func (ps *productService) validateCategoryIds(ctx context.Context, categoryIds []string) (bool, error) {
+	if len(categoryIds) == 0 {
+		return true, nil
+	}
+	count, err := ps.categoryRepository.CountActiveCategories(ctx, categoryIds)
+	if err != nil {
+		return false, err
+	}
+	return count == len(categoryIds), nil
+}
+
+// uniqueStrings returns values without duplicates, keeping the first occurrence of each.
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
+
+// toProductFilter mengubah filter dari request menjadi entity.ProductFilter.
+// Mengembalikan pesan error jika kombinasi filter tidak valid.
+func toProductFilter(f *product.ProductFilter) (*entity.ProductFilter, string) {
+	filter := &entity.ProductFilter{}
+	if f == nil {
+		return filter, ""
+	}
+
+	filter.MinPrice = f.MinPrice
+	filter.MaxPrice = f.MaxPrice
+	filter.NameContains = strings.TrimSpace(f.NameContains)
+	filter.IsDeleted = f.IsDeleted
+	filter.CategoryId = f.CategoryId
+	if f.CreatedFrom != nil {
+		t := f.CreatedFrom.AsTime()
+		filter.CreatedFrom = &t
+	}
+	if f.CreatedTo != nil {
+		t := f.CreatedTo.AsTime()
+		filter.CreatedTo = &t
+	}
+	if f.UpdatedFrom != nil {
+		t := f.UpdatedFrom.AsTime()
+		filter.UpdatedFrom = &t
+	}
+	if f.UpdatedTo != nil {
+		t := f.UpdatedTo.AsTime()
+		filter.UpdatedTo = &t
+	}
+
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return nil, "min_price must not be greater than max_price"
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && filter.CreatedFrom.After(*filter.CreatedTo) {
+		return nil, "created_from must not be after created_to"
+	}
+	if filter.UpdatedFrom != nil && filter.UpdatedTo != nil && filter.UpdatedFrom.After(*filter.UpdatedTo) {
+		return nil, "updated_from must not be after updated_to"
+	}
+	return filter, ""
+}
+
+func toProductFacetsResponse(facets *entity.ProductFacets) *product.ProductFacets {
+	toBuckets := func(buckets []*entity.FacetBucket) []*product.FacetBucket {
+		result := make([]*product.FacetBucket, 0, len(buckets))
+		for _, b := range buckets {
+			result = append(result, &product.FacetBucket{
+				Key:   b.Key,
+				Label: b.Label,
+				Count: b.Count,
+			})
+		}
+		return result
+	}
+
+	return &product.ProductFacets{
+		Price:     toBuckets(facets.Price),
+		CreatedAt: toBuckets(facets.CreatedAt),
+	}
+}
+
+func (ps *productService) SetProductOptions(ctx context.Context, request *product.SetProductOptionsRequest) (*product.SetProductOptionsResponse, error) {
+	productData, err := ps.productRepository.GetProductById(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productData == nil {
+		return &product.SetProductOptionsResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	options := make([]*entity.ProductOption, 0, len(request.Options))
+	seen := make(map[string]bool)
+	for i, o := range request.Options {
+		name := strings.TrimSpace(o.Name)
+		if seen[strings.ToLower(name)] {
+			return &product.SetProductOptionsResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Duplicate option %s", name)),
+			}, nil
+		}
+		seen[strings.ToLower(name)] = true
+		options = append(options, &entity.ProductOption{
+			Id:        uuid.NewString(),
+			ProductId: productData.Id,
+			Name:      name,
+			Position:  i,
+			Values:    o.Values,
+		})
+	}
+
+	// Varian yang sudah ada harus tetap valid terhadap opsi yang baru
+	variants, err := ps.variantRepository.ListVariantsByProductId(ctx, productData.Id)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range variants {
+		values := make(map[string]string, len(v.Options))
+		for _, o := range v.Options {
+			values[o.Name] = o.Value
+		}
+		if _, _, errMessage := resolveVariantOptions(options, values); errMessage != "" {
+			return &product.SetProductOptionsResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Variant %s no longer matches the options: %s", v.Sku, errMessage)),
+			}, nil
+		}
+	}
+
+	if err = ps.variantRepository.SetProductOptions(ctx, productData.Id, options); err != nil {
+		return nil, err
+	}
+
+	return &product.SetProductOptionsResponse{
+		Base: utils.SuccessResponse("Product options updated successfully"),
+	}, nil
+}
+
+func (ps *productService) CreateProductVariant(ctx context.Context, request *product.CreateProductVariantRequest) (*product.CreateProductVariantResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	price := optionalMoneyFromRequest(request.PriceMoney, request.Price)
+	if price != nil {
+		if errMessage := validatePrice(*price); errMessage != "" {
+			return &product.CreateProductVariantResponse{
+				Base: utils.BadRequestResponse(errMessage),
+			}, nil
+		}
+	}
+
+	productData, err := ps.productRepository.GetProductById(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productData == nil {
+		return &product.CreateProductVariantResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	newVariant := &entity.ProductVariant{
+		Id:        uuid.NewString(),
+		ProductId: productData.Id,
+		Sku:       strings.TrimSpace(request.Sku),
+		Price:     price,
+		Stock:     int(request.Stock),
+		CreatedAt: time.Now(),
+		CreatedBy: claims.FullName,
+	}
+	if request.ImageFileName != "" {
+		newVariant.ImageFileName = &request.ImageFileName
+	}
+
+	errMessage, err := ps.prepareVariant(ctx, newVariant, request.Options, "")
+	if err != nil {
+		return nil, err
+	}
+	if errMessage != "" {
+		return &product.CreateProductVariantResponse{
+			Base: utils.BadRequestResponse(errMessage),
+		}, nil
+	}
+
+	if err = ps.variantRepository.CreateVariant(ctx, newVariant); err != nil {
+		return nil, err
+	}
+
+	return &product.CreateProductVariantResponse{
+		Base: utils.SuccessResponse("Product variant created successfully"),
+		Id:   newVariant.Id,
+	}, nil
+}
+
+func (ps *productService) UpdateProductVariant(ctx context.Context, request *product.UpdateProductVariantRequest) (*product.UpdateProductVariantResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	price := optionalMoneyFromRequest(request.PriceMoney, request.Price)
+	if price != nil {
+		if errMessage := validatePrice(*price); errMessage != "" {
+			return &product.UpdateProductVariantResponse{
+				Base: utils.BadRequestResponse(errMessage),
+			}, nil
+		}
+	}
+
+	variantData, err := ps.variantRepository.GetVariantById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if variantData == nil {
+		return &product.UpdateProductVariantResponse{
+			Base: utils.NotFoundResponse("Product variant not found"),
+		}, nil
+	}
+
+	now := time.Now()
+	variantData.Sku = strings.TrimSpace(request.Sku)
+	variantData.Price = price
+	variantData.ImageFileName = nil
+	if request.ImageFileName != "" {
+		variantData.ImageFileName = &request.ImageFileName
+	}
+	variantData.UpdatedAt = &now
+	variantData.UpdatedBy = &claims.FullName
+
+	errMessage, err := ps.prepareVariant(ctx, variantData, request.Options, variantData.Id)
+	if err != nil {
+		return nil, err
+	}
+	if errMessage != "" {
+		return &product.UpdateProductVariantResponse{
+			Base: utils.BadRequestResponse(errMessage),
+		}, nil
+	}
+
+	if err = ps.variantRepository.UpdateVariant(ctx, variantData); err != nil {
+		return nil, err
+	}
+
+	return &product.UpdateProductVariantResponse{
+		Base: utils.SuccessResponse("Product variant updated successfully"),
+		Id:   variantData.Id,
+	}, nil
+}
+
+func (ps *productService) DeleteProductVariant(ctx context.Context, request *product.DeleteProductVariantRequest) (*product.DeleteProductVariantResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	variantData, err := ps.variantRepository.GetVariantById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if variantData == nil {
+		return &product.DeleteProductVariantResponse{
+			Base: utils.NotFoundResponse("Product variant not found"),
+		}, nil
+	}
+
+	if err = ps.variantRepository.DeleteVariant(ctx, time.Now(), claims.FullName, variantData.Id); err != nil {
+		return nil, err
+	}
+
+	return &product.DeleteProductVariantResponse{
+		Base: utils.SuccessResponse("Product variant deleted successfully"),
+	}, nil
+}
+
+func (ps *productService) AttachProductImage(ctx context.Context, request *product.AttachProductImageRequest) (*product.AttachProductImageResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	productData, err := ps.productRepository.GetProductById(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productData == nil {
+		return &product.AttachProductImageResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	exists, err := ps.storageService.CheckIfObjectExists(ctx, request.ImageFileName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Storage service check failed: %v", err)
+	}
+	if !exists {
+		return &product.AttachProductImageResponse{
+			Base: utils.BadRequestResponse("Image file not found in storage. Please upload the image first."),
+		}, nil
+	}
+
+	images, err := ps.imageRepository.ListImagesByProductId(ctx, productData.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	newImage := &entity.ProductImage{
+		Id:            uuid.NewString(),
+		ProductId:     productData.Id,
+		ImageFileName: request.ImageFileName,
+		// Gambar pertama di galeri otomatis menjadi primary
+		IsPrimary:     request.IsPrimary || len(images) == 0,
+		CreatedAt:     time.Now(),
+		CreatedBy:     claims.FullName,
+	}
+	if err = ps.imageRepository.AttachImage(ctx, newImage); err != nil {
+		return nil, err
+	}
+
+	return &product.AttachProductImageResponse{
+		Base: utils.SuccessResponse("Product image attached successfully"),
+		Id:   newImage.Id,
+	}, nil
+}
+
+func (ps *productService) ReorderProductImages(ctx context.Context, request *product.ReorderProductImagesRequest) (*product.ReorderProductImagesResponse, error) {
+	productData, err := ps.productRepository.GetProductById(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productData == nil {
+		return &product.ReorderProductImagesResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	images, err := ps.imageRepository.ListImagesByProductId(ctx, productData.Id)
+	if err != nil {
+		return nil, err
+	}
+	galleryIds := make(map[string]bool, len(images))
+	for _, i := range images {
+		galleryIds[i.Id] = true
+	}
+	if len(request.ImageIds) != len(images) {
+		return &product.ReorderProductImagesResponse{
+			Base: utils.BadRequestResponse("Image list must contain every image of the product exactly once"),
+		}, nil
+	}
+	// Setiap id dicoret setelah dipakai sehingga id duplikat ikut ditolak
+	for _, imageId := range request.ImageIds {
+		if !galleryIds[imageId] {
+			return &product.ReorderProductImagesResponse{
+				Base: utils.BadRequestResponse("Image list must contain every image of the product exactly once"),
+			}, nil
+		}
+		delete(galleryIds, imageId)
+	}
+
+	if err = ps.imageRepository.ReorderImages(ctx, productData.Id, request.ImageIds); err != nil {
+		return nil, err
+	}
+
+	return &product.ReorderProductImagesResponse{
+		Base: utils.SuccessResponse("Product images reordered successfully"),
+	}, nil
+}
+
+func (ps *productService) SetPrimaryProductImage(ctx context.Context, request *product.SetPrimaryProductImageRequest) (*product.SetPrimaryProductImageResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	imageData, err := ps.imageRepository.GetImageById(ctx, request.ImageId)
+	if err != nil {
+		return nil, err
+	}
+	if imageData == nil {
+		return &product.SetPrimaryProductImageResponse{
+			Base: utils.NotFoundResponse("Product image not found"),
+		}, nil
+	}
+
+	if !imageData.IsPrimary {
+		if err = ps.imageRepository.SetPrimaryImage(ctx, imageData, claims.FullName); err != nil {
+			return nil, err
+		}
+	}
+
+	return &product.SetPrimaryProductImageResponse{
+		Base: utils.SuccessResponse("Primary product image updated successfully"),
+	}, nil
+}
+
+func (ps *productService) DetachProductImage(ctx context.Context, request *product.DetachProductImageRequest) (*product.DetachProductImageResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	imageData, err := ps.imageRepository.GetImageById(ctx, request.ImageId)
+	if err != nil {
+		return nil, err
+	}
+	if imageData == nil {
+		return &product.DetachProductImageResponse{
+			Base: utils.NotFoundResponse("Product image not found"),
+		}, nil
+	}
+
+	images, err := ps.imageRepository.ListImagesByProductId(ctx, imageData.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if len(images) <= 1 {
+		return &product.DetachProductImageResponse{
+			Base: utils.BadRequestResponse("Product must have at least one image"),
+		}, nil
+	}
+
+	if err = ps.imageRepository.DetachImage(ctx, imageData, claims.FullName); err != nil {
+		return nil, err
+	}
+
+	ps.deleteImageIfUnused(ctx, imageData.ImageFileName)
+
+	return &product.DetachProductImageResponse{
+		Base: utils.SuccessResponse("Product image detached successfully"),
+	}, nil
+}
+
+// deleteImageIfUnused menghapus file dari storage jika tidak lagi dipakai produk, galeri atau varian mana pun.
+// Kegagalan hanya dicatat agar tidak menggagalkan perubahan produk yang sudah tersimpan.
+func (ps *productService) deleteImageIfUnused(ctx context.Context, imageFileName string) {
+	referenced, err := ps.imageRepository.IsImageFileReferenced(ctx, imageFileName)
+	if err != nil {
+		fmt.Printf("Failed to check references of image %s: %v\n", imageFileName, err)
+		return
+	}
+	if referenced {
+		return
+	}
+	if delErr := ps.storageService.DeleteObject(ctx, imageFileName); delErr != nil {
+		fmt.Printf("Failed to delete image %s from storage: %v\n", imageFileName, delErr)
+	}
+}
+
+// prepareVariant memvalidasi SKU, gambar dan nilai opsi varian, lalu mengisi Options dan Name.
+// excludeID adalah id varian itu sendiri saat update. Mengembalikan pesan error bisnis jika tidak valid.
+func (ps *productService) prepareVariant(ctx context.Context, variant *entity.ProductVariant, requestOptions []*product.VariantOptionValue, excludeID string) (string, error) {
+	options, err := ps.variantRepository.GetProductOptions(ctx, variant.ProductId)
+	if err != nil {
+		return "", err
+	}
+	if len(options) == 0 {
+		return "Product has no options, set the product options first", nil
+	}
+
+	values := make(map[string]string, len(requestOptions))
+	for _, o := range requestOptions {
+		name := strings.TrimSpace(o.Name)
+		if _, ok := values[name]; ok {
+			return fmt.Sprintf("Duplicate option %s", name), nil
+		}
+		values[name] = strings.TrimSpace(o.Value)
+	}
+	resolved, name, errMessage := resolveVariantOptions(options, values)
+	if errMessage != "" {
+		return errMessage, nil
+	}
+	variant.Options = resolved
+	variant.Name = name
+
+	// Satu kombinasi opsi hanya boleh dimiliki satu varian
+	variants, err := ps.variantRepository.ListVariantsByProductId(ctx, variant.ProductId)
+	if err != nil {
+		return "", err
+	}
+	for _, v := range variants {
+		if v.Id != excludeID && v.Name == variant.Name {
+			return fmt.Sprintf("Variant %s already exists", variant.Name), nil
+		}
+	}
+
+	skuTaken, err := ps.variantRepository.IsSkuTaken(ctx, variant.Sku, excludeID)
+	if err != nil {
+		return "", err
+	}
+	if skuTaken {
+		return "SKU already exists", nil
+	}
+
+	if variant.ImageFileName != nil {
+		exists, err := ps.storageService.CheckIfObjectExists(ctx, *variant.ImageFileName)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "Storage service check failed: %v", err)
+		}
+		if !exists {
+			return "Image file not found in storage. Please upload the image first.", nil
+		}
+	}
+	return "", nil
+}
+
+// resolveVariantOptions mencocokkan nilai opsi (nama opsi -> nilai) dengan opsi produk.
+// Setiap opsi produk wajib punya tepat satu nilai yang diizinkan. Hasilnya terurut sesuai posisi opsi,
+// beserta nama varian (mis. "M / Red").
+func resolveVariantOptions(options []*entity.ProductOption, values map[string]string) ([]*entity.ProductVariantOption, string, string) {
+	if len(values) != len(options) {
+		return nil, "", fmt.Sprintf("Variant must have exactly one value for each of the %d product options", len(options))
+	}
+
+	resolved := make([]*entity.ProductVariantOption, 0, len(options))
+	names := make([]string, 0, len(options))
+	for _, o := range options {
+		value, ok := values[o.Name]
+		if !ok {
+			return nil, "", fmt.Sprintf("Missing value for option %s", o.Name)
+		}
+		allowed := false
+		for _, v := range o.Values {
+			if v == value {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return nil, "", fmt.Sprintf("Invalid value %s for option %s", value, o.Name)
+		}
+		resolved = append(resolved, &entity.ProductVariantOption{
+			OptionId: o.Id,
+			Name:     o.Name,
+			Value:    value,
+		})
+		names = append(names, value)
+	}
+	return resolved, strings.Join(names, " / "), ""
+}
+
+func toProductVariantResponse(v *entity.ProductVariant, p *entity.Product) *product.ProductVariant {
+	imageFileName := p.ImageFileName
+	if v.ImageFileName != nil {
+		imageFileName = *v.ImageFileName
+	}
+
+	options := make([]*product.VariantOptionValue, 0, len(v.Options))
+	for _, o := range v.Options {
+		options = append(options, &product.VariantOptionValue{
+			Name:  o.Name,
+			Value: o.Value,
+		})
+	}
+
+	return &product.ProductVariant{
+		Id:               v.Id,
+		Sku:              v.Sku,
+		Name:             v.Name,
+		Price:            v.EffectivePrice(p.Price).Major(),
+		PriceMoney:       toMoneyResponse(v.EffectivePrice(p.Price)),
+		HasPriceOverride: v.Price != nil,
+		ImageUrl:         fmt.Sprintf("%s/%s", os.Getenv("R2_PUBLIC_DOMAIN"), imageFileName),
+		Stock:            int32(v.Stock),
+		InStock:          v.Stock > 0,
+		Options:          options,
+	}
+}
@@ -0,0 +1,501 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/common"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/product"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type fakeProductRepository struct {
+	repository.IProductRepository
+
+	products map[string]*entity.Product
+	updated  *entity.Product
+	created  *entity.Product
+	tsQuery  string
+	filter   *entity.ProductFilter
+}
+
+func (r *fakeProductRepository) GetProductById(ctx context.Context, id string) (*entity.Product, error) {
+	p, ok := r.products[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *p
+	return &copied, nil
+}
+
+// SearchProducts records the tsquery and returns every product.
+func (r *fakeProductRepository) SearchProducts(ctx context.Context, tsQuery string, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Product, int32, error) {
+	r.tsQuery = tsQuery
+	var found []*entity.Product
+	for _, p := range r.products {
+		found = append(found, p)
+	}
+	return found, int32(len(found)), nil
+}
+
+// ListProducts records the filter and returns every product.
+func (r *fakeProductRepository) ListProducts(ctx context.Context, filter *entity.ProductFilter, page int32, limit int32, sort []*common.PaginationSortRequest) ([]*entity.Product, int32, error) {
+	r.filter = filter
+	var found []*entity.Product
+	for _, p := range r.products {
+		found = append(found, p)
+	}
+	return found, int32(len(found)), nil
+}
+
+func (r *fakeProductRepository) GetProductFacets(ctx context.Context, filter *entity.ProductFilter) (*entity.ProductFacets, error) {
+	return &entity.ProductFacets{Price: []*entity.FacetBucket{{Key: "under_50000", Label: "Under 50,000", Count: int32(len(r.products))}}}, nil
+}
+
+func (r *fakeProductRepository) CreateProduct(ctx context.Context, p *entity.Product) error {
+	r.created = p
+	return nil
+}
+
+func (r *fakeProductRepository) UpdateProduct(ctx context.Context, p *entity.Product) error {
+	r.updated = p
+	return nil
+}
+
+// fakeCategoryRepository counts categories like COUNT(id) ... WHERE id = ANY($1): each existing category once.
+type fakeCategoryRepository struct {
+	repository.ICategoryRepository
+
+	active map[string]bool
+}
+
+func (r *fakeCategoryRepository) CountActiveCategories(ctx context.Context, ids []string) (int, error) {
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if r.active[id] {
+			seen[id] = true
+		}
+	}
+	return len(seen), nil
+}
+
+type fakeStorageService struct {
+	IStorageService
+
+	objects map[string]bool
+	deleted []string
+}
+
+func (s *fakeStorageService) CheckIfObjectExists(ctx context.Context, key string) (bool, error) {
+	return s.objects[key], nil
+}
+
+func (s *fakeStorageService) DeleteObject(ctx context.Context, key string) error {
+	s.deleted = append(s.deleted, key)
+	delete(s.objects, key)
+	return nil
+}
+
+type fakeProductImageRepository struct {
+	repository.IProductImageRepository
+
+	images     []*entity.ProductImage
+	referenced map[string]bool
+	reordered  []string
+}
+
+func (r *fakeProductImageRepository) ListImagesByProductId(ctx context.Context, productID string) ([]*entity.ProductImage, error) {
+	var images []*entity.ProductImage
+	for _, i := range r.images {
+		if i.ProductId == productID {
+			images = append(images, i)
+		}
+	}
+	return images, nil
+}
+
+func (r *fakeProductImageRepository) ReorderImages(ctx context.Context, productID string, imageIDs []string) error {
+	r.reordered = imageIDs
+	return nil
+}
+
+func (r *fakeProductImageRepository) GetImageById(ctx context.Context, id string) (*entity.ProductImage, error) {
+	for _, i := range r.images {
+		if i.Id == id {
+			copied := *i
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeProductImageRepository) AttachImage(ctx context.Context, image *entity.ProductImage) error {
+	if image.IsPrimary {
+		r.markPrimary(image.ProductId, "")
+	}
+	r.images = append(r.images, image)
+	return nil
+}
+
+func (r *fakeProductImageRepository) SetPrimaryImage(ctx context.Context, image *entity.ProductImage, updatedBy string) error {
+	r.markPrimary(image.ProductId, image.Id)
+	return nil
+}
+
+// DetachImage removes the image; the next primary image is chosen by the SQL repository and not modelled here.
+func (r *fakeProductImageRepository) DetachImage(ctx context.Context, image *entity.ProductImage, updatedBy string) error {
+	kept := r.images[:0]
+	for _, i := range r.images {
+		if i.Id != image.Id {
+			kept = append(kept, i)
+		}
+	}
+	r.images = kept
+	return nil
+}
+
+func (r *fakeProductImageRepository) markPrimary(productID string, imageID string) {
+	for _, i := range r.images {
+		if i.ProductId == productID {
+			i.IsPrimary = i.Id == imageID
+		}
+	}
+}
+
+func (r *fakeProductImageRepository) IsImageFileReferenced(ctx context.Context, imageFileName string) (bool, error) {
+	return r.referenced[imageFileName], nil
+}
+
+func (r *fakeProductRepository) DeleteProduct(ctx context.Context, deletedAt time.Time, deletedBy string, productID string) error {
+	delete(r.products, productID)
+	return nil
+}
+
+func newTestProductService() (*productService, *fakeProductRepository, *fakeStorageService) {
+	products := &fakeProductRepository{products: map[string]*entity.Product{
+		"p1": {Id: "p1", Name: "Kaos", Description: "Kaos polos", Price: entity.NewMoney(10000, entity.DefaultCurrency), ImageFileName: "products/p1.jpg"},
+	}}
+	categories := &fakeCategoryRepository{active: map[string]bool{"c1": true, "c2": true}}
+	storage := &fakeStorageService{objects: map[string]bool{"products/p1.jpg": true, "products/new.jpg": true}}
+	images := &fakeProductImageRepository{
+		images: []*entity.ProductImage{
+			{Id: "i1", ProductId: "p1", ImageFileName: "products/p1.jpg", IsPrimary: true},
+			{Id: "i2", ProductId: "p1", ImageFileName: "products/p1-side.jpg", SortOrder: 1},
+		},
+		referenced: map[string]bool{},
+	}
+	return &productService{
+		productRepository:  products,
+		categoryRepository: categories,
+		imageRepository:    images,
+		storageService:     storage,
+	}, products, storage
+}
+
+func TestCreateProductAcceptsDuplicateCategoryIds(t *testing.T) {
+	svc, products, _ := newTestProductService()
+
+	res, err := svc.CreateProduct(contextWithUser("admin"), &product.CreateProductRequest{
+		Name:          "Kemeja",
+		Description:   "Kemeja flanel",
+		PriceMoney:    toMoneyResponse(entity.NewMoney(50000, entity.DefaultCurrency)),
+		ImageFileName: "products/new.jpg",
+		CategoryIds:   []string{"c1", "c2", "c1"},
+	})
+	if err != nil {
+		t.Fatalf("CreateProduct returned error: %v", err)
+	}
+	if res.GetBase().GetIsError() {
+		t.Fatalf("CreateProduct failed: %s", res.GetBase().GetMessage())
+	}
+	if want := []string{"c1", "c2"}; !slices.Equal(products.created.CategoryIds, want) {
+		t.Errorf("got categories %v, want %v", products.created.CategoryIds, want)
+	}
+}
+
+func TestUpdateProductCategories(t *testing.T) {
+	tests := []struct {
+		name           string
+		categoryIds    []string
+		clear          bool
+		wantCategories []string
+		wantError      bool
+	}{
+		{name: "omitted leaves categories unchanged", wantCategories: nil},
+		{name: "explicit clear", clear: true, wantCategories: []string{}},
+		{name: "replace", categoryIds: []string{"c2"}, wantCategories: []string{"c2"}},
+		{name: "replace with duplicates", categoryIds: []string{"c2", "c1", "c2"}, wantCategories: []string{"c2", "c1"}},
+		{name: "unknown category", categoryIds: []string{"c1", "missing"}, wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, products, _ := newTestProductService()
+
+			res, err := svc.UpdateProduct(contextWithUser("admin"), &product.UpdateProductRequest{
+				Id:               "p1",
+				Name:             "Kaos",
+				Description:      "Kaos polos",
+				PriceMoney:       toMoneyResponse(entity.NewMoney(12000, entity.DefaultCurrency)),
+				ImageFileName:    "products/p1.jpg",
+				CategoryIds:      tt.categoryIds,
+				ClearCategoryIds: tt.clear,
+			})
+			if err != nil {
+				t.Fatalf("UpdateProduct returned error: %v", err)
+			}
+			if res.GetBase().GetIsError() != tt.wantError {
+				t.Fatalf("got error response %v (%s), want %v", res.GetBase().GetIsError(), res.GetBase().GetMessage(), tt.wantError)
+			}
+			if tt.wantError {
+				return
+			}
+			got := products.updated.CategoryIds
+			if (got == nil) != (tt.wantCategories == nil) || !slices.Equal(got, tt.wantCategories) {
+				t.Errorf("got categories %#v, want %#v", got, tt.wantCategories)
+			}
+		})
+	}
+}
+
+func TestReorderProductImagesRejectsDuplicates(t *testing.T) {
+	tests := []struct {
+		name      string
+		imageIds  []string
+		wantError bool
+	}{
+		{name: "every image once", imageIds: []string{"i2", "i1"}},
+		{name: "duplicate id", imageIds: []string{"i1", "i1"}, wantError: true},
+		{name: "missing image", imageIds: []string{"i1"}, wantError: true},
+		{name: "foreign image", imageIds: []string{"i1", "other"}, wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, _ := newTestProductService()
+
+			res, err := svc.ReorderProductImages(contextWithUser("admin"), &product.ReorderProductImagesRequest{ProductId: "p1", ImageIds: tt.imageIds})
+			if err != nil {
+				t.Fatalf("ReorderProductImages returned error: %v", err)
+			}
+			if res.GetBase().GetIsError() != tt.wantError {
+				t.Errorf("got error response %v (%s), want %v", res.GetBase().GetIsError(), res.GetBase().GetMessage(), tt.wantError)
+			}
+		})
+	}
+}
+
+func TestUpdateProductKeepsReferencedOldImage(t *testing.T) {
+	tests := []struct {
+		name        string
+		referenced  bool
+		wantDeleted []string
+	}{
+		{name: "old image still used by gallery or variant", referenced: true, wantDeleted: nil},
+		{name: "old image unused", referenced: false, wantDeleted: []string{"products/p1.jpg"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, storage := newTestProductService()
+			svc.imageRepository.(*fakeProductImageRepository).referenced["products/p1.jpg"] = tt.referenced
+
+			res, err := svc.UpdateProduct(contextWithUser("admin"), &product.UpdateProductRequest{
+				Id:            "p1",
+				Name:          "Kaos",
+				Description:   "Kaos polos",
+				PriceMoney:    toMoneyResponse(entity.NewMoney(12000, entity.DefaultCurrency)),
+				ImageFileName: "products/new.jpg",
+			})
+			if err != nil || res.GetBase().GetIsError() {
+				t.Fatalf("UpdateProduct failed: %v %s", err, res.GetBase().GetMessage())
+			}
+			if !slices.Equal(storage.deleted, tt.wantDeleted) {
+				t.Errorf("got deleted objects %v, want %v", storage.deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestDeleteProductDeletesOnlyUnreferencedImages(t *testing.T) {
+	svc, _, storage := newTestProductService()
+	// Gambar samping juga dipakai produk lain
+	svc.imageRepository.(*fakeProductImageRepository).referenced["products/p1-side.jpg"] = true
+
+	res, err := svc.DeleteProduct(contextWithUser("admin"), &product.DeleteProductRequest{Id: "p1"})
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("DeleteProduct failed: %v %s", err, res.GetBase().GetMessage())
+	}
+	if want := []string{"products/p1.jpg"}; !slices.Equal(storage.deleted, want) {
+		t.Errorf("got deleted objects %v, want %v", storage.deleted, want)
+	}
+}
+
+func TestSearchProducts(t *testing.T) {
+	svc, products, _ := newTestProductService()
+
+	res, err := svc.SearchProducts(context.Background(), &product.SearchProductsRequest{Query: "Kaos pol"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if products.tsQuery != "kaos:* & pol:*" {
+		t.Errorf("got tsquery %q", products.tsQuery)
+	}
+	if res.GetBase().GetIsError() || len(res.GetProducts()) != 1 || res.GetProducts()[0].GetId() != "p1" {
+		t.Fatalf("got %q with %d products", res.GetBase().GetMessage(), len(res.GetProducts()))
+	}
+	if p := res.GetPagination(); p.GetPage() != 1 || p.GetLimit() != 10 || p.GetTotalPages() != 1 || p.GetTotalElements() != 1 {
+		t.Errorf("got pagination %v, want the defaults with one page", p)
+	}
+}
+
+func TestSearchProductsWithoutSearchableTerms(t *testing.T) {
+	svc, products, _ := newTestProductService()
+
+	res, err := svc.SearchProducts(context.Background(), &product.SearchProductsRequest{Query: " & | ! "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.GetBase().GetStatusCode() != 400 || products.tsQuery != "" {
+		t.Errorf("got %d and tsquery %q, want 400 without a search", res.GetBase().GetStatusCode(), products.tsQuery)
+	}
+}
+
+func TestToProductFilter(t *testing.T) {
+	jan, feb := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	price := func(v float64) *float64 { return &v }
+	tests := []struct {
+		name        string
+		filter      *product.ProductFilter
+		wantMessage string
+	}{
+		{name: "no filter"},
+		{name: "price range", filter: &product.ProductFilter{MinPrice: price(100), MaxPrice: price(200)}},
+		{name: "equal prices", filter: &product.ProductFilter{MinPrice: price(100), MaxPrice: price(100)}},
+		{name: "inverted price range", filter: &product.ProductFilter{MinPrice: price(200), MaxPrice: price(100)},
+			wantMessage: "min_price must not be greater than max_price"},
+		{name: "created range", filter: &product.ProductFilter{CreatedFrom: timestamppb.New(jan), CreatedTo: timestamppb.New(feb)}},
+		{name: "inverted created range", filter: &product.ProductFilter{CreatedFrom: timestamppb.New(feb), CreatedTo: timestamppb.New(jan)},
+			wantMessage: "created_from must not be after created_to"},
+		{name: "inverted updated range", filter: &product.ProductFilter{UpdatedFrom: timestamppb.New(feb), UpdatedTo: timestamppb.New(jan)},
+			wantMessage: "updated_from must not be after updated_to"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, message := toProductFilter(tt.filter)
+			if message != tt.wantMessage || (message == "") != (filter != nil) {
+				t.Errorf("got %+v, %q; want message %q", filter, message, tt.wantMessage)
+			}
+		})
+	}
+
+	filter, _ := toProductFilter(&product.ProductFilter{NameContains: "  kaos  ", CreatedFrom: timestamppb.New(jan)})
+	if filter.NameContains != "kaos" || filter.CreatedFrom == nil || !filter.CreatedFrom.Equal(jan) {
+		t.Errorf("got %+v, want a trimmed name and the created_from time", filter)
+	}
+}
+
+func TestListProductsHidesDeletedProducts(t *testing.T) {
+	svc, products, _ := newTestProductService()
+	deleted := true
+
+	res, err := svc.ListProducts(context.Background(), &product.ListProductsRequest{Filter: &product.ProductFilter{IsDeleted: &deleted}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.GetBase().GetIsError() {
+		t.Fatalf("got %q", res.GetBase().GetMessage())
+	}
+	if products.filter == nil || products.filter.IsDeleted == nil || *products.filter.IsDeleted {
+		t.Errorf("got filter %+v, want deleted products excluded", products.filter)
+	}
+	if buckets := res.GetFacets().GetPrice(); len(buckets) != 1 || buckets[0].GetCount() != 1 {
+		t.Errorf("got price facets %v", buckets)
+	}
+}
+
+func TestAttachProductImage(t *testing.T) {
+	tests := []struct {
+		name         string
+		productId    string
+		imageFile    string
+		emptyGallery bool
+		wantCode     int64
+		wantPrimary  bool
+	}{
+		{name: "added to the gallery", productId: "p1", imageFile: "products/new.jpg", wantCode: 200},
+		{name: "first image becomes primary", productId: "p1", imageFile: "products/new.jpg", emptyGallery: true, wantCode: 200, wantPrimary: true},
+		{name: "not uploaded", productId: "p1", imageFile: "products/missing.jpg", wantCode: 400},
+		{name: "unknown product", productId: "missing", imageFile: "products/new.jpg", wantCode: 404},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, _ := newTestProductService()
+			images := svc.imageRepository.(*fakeProductImageRepository)
+			if tt.emptyGallery {
+				images.images = nil
+			}
+
+			res, err := svc.AttachProductImage(contextWithUser("admin"), &product.AttachProductImageRequest{ProductId: tt.productId, ImageFileName: tt.imageFile})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := res.GetBase().GetStatusCode(); got != tt.wantCode {
+				t.Fatalf("got status code %d (%s), want %d", got, res.GetBase().GetMessage(), tt.wantCode)
+			}
+			if tt.wantCode != 200 {
+				return
+			}
+			attached, _ := images.GetImageById(context.Background(), res.GetId())
+			if attached == nil || attached.ImageFileName != tt.imageFile || attached.IsPrimary != tt.wantPrimary {
+				t.Errorf("got %+v, want %s attached with primary %v", attached, tt.imageFile, tt.wantPrimary)
+			}
+		})
+	}
+}
+
+func TestSetPrimaryProductImage(t *testing.T) {
+	svc, _, _ := newTestProductService()
+	images := svc.imageRepository.(*fakeProductImageRepository)
+
+	res, err := svc.SetPrimaryProductImage(contextWithUser("admin"), &product.SetPrimaryProductImageRequest{ImageId: "i2"})
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("SetPrimaryProductImage: %v / %q", err, res.GetBase().GetMessage())
+	}
+	for _, i := range images.images {
+		if i.IsPrimary != (i.Id == "i2") {
+			t.Errorf("image %s primary = %v", i.Id, i.IsPrimary)
+		}
+	}
+
+	res, err = svc.SetPrimaryProductImage(contextWithUser("admin"), &product.SetPrimaryProductImageRequest{ImageId: "missing"})
+	if err != nil || res.GetBase().GetStatusCode() != 404 {
+		t.Errorf("got %v / %q, want 404 for an unknown image", err, res.GetBase().GetMessage())
+	}
+}
+
+func TestDetachProductImage(t *testing.T) {
+	svc, _, storage := newTestProductService()
+	images := svc.imageRepository.(*fakeProductImageRepository)
+
+	// Gambar samping tidak dipakai di tempat lain, jadi file-nya ikut dihapus
+	res, err := svc.DetachProductImage(contextWithUser("admin"), &product.DetachProductImageRequest{ImageId: "i2"})
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("DetachProductImage: %v / %q", err, res.GetBase().GetMessage())
+	}
+	if len(images.images) != 1 || images.images[0].Id != "i1" {
+		t.Errorf("got gallery %+v, want only i1", images.images)
+	}
+	if want := []string{"products/p1-side.jpg"}; !slices.Equal(storage.deleted, want) {
+		t.Errorf("got deleted objects %v, want %v", storage.deleted, want)
+	}
+
+	res, err = svc.DetachProductImage(contextWithUser("admin"), &product.DetachProductImageRequest{ImageId: "i1"})
+	if err != nil || res.GetBase().GetStatusCode() != 400 {
+		t.Errorf("got %v / %q, want the last image to be kept", err, res.GetBase().GetMessage())
+	}
+	if len(images.images) != 1 {
+		t.Errorf("got %d images, want the last image to stay", len(images.images))
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+)
+
+// RateLimitPurgeInterval is how often RunRateLimitPurge removes expired counters and lockouts.
+const RateLimitPurgeInterval = time.Hour
+
+// Tujuan rate limit, dipakai sebagai awalan key yang disimpan
+const (
+	rateLimitPurposeLoginAccount = "login-account"
+	rateLimitPurposeLoginIP      = "login-ip"
+	rateLimitPurposeGuestCartIP  = "guest-cart-ip"
+)
+
+// rateLimitPolicy decides when repeated hits lock a key and for how long.
+// After Threshold hits within Window every further hit doubles the lockout, starting at BaseLockout and capped at MaxLockout.
+type rateLimitPolicy struct {
+	Threshold   int
+	Window      time.Duration
+	BaseLockout time.Duration
+	MaxLockout  time.Duration
+}
+
+func (p rateLimitPolicy) lockoutFor(hitCount int) time.Duration {
+	if hitCount < p.Threshold {
+		return 0
+	}
+	lockout := p.BaseLockout
+	for i := p.Threshold; i < hitCount && lockout < p.MaxLockout; i++ {
+		lockout *= 2
+	}
+	if lockout > p.MaxLockout {
+		lockout = p.MaxLockout
+	}
+	return lockout
+}
+
+// rateLimitKey returns the stored key of subject (an email, an IP address) for purpose.
+func rateLimitKey(purpose string, subject string) string {
+	return purpose + ":" + subject
+}
+
+// rateLimiter counts hits per key and locks keys that cross the threshold of their policy.
+type rateLimiter struct {
+	repository repository.IRateLimitRepository
+}
+
+// lockedUntil returns the latest lockout among keys, or nil when none is locked.
+func (l *rateLimiter) lockedUntil(ctx context.Context, keys []string, now time.Time) (*time.Time, error) {
+	return l.repository.GetLockedUntil(ctx, keys, now)
+}
+
+// hit counts one hit on key and locks it when the count crossed the threshold of policy.
+func (l *rateLimiter) hit(ctx context.Context, key string, policy rateLimitPolicy, now time.Time) error {
+	hitCount, err := l.repository.RecordHit(ctx, key, now, policy.Window)
+	if err != nil {
+		return err
+	}
+	if lockout := policy.lockoutFor(hitCount); lockout > 0 {
+		return l.repository.LockKey(ctx, key, now.Add(lockout))
+	}
+	return nil
+}
+
+// reset forgets the hits and lockout of key.
+func (l *rateLimiter) reset(ctx context.Context, key string) error {
+	return l.repository.ClearKey(ctx, key)
+}
+
+// RunRateLimitPurge deletes expired rate limit keys every interval until ctx is cancelled.
+func RunRateLimitPurge(ctx context.Context, rateLimitRepository repository.IRateLimitRepository, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			purged, err := rateLimitRepository.PurgeExpiredRateLimits(ctx, now)
+			if err != nil {
+				log.Printf("failed to purge rate limits: %v", err)
+				continue
+			}
+			if purged > 0 {
+				log.Printf("purged %d expired rate limits", purged)
+			}
+		}
+	}
+}
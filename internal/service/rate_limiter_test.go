@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/auth"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/cart"
+)
+
+// memoryRateLimitRepository counts hits and keeps lockouts per key in memory.
+type memoryRateLimitRepository struct {
+	repository.IRateLimitRepository
+
+	counts      map[string]int
+	lockedUntil map[string]time.Time
+}
+
+func newMemoryRateLimitRepository() *memoryRateLimitRepository {
+	return &memoryRateLimitRepository{counts: map[string]int{}, lockedUntil: map[string]time.Time{}}
+}
+
+func (r *memoryRateLimitRepository) GetLockedUntil(ctx context.Context, keys []string, now time.Time) (*time.Time, error) {
+	for _, key := range keys {
+		if until, ok := r.lockedUntil[key]; ok && until.After(now) {
+			return &until, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryRateLimitRepository) RecordHit(ctx context.Context, key string, now time.Time, window time.Duration) (int, error) {
+	r.counts[key]++
+	return r.counts[key], nil
+}
+
+func (r *memoryRateLimitRepository) LockKey(ctx context.Context, key string, lockedUntil time.Time) error {
+	r.lockedUntil[key] = lockedUntil
+	return nil
+}
+
+func (r *memoryRateLimitRepository) ClearKey(ctx context.Context, key string) error {
+	delete(r.counts, key)
+	delete(r.lockedUntil, key)
+	return nil
+}
+
+func TestRateLimitPolicyLockout(t *testing.T) {
+	policy := rateLimitPolicy{Threshold: 3, Window: time.Minute, BaseLockout: time.Minute, MaxLockout: 5 * time.Minute}
+	tests := []struct {
+		hitCount int
+		want     time.Duration
+	}{
+		{hitCount: 2, want: 0},
+		{hitCount: 3, want: time.Minute},
+		{hitCount: 4, want: 2 * time.Minute},
+		{hitCount: 5, want: 4 * time.Minute},
+		{hitCount: 6, want: 5 * time.Minute},
+		{hitCount: 100, want: 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := policy.lockoutFor(tt.hitCount); got != tt.want {
+			t.Errorf("lockoutFor(%d) = %v, want %v", tt.hitCount, got, tt.want)
+		}
+	}
+}
+
+func TestRateLimitKeysPerPurpose(t *testing.T) {
+	limits := newMemoryRateLimitRepository()
+	authSvc := newLoginThrottleTestService(t)
+	authSvc.loginThrottle = &loginThrottle{limiter: &rateLimiter{repository: limits}}
+	cartSvc := NewCartService(nil, nil, nil, nil, &fakeGuestCartRepository{}, nil, limits, nil, 0)
+	ctx := contextFromIP("203.0.113.7")
+
+	if _, err := authSvc.Login(ctx, &auth.LoginRequest{Email: "User@Example.com", Password: "wrong"}); err == nil {
+		t.Fatal("login with a wrong password succeeded")
+	}
+	if _, err := cartSvc.CreateGuestCart(ctx, &cart.CreateGuestCartRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Login gagal dan pembuatan guest cart dari IP yang sama dihitung terpisah
+	want := map[string]int{"login-account:user@example.com": 1, "login-ip:203.0.113.7": 1, "guest-cart-ip:203.0.113.7": 1}
+	if len(limits.counts) != len(want) {
+		t.Errorf("got keys %v, want %v", limits.counts, want)
+	}
+	for key, count := range want {
+		if limits.counts[key] != count {
+			t.Errorf("got %d hits for %s, want %d", limits.counts[key], key, count)
+		}
+	}
+}
+
+// purgeSignalRateLimitRepository reports the time of each purge on a channel.
+type purgeSignalRateLimitRepository struct {
+	repository.IRateLimitRepository
+	purged chan time.Time
+}
+
+func (r *purgeSignalRateLimitRepository) PurgeExpiredRateLimits(ctx context.Context, now time.Time) (int64, error) {
+	select {
+	case r.purged <- now:
+	default:
+	}
+	return 0, nil
+}
+
+func TestRunRateLimitPurge(t *testing.T) {
+	limits := &purgeSignalRateLimitRepository{purged: make(chan time.Time, 1)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		RunRateLimitPurge(ctx, limits, time.Millisecond)
+		close(done)
+	}()
+	select {
+	case <-limits.purged:
+	case <-time.After(time.Second):
+		t.Fatal("purge did not run")
+	}
+	cancel()
+	<-done
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/role"
+	"github.com/google/uuid"
+	gocache "github.com/patrickmn/go-cache"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RolePermissionCacheTTL bounds how long a permission change can take to reach other replicas.
+const RolePermissionCacheTTL = time.Minute
+
+// IPermissionResolver resolves the permissions granted to a role.
+type IPermissionResolver interface {
+	RolePermissions(ctx context.Context, roleCode string) (entity.PermissionSet, error)
+}
+
+// IRoleService defines the interface for role and permission management. Access is enforced by the permission middleware.
+type IRoleService interface {
+	IPermissionResolver
+	ListPermissions(ctx context.Context, request *role.ListPermissionsRequest) (*role.ListPermissionsResponse, error)
+	ListRoles(ctx context.Context, request *role.ListRolesRequest) (*role.ListRolesResponse, error)
+	CreateRole(ctx context.Context, request *role.CreateRoleRequest) (*role.CreateRoleResponse, error)
+	// UpdateRole renames a role and replaces its permissions. The built-in admin role cannot be changed.
+	UpdateRole(ctx context.Context, request *role.UpdateRoleRequest) (*role.UpdateRoleResponse, error)
+	// DeleteRole deletes a role that is not built in and not assigned to any user.
+	DeleteRole(ctx context.Context, request *role.DeleteRoleRequest) (*role.DeleteRoleResponse, error)
+	// AssignUserRole changes the role of a user. Access tokens issued before the change stop working.
+	// The caller needs role:manage whichever RPC it came through.
+	AssignUserRole(ctx context.Context, request *role.AssignUserRoleRequest) (*role.AssignUserRoleResponse, error)
+}
+
+// RoleService implements IRoleService.
+type RoleService struct {
+	roleRepository  repository.IRoleRepository
+	authRepository  repository.IAuthRepository
+	revocationStore ITokenRevocationStore
+	permissionCache *gocache.Cache
+}
+
+// NewRoleService creates a new instance of RoleService.
+func NewRoleService(roleRepository repository.IRoleRepository, authRepository repository.IAuthRepository, revocationStore ITokenRevocationStore) IRoleService {
+	return &RoleService{
+		roleRepository:  roleRepository,
+		authRepository:  authRepository,
+		revocationStore: revocationStore,
+		permissionCache: gocache.New(RolePermissionCacheTTL, 10*time.Minute),
+	}
+}
+
+// RolePermissions returns the permissions of a role, cached for RolePermissionCacheTTL.
+func (s *RoleService) RolePermissions(ctx context.Context, roleCode string) (entity.PermissionSet, error) {
+	if cached, ok := s.permissionCache.Get(roleCode); ok {
+		return cached.(entity.PermissionSet), nil
+	}
+	codes, err := s.roleRepository.GetRolePermissions(ctx, roleCode)
+	if err != nil {
+		return nil, err
+	}
+	permissions := entity.NewPermissionSet(codes)
+	s.permissionCache.SetDefault(roleCode, permissions)
+	return permissions, nil
+}
+
+func (s *RoleService) ListPermissions(ctx context.Context, request *role.ListPermissionsRequest) (*role.ListPermissionsResponse, error) {
+	permissions, err := s.roleRepository.ListPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*role.Permission, 0, len(permissions))
+	for _, p := range permissions {
+		items = append(items, &role.Permission{
+			Code:        p.Code,
+			Description: p.Description,
+		})
+	}
+
+	return &role.ListPermissionsResponse{
+		Base:        utils.SuccessResponse("List permissions successful"),
+		Permissions: items,
+	}, nil
+}
+
+func (s *RoleService) ListRoles(ctx context.Context, request *role.ListRolesRequest) (*role.ListRolesResponse, error) {
+	roles, err := s.roleRepository.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*role.Role, 0, len(roles))
+	for _, r := range roles {
+		items = append(items, &role.Role{
+			Code:        r.Code,
+			Name:        r.Name,
+			Permissions: r.Permissions,
+		})
+	}
+
+	return &role.ListRolesResponse{
+		Base:  utils.SuccessResponse("List roles successful"),
+		Roles: items,
+	}, nil
+}
+
+func (s *RoleService) CreateRole(ctx context.Context, request *role.CreateRoleRequest) (*role.CreateRoleResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	existing, err := s.roleRepository.GetRoleByCode(ctx, request.Code)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return &role.CreateRoleResponse{
+			Base: utils.BadRequestResponse("Role code is already used"),
+		}, nil
+	}
+
+	errMessage, err := s.validatePermissions(ctx, request.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	if errMessage != "" {
+		return &role.CreateRoleResponse{
+			Base: utils.BadRequestResponse(errMessage),
+		}, nil
+	}
+
+	err = s.roleRepository.CreateRole(ctx, &entity.UserRole{
+		Id:          uuid.NewString(),
+		Name:        request.Name,
+		Code:        request.Code,
+		CreatedAt:   time.Now(),
+		CreatedBy:   &claims.FullName,
+		Permissions: request.Permissions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.permissionCache.Delete(request.Code)
+
+	return &role.CreateRoleResponse{
+		Base: utils.SuccessResponse("Role created successfully"),
+	}, nil
+}
+
+func (s *RoleService) UpdateRole(ctx context.Context, request *role.UpdateRoleRequest) (*role.UpdateRoleResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	// Admin selalu memegang semua permission supaya tidak ada yang bisa mengunci dirinya sendiri
+	if request.Code == entity.UserRoleAdmin {
+		return &role.UpdateRoleResponse{
+			Base: utils.BadRequestResponse("The admin role cannot be modified"),
+		}, nil
+	}
+
+	existing, err := s.roleRepository.GetRoleByCode(ctx, request.Code)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return &role.UpdateRoleResponse{
+			Base: utils.NotFoundResponse("Role not found"),
+		}, nil
+	}
+
+	errMessage, err := s.validatePermissions(ctx, request.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	if errMessage != "" {
+		return &role.UpdateRoleResponse{
+			Base: utils.BadRequestResponse(errMessage),
+		}, nil
+	}
+
+	existing.Name = request.Name
+	existing.Permissions = request.Permissions
+	existing.UpdatedAt = time.Now()
+	existing.UpdatedBy = &claims.FullName
+	if err = s.roleRepository.UpdateRole(ctx, existing); err != nil {
+		return nil, err
+	}
+	s.permissionCache.Delete(request.Code)
+
+	return &role.UpdateRoleResponse{
+		Base: utils.SuccessResponse("Role updated successfully"),
+	}, nil
+}
+
+func (s *RoleService) DeleteRole(ctx context.Context, request *role.DeleteRoleRequest) (*role.DeleteRoleResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	if request.Code == entity.UserRoleAdmin || request.Code == entity.UserRoleCustomer {
+		return &role.DeleteRoleResponse{
+			Base: utils.BadRequestResponse("Built-in roles cannot be deleted"),
+		}, nil
+	}
+
+	existing, err := s.roleRepository.GetRoleByCode(ctx, request.Code)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return &role.DeleteRoleResponse{
+			Base: utils.NotFoundResponse("Role not found"),
+		}, nil
+	}
+
+	userCount, err := s.roleRepository.CountUsersWithRole(ctx, request.Code)
+	if err != nil {
+		return nil, err
+	}
+	if userCount > 0 {
+		return &role.DeleteRoleResponse{
+			Base: utils.BadRequestResponse("Role is still assigned to users"),
+		}, nil
+	}
+
+	if err = s.roleRepository.DeleteRole(ctx, time.Now(), claims.FullName, request.Code); err != nil {
+		return nil, err
+	}
+	s.permissionCache.Delete(request.Code)
+
+	return &role.DeleteRoleResponse{
+		Base: utils.SuccessResponse("Role deleted successfully"),
+	}, nil
+}
+
+func (s *RoleService) AssignUserRole(ctx context.Context, request *role.AssignUserRoleRequest) (*role.AssignUserRoleResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+	// Memberi role sama dengan memberi permission, jadi user:manage saja tidak cukup
+	if !entity.HasPermission(ctx, entity.PermissionRoleManage) {
+		return nil, status.Error(codes.PermissionDenied, "Permission denied")
+	}
+
+	if request.UserId == claims.Subject {
+		return &role.AssignUserRoleResponse{
+			Base: utils.BadRequestResponse("You cannot change your own role"),
+		}, nil
+	}
+
+	user, err := s.authRepository.GetUserById(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return &role.AssignUserRoleResponse{
+			Base: utils.NotFoundResponse("User not found"),
+		}, nil
+	}
+
+	roleData, err := s.roleRepository.GetRoleByCode(ctx, request.RoleCode)
+	if err != nil {
+		return nil, err
+	}
+	if roleData == nil {
+		return &role.AssignUserRoleResponse{
+			Base: utils.NotFoundResponse("Role not found"),
+		}, nil
+	}
+
+	if err = s.roleRepository.AssignUserRole(ctx, user.Id, roleData.Code, claims.FullName); err != nil {
+		return nil, err
+	}
+
+	// role_code ada di dalam access token, jadi token lama dicabut; refresh token tetap berlaku dan menerbitkan token dengan role baru
+	now := time.Now()
+	if err = s.revocationStore.RevokeUser(ctx, user.Id, now, now.Add(AccessTokenTTL)); err != nil {
+		return nil, err
+	}
+
+	return &role.AssignUserRoleResponse{
+		Base: utils.SuccessResponse("User role updated successfully"),
+	}, nil
+}
+
+// validatePermissions returns an error message when codes contains an unknown permission.
+func (s *RoleService) validatePermissions(ctx context.Context, codes []string) (string, error) {
+	if len(codes) == 0 {
+		return "", nil
+	}
+	count, err := s.roleRepository.CountExistingPermissions(ctx, codes)
+	if err != nil {
+		return "", err
+	}
+	if count != len(codes) {
+		return "Unknown permission code", nil
+	}
+	return "", nil
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/role"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeRoleRepository knows the admin role and the roles in permissions, and records role assignments.
+type fakeRoleRepository struct {
+	repository.IRoleRepository
+
+	assigned    map[string]string
+	permissions map[string][]string
+	// permissionReads counts how often role permissions were loaded
+	permissionReads int
+}
+
+func (r *fakeRoleRepository) GetRoleByCode(ctx context.Context, code string) (*entity.UserRole, error) {
+	if code == entity.UserRoleAdmin {
+		return &entity.UserRole{Code: code, Name: "Admin"}, nil
+	}
+	if permissions, ok := r.permissions[code]; ok {
+		return &entity.UserRole{Code: code, Name: code, Permissions: permissions}, nil
+	}
+	return nil, nil
+}
+
+func (r *fakeRoleRepository) GetRolePermissions(ctx context.Context, roleCode string) ([]string, error) {
+	r.permissionReads++
+	return r.permissions[roleCode], nil
+}
+
+func (r *fakeRoleRepository) UpdateRole(ctx context.Context, userRole *entity.UserRole) error {
+	r.permissions[userRole.Code] = userRole.Permissions
+	return nil
+}
+
+// CountExistingPermissions treats every code with a "resource:action" shape as a known permission.
+func (r *fakeRoleRepository) CountExistingPermissions(ctx context.Context, codes []string) (int, error) {
+	count := 0
+	for _, code := range codes {
+		if strings.Contains(code, ":") {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *fakeRoleRepository) AssignUserRole(ctx context.Context, userID string, roleCode string, updatedBy string) error {
+	r.assigned[userID] = roleCode
+	return nil
+}
+
+func newTestRoleService() (*RoleService, *fakeRoleRepository) {
+	roles := &fakeRoleRepository{assigned: map[string]string{}, permissions: map[string][]string{}}
+	users := newFakeAuthRepository(&entity.User{Id: "target", Email: "target@example.com"})
+	svc := NewRoleService(roles, users, NewMemoryTokenRevocationStore(time.Minute)).(*RoleService)
+	return svc, roles
+}
+
+func contextWithPermissions(userID string, permissions ...string) context.Context {
+	return entity.NewPermissionSet(permissions).SetToContext(contextWithUser(userID))
+}
+
+func TestAssignUserRoleRequiresRoleManage(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions []string
+		wantCode    codes.Code
+	}{
+		{name: "user manage only", permissions: []string{entity.PermissionUserManage}, wantCode: codes.PermissionDenied},
+		{name: "no permissions", wantCode: codes.PermissionDenied},
+		{name: "role manage", permissions: []string{entity.PermissionRoleManage}, wantCode: codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, roles := newTestRoleService()
+			ctx := contextWithPermissions("caller", tt.permissions...)
+
+			_, err := svc.AssignUserRole(ctx, &role.AssignUserRoleRequest{UserId: "target", RoleCode: entity.UserRoleAdmin})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("got code %v (%v), want %v", got, err, tt.wantCode)
+			}
+			if _, changed := roles.assigned["target"]; changed != (tt.wantCode == codes.OK) {
+				t.Errorf("role assigned = %v, want %v", changed, tt.wantCode == codes.OK)
+			}
+		})
+	}
+}
+
+func TestChangeUserRoleWithUserManageIsDenied(t *testing.T) {
+	roleService, roles := newTestRoleService()
+	svc := &UserAdminService{roleService: roleService}
+
+	for _, target := range []string{"target", "caller"} {
+		ctx := contextWithPermissions("caller", entity.PermissionUserManage, entity.PermissionUserRead)
+		_, err := svc.ChangeUserRole(ctx, &user.ChangeUserRoleRequest{Id: target, RoleCode: entity.UserRoleAdmin})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("target %s: got %v, want PermissionDenied", target, err)
+		}
+	}
+	if len(roles.assigned) != 0 {
+		t.Errorf("got role assignments %v, want none", roles.assigned)
+	}
+}
+
+func TestRolePermissionsCacheInvalidatedOnUpdate(t *testing.T) {
+	svc, roles := newTestRoleService()
+	roles.permissions["support"] = []string{entity.PermissionUserRead}
+	ctx := contextWithPermissions("admin", entity.PermissionRoleManage)
+
+	for i := 0; i < 2; i++ {
+		permissions, err := svc.RolePermissions(ctx, "support")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !permissions.Has(entity.PermissionUserRead) || permissions.Has(entity.PermissionUserManage) {
+			t.Fatalf("got %v, want only %s", permissions, entity.PermissionUserRead)
+		}
+	}
+	if roles.permissionReads != 1 {
+		t.Errorf("got %d permission reads, want the second lookup to be cached", roles.permissionReads)
+	}
+
+	res, err := svc.UpdateRole(ctx, &role.UpdateRoleRequest{Code: "support", Name: "Support", Permissions: []string{entity.PermissionUserRead, entity.PermissionUserManage}})
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("UpdateRole: %v / %q", err, res.GetBase().GetMessage())
+	}
+	permissions, err := svc.RolePermissions(ctx, "support")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !permissions.Has(entity.PermissionUserManage) {
+		t.Errorf("got %v after the update, want %s", permissions, entity.PermissionUserManage)
+	}
+}
+
+func TestUpdateRoleRejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  *role.UpdateRoleRequest
+		wantCode int64
+	}{
+		{name: "admin role", request: &role.UpdateRoleRequest{Code: entity.UserRoleAdmin, Name: "Admin"}, wantCode: 400},
+		{name: "unknown role", request: &role.UpdateRoleRequest{Code: "missing", Name: "Missing"}, wantCode: 404},
+		{name: "unknown permission", request: &role.UpdateRoleRequest{Code: "support", Name: "Support", Permissions: []string{"everything"}}, wantCode: 400},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, roles := newTestRoleService()
+			roles.permissions["support"] = []string{entity.PermissionUserRead}
+
+			res, err := svc.UpdateRole(contextWithPermissions("admin", entity.PermissionRoleManage), tt.request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := res.GetBase().GetStatusCode(); got != tt.wantCode {
+				t.Errorf("got status code %d (%s), want %d", got, res.GetBase().GetMessage(), tt.wantCode)
+			}
+			if got := roles.permissions["support"]; len(got) != 1 || got[0] != entity.PermissionUserRead {
+				t.Errorf("got support permissions %v, want them unchanged", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+)
+
+const (
+	PostgresTokenRevocationStoreName = "postgres"
+	MemoryTokenRevocationStoreName   = "memory"
+)
+
+// ITokenRevocationStore keeps the IDs of access tokens that were revoked before they expired.
+type ITokenRevocationStore interface {
+	// Revoke marks a token ID as revoked. The entry can be forgotten once expiresAt has passed.
+	Revoke(ctx context.Context, jti string, expiresAt time.Time) error
+	// RevokeUser revokes every token of the user issued before revokedBefore.
+	// The cut-off is truncated to whole seconds, the precision of the iat claim, so tokens issued
+	// within the same second as the revocation stay valid.
+	// The entry can be forgotten once expiresAt has passed, i.e. revokedBefore plus the access token lifetime.
+	RevokeUser(ctx context.Context, userID string, revokedBefore time.Time, expiresAt time.Time) error
+	// IsRevoked reports whether the token itself, or the tokens of its user issued at issuedAt, have been revoked.
+	IsRevoked(ctx context.Context, jti string, userID string, issuedAt time.Time) (bool, error)
+}
+
+// NewTokenRevocationStore returns the store selected by name (TOKEN_REVOCATION_STORE).
+// The PostgreSQL store is shared by every replica and survives restarts; the memory store is only suitable for a single instance.
+func NewTokenRevocationStore(ctx context.Context, name string, revokedTokenRepository repository.IRevokedTokenRepository, purgeInterval time.Duration) (ITokenRevocationStore, error) {
+	switch name {
+	case "", PostgresTokenRevocationStoreName:
+		store := NewPostgresTokenRevocationStore(revokedTokenRepository)
+		go store.RunPurge(ctx, purgeInterval)
+		return store, nil
+	case MemoryTokenRevocationStoreName:
+		return NewMemoryTokenRevocationStore(purgeInterval), nil
+	default:
+		return nil, fmt.Errorf("unknown token revocation store %q", name)
+	}
+}
+
+// PostgresTokenRevocationStore persists revocations in the revoked_token table.
+type PostgresTokenRevocationStore struct {
+	revokedTokenRepository repository.IRevokedTokenRepository
+}
+
+func NewPostgresTokenRevocationStore(revokedTokenRepository repository.IRevokedTokenRepository) *PostgresTokenRevocationStore {
+	return &PostgresTokenRevocationStore{revokedTokenRepository: revokedTokenRepository}
+}
+
+func (s *PostgresTokenRevocationStore) Revoke(ctx context.Context, jti string, expiresAt time.Time) error {
+	return s.revokedTokenRepository.RevokeToken(ctx, jti, expiresAt)
+}
+
+func (s *PostgresTokenRevocationStore) RevokeUser(ctx context.Context, userID string, revokedBefore time.Time, expiresAt time.Time) error {
+	return s.revokedTokenRepository.RevokeUserTokens(ctx, userID, revocationCutoff(revokedBefore), expiresAt)
+}
+
+func (s *PostgresTokenRevocationStore) IsRevoked(ctx context.Context, jti string, userID string, issuedAt time.Time) (bool, error) {
+	revoked, err := s.revokedTokenRepository.IsTokenRevoked(ctx, jti)
+	if err != nil || revoked {
+		return revoked, err
+	}
+	revokedBefore, err := s.revokedTokenRepository.GetUserRevokedBefore(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return revokedBefore != nil && issuedBeforeCutoff(issuedAt, *revokedBefore), nil
+}
+
+// revocationCutoff truncates a user revocation cut-off to whole seconds, the precision of the iat claim.
+func revocationCutoff(revokedBefore time.Time) time.Time {
+	return revokedBefore.Truncate(time.Second)
+}
+
+// issuedBeforeCutoff reports whether a token issued at issuedAt is covered by a user revocation cut-off.
+// Both sides are compared in whole seconds.
+func issuedBeforeCutoff(issuedAt time.Time, revokedBefore time.Time) bool {
+	return issuedAt.Truncate(time.Second).Before(revocationCutoff(revokedBefore))
+}
+
+// RunPurge deletes expired revocations every interval until ctx is cancelled.
+func (s *PostgresTokenRevocationStore) RunPurge(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			purged, err := s.revokedTokenRepository.PurgeExpiredTokens(ctx, now)
+			if err != nil {
+				log.Printf("failed to purge revoked tokens: %v", err)
+				continue
+			}
+			if purged > 0 {
+				log.Printf("purged %d expired revoked tokens", purged)
+			}
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+)
+
+// fakeRevokedTokenRepository keeps token IDs and user cut-offs the way the revoked_token and revoked_user_token tables do.
+type fakeRevokedTokenRepository struct {
+	repository.IRevokedTokenRepository
+
+	tokens        map[string]time.Time
+	revokedBefore map[string]time.Time
+}
+
+func newFakeRevokedTokenRepository() *fakeRevokedTokenRepository {
+	return &fakeRevokedTokenRepository{tokens: map[string]time.Time{}, revokedBefore: map[string]time.Time{}}
+}
+
+func (r *fakeRevokedTokenRepository) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	if _, ok := r.tokens[jti]; !ok {
+		r.tokens[jti] = expiresAt
+	}
+	return nil
+}
+
+func (r *fakeRevokedTokenRepository) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	expiresAt, ok := r.tokens[jti]
+	return ok && expiresAt.After(time.Now()), nil
+}
+
+func (r *fakeRevokedTokenRepository) RevokeUserTokens(ctx context.Context, userID string, revokedBefore time.Time, expiresAt time.Time) error {
+	if current, ok := r.revokedBefore[userID]; !ok || revokedBefore.After(current) {
+		r.revokedBefore[userID] = revokedBefore
+	}
+	return nil
+}
+
+func (r *fakeRevokedTokenRepository) GetUserRevokedBefore(ctx context.Context, userID string) (*time.Time, error) {
+	revokedBefore, ok := r.revokedBefore[userID]
+	if !ok {
+		return nil, nil
+	}
+	return &revokedBefore, nil
+}
+
+// testRevocationStores builds a fresh store of every kind.
+var testRevocationStores = map[string]func() ITokenRevocationStore{
+	"postgres": func() ITokenRevocationStore {
+		return NewPostgresTokenRevocationStore(newFakeRevokedTokenRepository())
+	},
+	"memory": func() ITokenRevocationStore { return NewMemoryTokenRevocationStore(time.Minute) },
+}
+
+func TestRevokeUserComparesWholeSeconds(t *testing.T) {
+	// Revocation di tengah detik, iat selalu dibulatkan ke detik
+	revokedAt := time.Now().Truncate(time.Second).Add(600 * time.Millisecond)
+	tests := []struct {
+		name     string
+		issuedAt time.Time
+		want     bool
+	}{
+		{name: "previous second", issuedAt: revokedAt.Add(-time.Second).Truncate(time.Second), want: true},
+		{name: "same second", issuedAt: revokedAt.Truncate(time.Second), want: false},
+		{name: "next second", issuedAt: revokedAt.Add(time.Second).Truncate(time.Second), want: false},
+		{name: "no iat", issuedAt: time.Time{}, want: true},
+	}
+	for storeName, newStore := range testRevocationStores {
+		for _, tt := range tests {
+			t.Run(storeName+"/"+tt.name, func(t *testing.T) {
+				store := newStore()
+				if err := store.RevokeUser(context.Background(), "u1", revokedAt, revokedAt.Add(AccessTokenTTL)); err != nil {
+					t.Fatal(err)
+				}
+				revoked, err := store.IsRevoked(context.Background(), "jti", "u1", tt.issuedAt)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if revoked != tt.want {
+					t.Errorf("got revoked %v, want %v", revoked, tt.want)
+				}
+				if revoked, _ := store.IsRevoked(context.Background(), "jti", "u2", tt.issuedAt); revoked {
+					t.Error("another user's token is revoked")
+				}
+			})
+		}
+	}
+}
+
+func TestRevokeToken(t *testing.T) {
+	for storeName, newStore := range testRevocationStores {
+		t.Run(storeName, func(t *testing.T) {
+			store := newStore()
+			issuedAt := time.Now().Truncate(time.Second)
+			if err := store.Revoke(context.Background(), "revoked-jti", issuedAt.Add(AccessTokenTTL)); err != nil {
+				t.Fatal(err)
+			}
+			if err := store.Revoke(context.Background(), "expired-jti", time.Now().Add(-time.Second)); err != nil {
+				t.Fatal(err)
+			}
+
+			tests := []struct {
+				jti  string
+				want bool
+			}{
+				{jti: "revoked-jti", want: true},
+				{jti: "expired-jti", want: false},
+				{jti: "other-jti", want: false},
+			}
+			for _, tt := range tests {
+				revoked, err := store.IsRevoked(context.Background(), tt.jti, "u1", issuedAt)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if revoked != tt.want {
+					t.Errorf("%s: got revoked %v, want %v", tt.jti, revoked, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRevokeUserKeepsLatestCutoff(t *testing.T) {
+	for storeName, newStore := range testRevocationStores {
+		t.Run(storeName, func(t *testing.T) {
+			store := newStore()
+			later := time.Now().Truncate(time.Second)
+			earlier := later.Add(-time.Minute)
+			// Revocation yang lebih lama datang belakangan tidak boleh memundurkan cut-off
+			for _, revokedAt := range []time.Time{later, earlier} {
+				if err := store.RevokeUser(context.Background(), "u1", revokedAt, revokedAt.Add(AccessTokenTTL)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			revoked, err := store.IsRevoked(context.Background(), "jti", "u1", later.Add(-time.Second))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !revoked {
+				t.Error("token issued before the latest cut-off is not revoked")
+			}
+		})
+	}
+}
+
+func TestNewTokenRevocationStore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "", want: "*service.PostgresTokenRevocationStore"},
+		{name: PostgresTokenRevocationStoreName, want: "*service.PostgresTokenRevocationStore"},
+		{name: MemoryTokenRevocationStoreName, want: "*service.MemoryTokenRevocationStore"},
+		{name: "redis", wantErr: true},
+	}
+	for _, tt := range tests {
+		store, err := NewTokenRevocationStore(ctx, tt.name, newFakeRevokedTokenRepository(), time.Hour)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("%q: got error %v, want error %v", tt.name, err, tt.wantErr)
+		}
+		if got := fmt.Sprintf("%T", store); !tt.wantErr && got != tt.want {
+			t.Errorf("%q: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
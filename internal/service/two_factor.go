@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/google/uuid"
+)
+
+const (
+	TOTPChallengeTTL  = 5 * time.Minute
+	RecoveryCodeCount = 10
+	// totpSkewSteps accepts the previous and next code to tolerate clock drift on the user's device.
+	totpSkewSteps = 1
+)
+
+// ParseTOTPEncryptionKey decodes TOTP_ENCRYPTION_KEY, a base64 encoded 32 byte AES-256 key. An empty value returns nil.
+func ParseTOTPEncryptionKey(value string) ([]byte, error) {
+	if value == "" {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("TOTP_ENCRYPTION_KEY is not valid base64: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("TOTP_ENCRYPTION_KEY must be 32 bytes, got %d", len(key))
+	}
+	return key, nil
+}
+
+// sealTOTPSecret encrypts the secret with AES-GCM and returns base64(nonce || ciphertext).
+func sealTOTPSecret(key []byte, secret string) (string, error) {
+	gcm, err := newTOTPCipher(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func openTOTPSecret(key []byte, sealed string) (string, error) {
+	gcm, err := newTOTPCipher(key)
+	if err != nil {
+		return "", err
+	}
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil || len(data) < gcm.NonceSize() {
+		return "", fmt.Errorf("invalid encrypted totp secret")
+	}
+	secret, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt totp secret: %w", err)
+	}
+	return string(secret), nil
+}
+
+func newTOTPCipher(key []byte) (cipher.AEAD, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("TOTP_ENCRYPTION_KEY is not configured")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// newRecoveryCodes returns RecoveryCodeCount codes formatted as xxxx-xxxx-xxxx-xxxx together with their hashes.
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, RecoveryCodeCount)
+	hashes := make([]string, 0, RecoveryCodeCount)
+	for i := 0; i < RecoveryCodeCount; i++ {
+		b := make([]byte, 10)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		raw := strings.ToLower(base32.StdEncoding.EncodeToString(b))
+		codes = append(codes, raw[0:4]+"-"+raw[4:8]+"-"+raw[8:12]+"-"+raw[12:16])
+		hashes = append(hashes, utils.HashToken(raw))
+	}
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// verifySecondFactor accepts either a current TOTP code that was not used before or an unused recovery code.
+func (s *authService) verifySecondFactor(ctx context.Context, totp *entity.UserTOTP, code string, now time.Time) (bool, error) {
+	if len(strings.TrimSpace(code)) == utils.TOTPDigits {
+		secret, err := openTOTPSecret(s.config.TOTPEncryptionKey, totp.SecretEncrypted)
+		if err != nil {
+			return false, err
+		}
+		step, ok := utils.ValidateTOTP(secret, code, now, totpSkewSteps)
+		if !ok {
+			return false, nil
+		}
+		return s.userTOTPRepository.UseTOTPStep(ctx, totp.UserId, step)
+	}
+	return s.userTOTPRepository.ConsumeRecoveryCode(ctx, totp.UserId, utils.HashToken(normalizeRecoveryCode(code)), now)
+}
+
+// issueSession issues an access token and starts a new refresh token family.
+func (s *authService) issueSession(ctx context.Context, user *entity.User, now time.Time, mfa bool) (string, string, error) {
+	accessToken, err := s.generateAccessToken(user, now, mfa, true)
+	if err != nil {
+		return "", "", err
+	}
+	refreshToken, refreshTokenData, err := newRefreshToken(user.Id, uuid.NewString(), now, mfa)
+	if err != nil {
+		return "", "", err
+	}
+	if err = s.refreshTokenRepository.InsertRefreshToken(ctx, refreshTokenData); err != nil {
+		return "", "", err
+	}
+	return accessToken, refreshToken, nil
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/auth"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newTOTPTestService(t *testing.T, password string) (*authService, *fakeUserTOTPRepository, *fakeRefreshTokenRepository) {
+	t.Helper()
+	user := &entity.User{Id: "u1", Email: "user@example.com", FullName: "User"}
+	if password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+		if err != nil {
+			t.Fatal(err)
+		}
+		user.Password = string(hash)
+	}
+	totps := newFakeUserTOTPRepository()
+	refreshTokens := &fakeRefreshTokenRepository{}
+	svc := &authService{
+		authRepository:         newFakeAuthRepository(user),
+		refreshTokenRepository: refreshTokens,
+		userTOTPRepository:     totps,
+		revocationStore:        NewMemoryTokenRevocationStore(time.Minute),
+		loginThrottle:          &loginThrottle{limiter: &rateLimiter{repository: &fakeRateLimitRepository{}}},
+		config:                 AuthConfig{TOTPIssuer: "Shop", TOTPEncryptionKey: make([]byte, 32)},
+	}
+	return svc, totps, refreshTokens
+}
+
+func TestEnrollTOTPRequiresProofOfIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		password     string
+		ctx          context.Context
+		request      *auth.EnrollTOTPRequest
+		wantEnrolled bool
+	}{
+		{name: "correct password", password: "secret-password", ctx: contextWithSignIn("u1", time.Time{}), request: &auth.EnrollTOTPRequest{Password: "secret-password"}, wantEnrolled: true},
+		{name: "missing password", password: "secret-password", ctx: contextWithSignIn("u1", time.Time{}), request: &auth.EnrollTOTPRequest{}},
+		{name: "wrong password", password: "secret-password", ctx: contextWithSignIn("u1", time.Time{}), request: &auth.EnrollTOTPRequest{Password: "guess"}},
+		{name: "passwordless after fresh sign-in", ctx: contextWithSignIn("u1", time.Now()), request: &auth.EnrollTOTPRequest{}, wantEnrolled: true},
+		{name: "passwordless with refreshed token", ctx: contextWithSignIn("u1", time.Time{}), request: &auth.EnrollTOTPRequest{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, totps, _ := newTOTPTestService(t, tt.password)
+
+			res, err := svc.EnrollTOTP(tt.ctx, tt.request)
+			if err != nil {
+				t.Fatalf("EnrollTOTP returned error: %v", err)
+			}
+			_, enrolled := totps.totps["u1"]
+			if enrolled != tt.wantEnrolled || (res.GetSecret() != "") != tt.wantEnrolled {
+				t.Errorf("got enrolled %v secret %q (%q), want enrolled %v", enrolled, res.GetSecret(), res.GetBase().GetMessage(), tt.wantEnrolled)
+			}
+		})
+	}
+}
+
+func TestConfirmTOTPRevokesOtherSessions(t *testing.T) {
+	svc, totps, refreshTokens := newTOTPTestService(t, "secret-password")
+	ctx := contextWithSignIn("u1", time.Time{})
+	enrolled, err := svc.EnrollTOTP(ctx, &auth.EnrollTOTPRequest{Password: "secret-password"})
+	if err != nil || enrolled.GetBase().GetIsError() {
+		t.Fatalf("EnrollTOTP: %v / %q", err, enrolled.GetBase().GetMessage())
+	}
+	code, err := utils.TOTPCode(enrolled.GetSecret(), utils.TOTPStep(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := svc.ConfirmTOTP(ctx, &auth.ConfirmTOTPRequest{Code: code, Password: "wrong"})
+	if err != nil || !res.GetBase().GetIsError() || totps.totps["u1"].Enabled() {
+		t.Fatalf("got %v / %q, want a wrong password to be rejected", err, res.GetBase().GetMessage())
+	}
+
+	issuedBefore := time.Now().Add(-time.Second)
+	res, err = svc.ConfirmTOTP(ctx, &auth.ConfirmTOTPRequest{Code: code, Password: "secret-password"})
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("ConfirmTOTP: %v / %q", err, res.GetBase().GetMessage())
+	}
+	if !totps.totps["u1"].Enabled() || len(res.GetRecoveryCodes()) != RecoveryCodeCount {
+		t.Errorf("got enabled %v with %d recovery codes", totps.totps["u1"].Enabled(), len(res.GetRecoveryCodes()))
+	}
+	if len(refreshTokens.revokedUsers) != 1 || refreshTokens.revokedUsers[0] != "u1" {
+		t.Errorf("got refresh token revocations %v, want u1", refreshTokens.revokedUsers)
+	}
+	if revoked, _ := svc.revocationStore.IsRevoked(context.Background(), "old-jti", "u1", issuedBefore); !revoked {
+		t.Error("access tokens issued before enabling two-factor authentication are still valid")
+	}
+
+	claims := parseAccessToken(t, res.GetAccessToken())
+	if revoked, _ := svc.revocationStore.IsRevoked(context.Background(), claims.ID, "u1", claims.IssuedAtTime()); revoked {
+		t.Error("the new access token is revoked")
+	}
+	if !claims.HasMFA() || res.GetRefreshToken() == "" || len(refreshTokens.inserted) != 1 || !refreshTokens.inserted[0].MFA {
+		t.Errorf("got mfa %v and refresh token %q, want a new two-factor session", claims.HasMFA(), res.GetRefreshToken())
+	}
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	jwtentity "github.com/daiyanuthsa/grpc-ecom-be/internal/entity/jwt"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/utils"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/common"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/role"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// IUserAdminService defines admin user management. Access is enforced by the permission middleware
+// (user:read for reads, user:manage for changes).
+type IUserAdminService interface {
+	// ListUsers returns a page of users, optionally searched by email/name and filtered by role or deletion.
+	ListUsers(ctx context.Context, request *user.ListUsersRequest) (*user.ListUsersResponse, error)
+	GetUser(ctx context.Context, request *user.GetUserRequest) (*user.GetUserResponse, error)
+	ChangeUserRole(ctx context.Context, request *user.ChangeUserRoleRequest) (*user.ChangeUserRoleResponse, error)
+	// DeleteUser soft-deletes a user and ends all of their sessions.
+	DeleteUser(ctx context.Context, request *user.DeleteUserRequest) (*user.DeleteUserResponse, error)
+	RestoreUser(ctx context.Context, request *user.RestoreUserRequest) (*user.RestoreUserResponse, error)
+	// ForceLogoutUser revokes every access and refresh token of a user.
+	ForceLogoutUser(ctx context.Context, request *user.ForceLogoutUserRequest) (*user.ForceLogoutUserResponse, error)
+}
+
+// UserAdminService implements IUserAdminService.
+type UserAdminService struct {
+	userRepository         repository.IUserRepository
+	authRepository         repository.IAuthRepository
+	refreshTokenRepository repository.IRefreshTokenRepository
+	revocationStore        ITokenRevocationStore
+	roleService            IRoleService
+}
+
+// NewUserAdminService creates a new instance of UserAdminService.
+func NewUserAdminService(userRepository repository.IUserRepository, authRepository repository.IAuthRepository, refreshTokenRepository repository.IRefreshTokenRepository, revocationStore ITokenRevocationStore, roleService IRoleService) IUserAdminService {
+	return &UserAdminService{
+		userRepository:         userRepository,
+		authRepository:         authRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		revocationStore:        revocationStore,
+		roleService:            roleService,
+	}
+}
+
+func (s *UserAdminService) ListUsers(ctx context.Context, request *user.ListUsersRequest) (*user.ListUsersResponse, error) {
+	const DefaultPage int32 = 1
+	const DefaultLimit int32 = 10
+
+	paginationReq := request.GetPagination()
+	page := paginationReq.GetPage()
+	limit := paginationReq.GetLimit()
+	sort := paginationReq.GetSort()
+
+	if page == 0 {
+		page = DefaultPage
+	}
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+
+	filter := &entity.UserFilter{
+		Search:   request.Search,
+		RoleCode: request.RoleCode,
+	}
+	if request.IsDeleted != nil {
+		isDeleted := request.GetIsDeleted()
+		filter.IsDeleted = &isDeleted
+	}
+
+	users, totalElements, err := s.userRepository.ListUsers(ctx, filter, page, limit, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int32(math.Ceil(float64(totalElements) / float64(limit)))
+	if totalElements == 0 {
+		totalPages = 0
+	}
+
+	usersData := make([]*user.User, 0, len(users))
+	for _, u := range users {
+		usersData = append(usersData, toUserResponse(u))
+	}
+
+	return &user.ListUsersResponse{
+		Base: utils.SuccessResponse("Users retrieved successfully"),
+		Pagination: &common.PaginationResponse{
+			Page:          page,
+			Limit:         limit,
+			TotalPages:    totalPages,
+			TotalElements: totalElements,
+		},
+		Users: usersData,
+	}, nil
+}
+
+func (s *UserAdminService) GetUser(ctx context.Context, request *user.GetUserRequest) (*user.GetUserResponse, error) {
+	userData, err := s.userRepository.GetUserById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if userData == nil {
+		return &user.GetUserResponse{
+			Base: utils.NotFoundResponse("User not found"),
+		}, nil
+	}
+
+	return &user.GetUserResponse{
+		Base: utils.SuccessResponse("User retrieved successfully"),
+		User: toUserResponse(userData),
+	}, nil
+}
+
+// ChangeUserRole memakai logika yang sama dengan RoleService.AssignUserRole, termasuk syarat permission role:manage.
+func (s *UserAdminService) ChangeUserRole(ctx context.Context, request *user.ChangeUserRoleRequest) (*user.ChangeUserRoleResponse, error) {
+	res, err := s.roleService.AssignUserRole(ctx, &role.AssignUserRoleRequest{
+		UserId:   request.Id,
+		RoleCode: request.RoleCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user.ChangeUserRoleResponse{
+		Base: res.Base,
+	}, nil
+}
+
+func (s *UserAdminService) DeleteUser(ctx context.Context, request *user.DeleteUserRequest) (*user.DeleteUserResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	if request.Id == claims.Subject {
+		return &user.DeleteUserResponse{
+			Base: utils.BadRequestResponse("You cannot delete your own account"),
+		}, nil
+	}
+
+	userData, err := s.userRepository.GetUserById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if userData == nil || userData.IsDeleted {
+		return &user.DeleteUserResponse{
+			Base: utils.NotFoundResponse("User not found"),
+		}, nil
+	}
+
+	if err = s.userRepository.SoftDeleteUser(ctx, time.Now(), claims.FullName, userData.Id); err != nil {
+		return nil, err
+	}
+	if err = s.revokeUserSessions(ctx, userData.Id); err != nil {
+		return nil, err
+	}
+
+	return &user.DeleteUserResponse{
+		Base: utils.SuccessResponse("User deleted successfully"),
+	}, nil
+}
+
+func (s *UserAdminService) RestoreUser(ctx context.Context, request *user.RestoreUserRequest) (*user.RestoreUserResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	userData, err := s.userRepository.GetUserById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if userData == nil || !userData.IsDeleted {
+		return &user.RestoreUserResponse{
+			Base: utils.NotFoundResponse("Deleted user not found"),
+		}, nil
+	}
+
+	// Email bisa sudah dipakai akun baru setelah akun ini dihapus
+	activeUser, err := s.authRepository.GetUserByEmail(ctx, userData.Email)
+	if err != nil {
+		return nil, err
+	}
+	if activeUser != nil {
+		return &user.RestoreUserResponse{
+			Base: utils.BadRequestResponse("Email is already used by another active account"),
+		}, nil
+	}
+
+	if err = s.userRepository.RestoreUser(ctx, time.Now(), claims.FullName, userData.Id); err != nil {
+		return nil, err
+	}
+
+	return &user.RestoreUserResponse{
+		Base: utils.SuccessResponse("User restored successfully"),
+	}, nil
+}
+
+func (s *UserAdminService) ForceLogoutUser(ctx context.Context, request *user.ForceLogoutUserRequest) (*user.ForceLogoutUserResponse, error) {
+	userData, err := s.userRepository.GetUserById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if userData == nil {
+		return &user.ForceLogoutUserResponse{
+			Base: utils.NotFoundResponse("User not found"),
+		}, nil
+	}
+
+	if err = s.revokeUserSessions(ctx, userData.Id); err != nil {
+		return nil, err
+	}
+
+	return &user.ForceLogoutUserResponse{
+		Base: utils.SuccessResponse("User logged out from all sessions"),
+	}, nil
+}
+
+// revokeUserSessions mencabut semua refresh token dan access token milik user.
+func (s *UserAdminService) revokeUserSessions(ctx context.Context, userID string) error {
+	if err := s.refreshTokenRepository.RevokeUserRefreshTokens(ctx, userID); err != nil {
+		return err
+	}
+	now := time.Now()
+	return s.revocationStore.RevokeUser(ctx, userID, now, now.Add(AccessTokenTTL))
+}
+
+func toUserResponse(u *entity.User) *user.User {
+	res := &user.User{
+		Id:            u.Id,
+		FullName:      u.FullName,
+		Email:         u.Email,
+		RoleCode:      u.RoleCode,
+		EmailVerified: u.EmailVerified,
+		CreatedAt:     timestamppb.New(u.CreatedAt),
+		IsDeleted:     u.IsDeleted,
+		DeletedBy:     utils.SafeDerefString(u.DeletedBy),
+	}
+	if u.IsDeleted {
+		res.DeletedAt = timestamppb.New(u.DeletedAt)
+	}
+	return res
+}
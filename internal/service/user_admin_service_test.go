@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/entity"
+	"github.com/daiyanuthsa/grpc-ecom-be/internal/repository"
+	"github.com/daiyanuthsa/grpc-ecom-be/pb/user"
+)
+
+// fakeUserRepository sees the users of a fakeAuthRepository, deleted ones included.
+type fakeUserRepository struct {
+	repository.IUserRepository
+
+	users *fakeAuthRepository
+}
+
+func (r *fakeUserRepository) GetUserById(ctx context.Context, id string) (*entity.User, error) {
+	u, ok := r.users.users[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *u
+	return &copied, nil
+}
+
+func (r *fakeUserRepository) SoftDeleteUser(ctx context.Context, deletedAt time.Time, deletedBy string, id string) error {
+	r.users.users[id].IsDeleted = true
+	return nil
+}
+
+func (r *fakeUserRepository) RestoreUser(ctx context.Context, updatedAt time.Time, updatedBy string, id string) error {
+	r.users.users[id].IsDeleted = false
+	return nil
+}
+
+func newTestUserAdminService(users ...*entity.User) (*UserAdminService, *fakeAuthRepository, *fakeRefreshTokenRepository) {
+	authRepository := newFakeAuthRepository(users...)
+	refreshTokens := &fakeRefreshTokenRepository{}
+	svc := NewUserAdminService(&fakeUserRepository{users: authRepository}, authRepository, refreshTokens, NewMemoryTokenRevocationStore(time.Minute), nil).(*UserAdminService)
+	return svc, authRepository, refreshTokens
+}
+
+func TestDeleteUser(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		wantCode    int64
+		wantDeleted bool
+	}{
+		{name: "other user", id: "u1", wantCode: 200, wantDeleted: true},
+		{name: "own account", id: "admin", wantCode: 400},
+		{name: "unknown user", id: "missing", wantCode: 404},
+		{name: "already deleted", id: "deleted", wantCode: 404},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, users, refreshTokens := newTestUserAdminService(
+				&entity.User{Id: "u1", Email: "user@example.com"},
+				&entity.User{Id: "admin", Email: "admin@example.com"},
+				&entity.User{Id: "deleted", Email: "deleted@example.com", IsDeleted: true},
+			)
+
+			issuedAt := time.Now().Add(-time.Second)
+
+			res, err := svc.DeleteUser(contextWithUser("admin"), &user.DeleteUserRequest{Id: tt.id})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := res.GetBase().GetStatusCode(); got != tt.wantCode {
+				t.Fatalf("got status code %d (%s), want %d", got, res.GetBase().GetMessage(), tt.wantCode)
+			}
+			if !tt.wantDeleted {
+				if len(refreshTokens.revokedUsers) != 0 {
+					t.Errorf("got refresh token revocations %v, want none", refreshTokens.revokedUsers)
+				}
+				return
+			}
+			if !users.users[tt.id].IsDeleted {
+				t.Error("user was not deleted")
+			}
+			if len(refreshTokens.revokedUsers) != 1 || refreshTokens.revokedUsers[0] != tt.id {
+				t.Errorf("got refresh token revocations %v, want %s", refreshTokens.revokedUsers, tt.id)
+			}
+			if revoked, _ := svc.revocationStore.IsRevoked(context.Background(), "jti", tt.id, issuedAt); !revoked {
+				t.Error("access tokens of the deleted user are still valid")
+			}
+		})
+	}
+}
+
+func TestRestoreUser(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		wantCode int64
+	}{
+		{name: "deleted user", id: "deleted", wantCode: 200},
+		{name: "email reused by a new account", id: "replaced", wantCode: 400},
+		{name: "active user", id: "active", wantCode: 404},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, users, _ := newTestUserAdminService(
+				&entity.User{Id: "deleted", Email: "deleted@example.com", IsDeleted: true},
+				&entity.User{Id: "replaced", Email: "active@example.com", IsDeleted: true},
+				&entity.User{Id: "active", Email: "active@example.com"},
+			)
+
+			res, err := svc.RestoreUser(contextWithUser("admin"), &user.RestoreUserRequest{Id: tt.id})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := res.GetBase().GetStatusCode(); got != tt.wantCode {
+				t.Fatalf("got status code %d (%s), want %d", got, res.GetBase().GetMessage(), tt.wantCode)
+			}
+			if wantDeleted := tt.id == "replaced"; users.users[tt.id].IsDeleted != wantDeleted {
+				t.Errorf("got deleted %v, want %v", users.users[tt.id].IsDeleted, wantDeleted)
+			}
+		})
+	}
+}
+
+func TestForceLogoutUser(t *testing.T) {
+	svc, _, refreshTokens := newTestUserAdminService(&entity.User{Id: "u1", Email: "user@example.com"})
+	issuedAt := time.Now().Add(-time.Second)
+
+	res, err := svc.ForceLogoutUser(contextWithUser("admin"), &user.ForceLogoutUserRequest{Id: "u1"})
+	if err != nil || res.GetBase().GetIsError() {
+		t.Fatalf("ForceLogoutUser: %v / %q", err, res.GetBase().GetMessage())
+	}
+	if len(refreshTokens.revokedUsers) != 1 || refreshTokens.revokedUsers[0] != "u1" {
+		t.Errorf("got refresh token revocations %v, want u1", refreshTokens.revokedUsers)
+	}
+	if revoked, _ := svc.revocationStore.IsRevoked(context.Background(), "jti", "u1", issuedAt); !revoked {
+		t.Error("access tokens issued before the forced logout are still valid")
+	}
+
+	res, err = svc.ForceLogoutUser(contextWithUser("admin"), &user.ForceLogoutUserRequest{Id: "missing"})
+	if err != nil || res.GetBase().GetStatusCode() != 404 {
+		t.Errorf("got %v / %q, want 404 for an unknown user", err, res.GetBase().GetMessage())
+	}
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ParseDecimal parses a non-negative decimal string into an integer scaled by 10^decimals without going through
+// floating point, e.g. ParseDecimal("12.5", 2) = 1250. An empty string is zero.
+func ParseDecimal(s string, decimals int) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	whole, fraction, _ := strings.Cut(s, ".")
+	if whole == "" || len(fraction) > decimals {
+		return 0, fmt.Errorf("invalid decimal %q: at most %d decimals allowed", s, decimals)
+	}
+	fraction += strings.Repeat("0", decimals-len(fraction))
+
+	var value int64
+	for _, c := range whole + fraction {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("invalid decimal %q", s)
+		}
+		if value > (math.MaxInt64-int64(c-'0'))/10 {
+			return 0, fmt.Errorf("decimal %q is too large", s)
+		}
+		value = value*10 + int64(c-'0')
+	}
+	return value, nil
+}
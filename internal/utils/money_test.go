@@ -0,0 +1,34 @@
+package utils
+
+import "testing"
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		value    string
+		decimals int
+		want     int64
+		wantErr  bool
+	}{
+		{value: "", decimals: 2, want: 0},
+		{value: "12", decimals: 2, want: 1200},
+		{value: "12.5", decimals: 2, want: 1250},
+		{value: " 12.50 ", decimals: 2, want: 1250},
+		{value: "0.01", decimals: 2, want: 1},
+		{value: "11", decimals: 0, want: 11},
+		{value: "12.", decimals: 2, want: 1200},
+		{value: "12.345", decimals: 2, wantErr: true},
+		{value: "1.5", decimals: 0, wantErr: true},
+		{value: ".5", decimals: 2, wantErr: true},
+		{value: "-1", decimals: 2, wantErr: true},
+		{value: "1e3", decimals: 2, wantErr: true},
+		{value: "1,5", decimals: 2, wantErr: true},
+		{value: "92233720368547758.07", decimals: 2, want: 9223372036854775807},
+		{value: "92233720368547758.08", decimals: 2, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDecimal(tt.value, tt.decimals)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseDecimal(%q, %d) = %d, %v; want %d, error %v", tt.value, tt.decimals, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
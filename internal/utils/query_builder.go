@@ -3,6 +3,7 @@ package utils
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/daiyanuthsa/grpc-ecom-be/pb/common"
 )
@@ -34,6 +35,77 @@ func BuildOrderByClause(sorts []*common.PaginationSortRequest, allowedSortFields
 	return "ORDER BY " + strings.Join(orderClauses, ", "), nil
 }
 
+// WhereBuilder menyusun klausa WHERE secara dinamis dan aman. Setiap kondisi ditulis dengan
+// placeholder "?" dan diberi nama dimensi (mis. "price"), lalu Build menomori ulang placeholder
+// menjadi $1, $2, ... sesuai urutan argumen. Dimensi bisa dikecualikan saat Build, misalnya untuk
+// menghitung facet harga tanpa filter harga itu sendiri.
+type WhereBuilder struct {
+	conditions []whereCondition
+}
+
+type whereCondition struct {
+	dimension string
+	sql       string
+	args      []any
+}
+
+func NewWhereBuilder() *WhereBuilder {
+	return &WhereBuilder{}
+}
+
+// Add menambahkan kondisi untuk sebuah dimensi. Jumlah "?" pada sql harus sama dengan jumlah args.
+func (b *WhereBuilder) Add(dimension string, sql string, args ...any) {
+	b.conditions = append(b.conditions, whereCondition{dimension: dimension, sql: sql, args: args})
+}
+
+// Build menghasilkan klausa "WHERE ..." (atau string kosong) beserta argumennya.
+// Kondisi dengan dimensi yang ada di excludeDimensions dilewati.
+func (b *WhereBuilder) Build(excludeDimensions ...string) (string, []any) {
+	excluded := make(map[string]bool, len(excludeDimensions))
+	for _, d := range excludeDimensions {
+		excluded[d] = true
+	}
+
+	var clauses []string
+	var args []any
+	for _, c := range b.conditions {
+		if excluded[c.dimension] {
+			continue
+		}
+		clause := c.sql
+		for _, arg := range c.args {
+			args = append(args, arg)
+			clause = strings.Replace(clause, "?", fmt.Sprintf("$%d", len(args)), 1)
+		}
+		clauses = append(clauses, "("+clause+")")
+	}
+
+	if len(clauses) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(clauses, " AND "), args
+}
+
+// EscapeLikePattern meng-escape karakter wildcard LIKE (%, _ dan \) dari input user.
+func EscapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// BuildPrefixTsQuery mengubah kata kunci bebas dari user menjadi tsquery yang aman untuk to_tsquery.
+// Setiap kata dijadikan prefix match (kata:*) dan digabung dengan AND, sehingga "kaos hit" cocok dengan "Kaos Hitam".
+// Mengembalikan string kosong jika tidak ada kata yang bisa dicari.
+func BuildPrefixTsQuery(query string) string {
+	terms := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	prefixTerms := make([]string, 0, len(terms))
+	for _, term := range terms {
+		prefixTerms = append(prefixTerms, term+":*")
+	}
+	return strings.Join(prefixTerms, " & ")
+}
+
 func SafeDerefString(s *string) string {
     if s == nil {
         return "" // Jika NULL di DB, kembalikan string kosong
@@ -0,0 +1,66 @@
+package utils
+
+import "testing"
+
+func TestBuildPrefixTsQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "kaos", want: "kaos:*"},
+		{query: "Kaos  Hit", want: "kaos:* & hit:*"},
+		{query: "kaos & !hitam | (putih):*", want: "kaos:* & hitam:* & putih:*"},
+		{query: "size-42", want: "size:* & 42:*"},
+		{query: "O'Neill", want: "o:* & neill:*"},
+		{query: "sepatu café", want: "sepatu:* & café:*"},
+		{query: "  ", want: ""},
+		{query: "&|!:*()'", want: ""},
+	}
+	for _, tt := range tests {
+		if got := BuildPrefixTsQuery(tt.query); got != tt.want {
+			t.Errorf("BuildPrefixTsQuery(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestWhereBuilder(t *testing.T) {
+	where := NewWhereBuilder()
+	where.Add("price", "price >= ?", 10.0)
+	where.Add("price", "price <= ?", 20.0)
+	where.Add("name", "name ILIKE ? OR description ILIKE ?", "%a%", "%b%")
+
+	clause, args := where.Build()
+	wantClause := "WHERE (price >= $1) AND (price <= $2) AND (name ILIKE $3 OR description ILIKE $4)"
+	if clause != wantClause || len(args) != 4 || args[0] != 10.0 || args[3] != "%b%" {
+		t.Errorf("got %q %v, want %q", clause, args, wantClause)
+	}
+
+	// Facet harga dihitung tanpa filter harga itu sendiri, placeholder dinomori ulang
+	clause, args = where.Build("price")
+	if clause != "WHERE (name ILIKE $1 OR description ILIKE $2)" || len(args) != 2 || args[0] != "%a%" {
+		t.Errorf("got %q %v without price", clause, args)
+	}
+
+	if clause, args = where.Build("price", "name"); clause != "" || len(args) != 0 {
+		t.Errorf("got %q %v with every dimension excluded, want no clause", clause, args)
+	}
+	if clause, _ = NewWhereBuilder().Build(); clause != "" {
+		t.Errorf("got %q for an empty builder", clause)
+	}
+}
+
+func TestEscapeLikePattern(t *testing.T) {
+	tests := map[string]string{
+		"kaos":    "kaos",
+		"100%":    `100\%`,
+		"a_b":     `a\_b`,
+		`c:\path`: `c:\\path`,
+		`%_\`:     `\%\_\\`,
+		"":        "",
+	}
+	for input, want := range tests {
+		if got := EscapeLikePattern(input); got != want {
+			t.Errorf("EscapeLikePattern(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
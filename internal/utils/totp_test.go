@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+// rfc6238Secret is the SHA-1 seed of RFC 6238 appendix B, "12345678901234567890", in base32.
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCodeRFC6238Vectors(t *testing.T) {
+	// Nilai 8 digit dari RFC 6238 appendix B; kode 6 digit adalah 6 digit terakhirnya
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+	for _, tt := range tests {
+		got, err := TOTPCode(rfc6238Secret, TOTPStep(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatalf("TOTPCode at %d returned error: %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Errorf("TOTPCode at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestTOTPCodeLowercaseSecret(t *testing.T) {
+	upper, _ := TOTPCode(rfc6238Secret, 1)
+	lower, err := TOTPCode("gezdgnbvgy3tqojqgezdgnbvgy3tqojq", 1)
+	if err != nil || lower != upper {
+		t.Errorf("got %s (%v), want %s", lower, err, upper)
+	}
+	if _, err := TOTPCode("not base32!", 1); err == nil {
+		t.Error("got no error for an invalid secret")
+	}
+}
+
+func TestValidateTOTPSkewWindow(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := TOTPStep(now)
+	codeAt := func(step int64) string {
+		code, err := TOTPCode(rfc6238Secret, step)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return code
+	}
+	tests := []struct {
+		name     string
+		code     string
+		skew     int64
+		wantStep int64
+		wantOK   bool
+	}{
+		{name: "current step", code: codeAt(current), skew: 1, wantStep: current, wantOK: true},
+		{name: "previous step", code: codeAt(current - 1), skew: 1, wantStep: current - 1, wantOK: true},
+		{name: "next step", code: codeAt(current + 1), skew: 1, wantStep: current + 1, wantOK: true},
+		{name: "two steps behind", code: codeAt(current - 2), skew: 1},
+		{name: "two steps ahead", code: codeAt(current + 2), skew: 1},
+		{name: "previous step without skew", code: codeAt(current - 1), skew: 0},
+		{name: "spaces are ignored", code: " 050 471 ", skew: 0, wantStep: current, wantOK: true},
+		{name: "wrong code", code: "123456", skew: 1},
+		{name: "too short", code: "05047", skew: 1},
+		{name: "eight digits", code: "14050471", skew: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := ValidateTOTP(rfc6238Secret, tt.code, now, tt.skew)
+			if ok != tt.wantOK || (ok && step != tt.wantStep) {
+				t.Errorf("got step %d ok %v, want step %d ok %v", step, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTOTPStepBoundaries(t *testing.T) {
+	if TOTPStep(time.Unix(29, 0)) != 0 || TOTPStep(time.Unix(30, 0)) != 1 {
+		t.Error("steps must change every 30 seconds")
+	}
+}
@@ -0,0 +1,26 @@
+-- Order subsystem: header order + snapshot item per produk.
+CREATE TABLE IF NOT EXISTS "order" (
+    id             VARCHAR(255) PRIMARY KEY,
+    user_id        VARCHAR(255) NOT NULL,
+    status         VARCHAR(50)  NOT NULL,
+    total_price    NUMERIC(15, 2) NOT NULL,
+    total_quantity INTEGER NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by     VARCHAR(255) NOT NULL,
+    updated_at     TIMESTAMPTZ,
+    updated_by     VARCHAR(255)
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_user_id_created_at ON "order" (user_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS order_item (
+    id           VARCHAR(255) PRIMARY KEY,
+    order_id     VARCHAR(255) NOT NULL REFERENCES "order" (id) ON DELETE CASCADE,
+    product_id   VARCHAR(255) NOT NULL,
+    product_name VARCHAR(255) NOT NULL,
+    price        NUMERIC(15, 2) NOT NULL,
+    quantity     INTEGER NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_item_order_id ON order_item (order_id);
@@ -0,0 +1,19 @@
+-- Riwayat perpindahan status order (timeline).
+CREATE TABLE IF NOT EXISTS order_status_history (
+    id                 VARCHAR(255) PRIMARY KEY,
+    order_id           VARCHAR(255) NOT NULL REFERENCES "order" (id) ON DELETE CASCADE,
+    from_status        VARCHAR(50),
+    to_status          VARCHAR(50) NOT NULL,
+    reason             VARCHAR(255) NOT NULL DEFAULT '',
+    changed_by_user_id VARCHAR(255) NOT NULL,
+    changed_by         VARCHAR(255) NOT NULL,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_status_history_order_id ON order_status_history (order_id, created_at);
+
+-- Order yang sudah ada sebelum tabel ini dibuat mendapat entri awal.
+INSERT INTO order_status_history (id, order_id, from_status, to_status, reason, changed_by_user_id, changed_by, created_at)
+SELECT gen_random_uuid()::text, o.id, NULL, o.status, 'Order created', o.user_id, o.created_by, o.created_at
+FROM "order" o
+WHERE NOT EXISTS (SELECT 1 FROM order_status_history h WHERE h.order_id = o.id);
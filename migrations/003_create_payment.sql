@@ -0,0 +1,18 @@
+-- Pembayaran order melalui payment provider (fake, dst).
+CREATE TABLE IF NOT EXISTS payment (
+    id                 VARCHAR(255) PRIMARY KEY,
+    order_id           VARCHAR(255) NOT NULL REFERENCES "order" (id) ON DELETE CASCADE,
+    provider           VARCHAR(50)  NOT NULL,
+    provider_intent_id VARCHAR(255) NOT NULL,
+    provider_refund_id VARCHAR(255),
+    amount             NUMERIC(15, 2) NOT NULL,
+    currency           VARCHAR(3)  NOT NULL,
+    status             VARCHAR(50) NOT NULL,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by         VARCHAR(255) NOT NULL,
+    updated_at         TIMESTAMPTZ,
+    updated_by         VARCHAR(255),
+    UNIQUE (provider, provider_intent_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_order_id ON payment (order_id, created_at DESC);
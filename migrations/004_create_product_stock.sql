@@ -0,0 +1,27 @@
+-- Stok produk. Produk tanpa baris di tabel ini dianggap stoknya 0.
+CREATE TABLE IF NOT EXISTS product_stock (
+    product_id VARCHAR(255) PRIMARY KEY,
+    quantity   INTEGER NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by VARCHAR(255) NOT NULL
+);
+
+-- Stok awal produk yang sudah ada diambil dari setting app.initial_product_stock, agar katalog tidak langsung
+-- habis setelah deploy. Set di sesi yang sama sebelum migration dijalankan, mis.:
+--   SET app.initial_product_stock = '100';
+-- Jika ada produk tanpa stok dan setting ini tidak di-set, migration gagal alih-alih mengisi stok 0.
+DO $$
+DECLARE
+    initial_stock INTEGER := NULLIF(current_setting('app.initial_product_stock', true), '')::INTEGER;
+BEGIN
+    IF initial_stock IS NULL THEN
+        IF EXISTS (SELECT 1 FROM "product" p WHERE NOT EXISTS (SELECT 1 FROM product_stock s WHERE s.product_id = p.id)) THEN
+            RAISE EXCEPTION 'app.initial_product_stock must be set to seed the stock of existing products';
+        END IF;
+        RETURN;
+    END IF;
+
+    INSERT INTO product_stock (product_id, quantity, updated_by)
+    SELECT id, initial_stock, 'migration' FROM "product"
+    ON CONFLICT (product_id) DO NOTHING;
+END $$;
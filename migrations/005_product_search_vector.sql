@@ -0,0 +1,10 @@
+-- Full-text search produk: nama (bobot A) lebih penting dari deskripsi (bobot B).
+-- Konfigurasi 'simple' dipakai karena katalog bercampur bahasa Indonesia dan Inggris.
+ALTER TABLE "product"
+    ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_product_search_vector ON "product" USING GIN (search_vector);
@@ -0,0 +1,3 @@
+-- Index untuk filter dan facet ListProducts
+CREATE INDEX IF NOT EXISTS idx_product_price ON public.product (price) WHERE is_deleted = FALSE;
+CREATE INDEX IF NOT EXISTS idx_product_created_at ON public.product (created_at) WHERE is_deleted = FALSE;
@@ -0,0 +1,24 @@
+-- Kategori produk bertingkat (parent/child) dan relasi many-to-many produk <-> kategori.
+CREATE TABLE IF NOT EXISTS category (
+    id          VARCHAR(255) PRIMARY KEY,
+    parent_id   VARCHAR(255) REFERENCES category (id),
+    name        VARCHAR(255) NOT NULL,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by  VARCHAR(255) NOT NULL,
+    updated_at  TIMESTAMPTZ,
+    updated_by  VARCHAR(255),
+    deleted_at  TIMESTAMPTZ,
+    deleted_by  VARCHAR(255),
+    is_deleted  BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS idx_category_parent_id ON category (parent_id) WHERE is_deleted = FALSE;
+
+CREATE TABLE IF NOT EXISTS product_category (
+    product_id  VARCHAR(255) NOT NULL,
+    category_id VARCHAR(255) NOT NULL REFERENCES category (id),
+    PRIMARY KEY (product_id, category_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_category_category_id ON product_category (category_id);
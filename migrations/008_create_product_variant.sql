@@ -0,0 +1,44 @@
+-- Varian produk (mis. ukuran/warna). Setiap varian punya SKU, harga override opsional, stok dan gambar sendiri.
+CREATE TABLE IF NOT EXISTS product_option (
+    id            VARCHAR(255) PRIMARY KEY,
+    product_id    VARCHAR(255) NOT NULL,
+    name          VARCHAR(100) NOT NULL,
+    position      INTEGER NOT NULL DEFAULT 0,
+    option_values TEXT[] NOT NULL DEFAULT '{}',
+    UNIQUE (product_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS product_variant (
+    id              VARCHAR(255) PRIMARY KEY,
+    product_id      VARCHAR(255) NOT NULL,
+    sku             VARCHAR(100) NOT NULL,
+    name            VARCHAR(255) NOT NULL,
+    price           NUMERIC(15, 2),
+    image_file_name VARCHAR(255),
+    stock           INTEGER NOT NULL DEFAULT 0 CHECK (stock >= 0),
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by      VARCHAR(255) NOT NULL,
+    updated_at      TIMESTAMPTZ,
+    updated_by      VARCHAR(255),
+    deleted_at      TIMESTAMPTZ,
+    deleted_by      VARCHAR(255),
+    is_deleted      BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_variant_sku ON product_variant (sku) WHERE is_deleted = FALSE;
+CREATE INDEX IF NOT EXISTS idx_product_variant_product_id ON product_variant (product_id) WHERE is_deleted = FALSE;
+
+CREATE TABLE IF NOT EXISTS product_variant_option (
+    variant_id VARCHAR(255) NOT NULL REFERENCES product_variant (id) ON DELETE CASCADE,
+    option_id  VARCHAR(255) NOT NULL REFERENCES product_option (id) ON DELETE CASCADE,
+    value      VARCHAR(100) NOT NULL,
+    PRIMARY KEY (variant_id, option_id)
+);
+
+-- Cart dan order item kini merujuk varian; NULL berarti produk tanpa varian.
+ALTER TABLE public.user_cart ADD COLUMN IF NOT EXISTS variant_id VARCHAR(255);
+
+ALTER TABLE order_item
+    ADD COLUMN IF NOT EXISTS variant_id   VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS sku          VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS variant_name VARCHAR(255);
@@ -0,0 +1,19 @@
+-- Galeri gambar produk. Gambar primary disalin ke product.image_file_name agar list produk tetap satu query.
+CREATE TABLE IF NOT EXISTS product_image (
+    id              VARCHAR(255) PRIMARY KEY,
+    product_id      VARCHAR(255) NOT NULL,
+    image_file_name VARCHAR(255) NOT NULL,
+    sort_order      INTEGER NOT NULL DEFAULT 0,
+    is_primary      BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by      VARCHAR(255) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_image_product_id ON product_image (product_id, sort_order);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_image_primary ON product_image (product_id) WHERE is_primary = TRUE;
+
+INSERT INTO product_image (id, product_id, image_file_name, sort_order, is_primary, created_by)
+SELECT gen_random_uuid()::text, p.id, p.image_file_name, 0, TRUE, 'migration'
+FROM "product" p
+WHERE p.image_file_name <> ''
+  AND NOT EXISTS (SELECT 1 FROM product_image pi WHERE pi.product_id = p.id);
@@ -0,0 +1,14 @@
+-- Refresh token disimpan dalam bentuk hash SHA-256. family_id mengelompokkan token hasil rotasi dari satu login.
+CREATE TABLE IF NOT EXISTS refresh_token (
+    id             VARCHAR(255) PRIMARY KEY,
+    user_id        VARCHAR(255) NOT NULL,
+    family_id      VARCHAR(255) NOT NULL,
+    token_hash     VARCHAR(64)  NOT NULL UNIQUE,
+    expires_at     TIMESTAMPTZ NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    revoked_at     TIMESTAMPTZ,
+    replaced_by_id VARCHAR(255)
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_token_family_id ON refresh_token (family_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_token_user_id ON refresh_token (user_id);
@@ -0,0 +1,8 @@
+-- Access token yang sudah di-logout, dicatat per jti sampai token tersebut kedaluwarsa.
+CREATE TABLE IF NOT EXISTS revoked_token (
+    jti        VARCHAR(255) PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_revoked_token_expires_at ON revoked_token (expires_at);
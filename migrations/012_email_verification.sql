@@ -0,0 +1,18 @@
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS email_verified BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMPTZ;
+
+-- User yang sudah ada sebelum fitur ini dianggap terverifikasi agar tidak terkunci
+UPDATE "user" SET email_verified = TRUE, email_verified_at = NOW() WHERE email_verified = FALSE;
+
+-- Token sekali pakai yang dikirim lewat email. purpose membedakan kegunaannya (mis. verifikasi email).
+CREATE TABLE IF NOT EXISTS user_token (
+    id         VARCHAR(255) PRIMARY KEY,
+    user_id    VARCHAR(255) NOT NULL,
+    purpose    VARCHAR(50)  NOT NULL,
+    token_hash VARCHAR(64)  NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    used_at    TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_token_user_id_purpose ON user_token (user_id, purpose);
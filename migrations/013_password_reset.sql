@@ -0,0 +1,9 @@
+-- Semua access token milik user yang diterbitkan sebelum revoked_before dianggap tidak berlaku
+-- (mis. setelah reset password). Baris boleh dihapus setelah expires_at.
+CREATE TABLE IF NOT EXISTS revoked_user_token (
+    user_id        VARCHAR(255) PRIMARY KEY,
+    revoked_before TIMESTAMPTZ NOT NULL,
+    expires_at     TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_revoked_user_token_expires_at ON revoked_user_token (expires_at);
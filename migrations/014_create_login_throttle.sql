@@ -0,0 +1,7 @@
+-- Percobaan login gagal per akun ("account:<email>") dan per IP ("ip:<address>").
+CREATE TABLE IF NOT EXISTS login_throttle (
+    throttle_key   VARCHAR(255) PRIMARY KEY,
+    failed_count   INT         NOT NULL DEFAULT 0,
+    last_failed_at TIMESTAMPTZ NOT NULL,
+    locked_until   TIMESTAMPTZ
+);
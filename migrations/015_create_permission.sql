@@ -0,0 +1,46 @@
+-- Role sudah dipakai lewat "user".role_code; tabel dibuat di sini bila belum ada di database lama
+CREATE TABLE IF NOT EXISTS user_role (
+    id         VARCHAR(255) PRIMARY KEY,
+    name       VARCHAR(100) NOT NULL,
+    code       VARCHAR(50)  NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    created_by VARCHAR(255),
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(255),
+    deleted_at TIMESTAMPTZ,
+    deleted_by VARCHAR(255),
+    is_deleted BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+INSERT INTO user_role (id, name, code, created_by)
+SELECT gen_random_uuid()::text, r.name, r.code, 'system'
+FROM (VALUES ('Admin', 'admin'), ('Customer', 'customer')) AS r(name, code)
+WHERE NOT EXISTS (SELECT 1 FROM user_role ur WHERE ur.code = r.code);
+
+CREATE TABLE IF NOT EXISTS permission (
+    code        VARCHAR(100) PRIMARY KEY,
+    description VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS role_permission (
+    role_code       VARCHAR(50)  NOT NULL,
+    permission_code VARCHAR(100) NOT NULL REFERENCES permission (code) ON DELETE CASCADE,
+    PRIMARY KEY (role_code, permission_code)
+);
+
+INSERT INTO permission (code, description) VALUES
+    ('product:read', 'View the admin product listing, including deleted products'),
+    ('product:write', 'Create, update and delete products, variants and images'),
+    ('category:write', 'Create, update and delete categories'),
+    ('inventory:write', 'Set and adjust stock'),
+    ('order:read', 'View orders of any user'),
+    ('order:write', 'Change order status'),
+    ('payment:write', 'Capture and refund payments'),
+    ('user:manage', 'Unlock user accounts'),
+    ('role:manage', 'Manage roles and assign them to users')
+ON CONFLICT (code) DO NOTHING;
+
+-- Admin mendapat semua permission yang ada saat ini
+INSERT INTO role_permission (role_code, permission_code)
+SELECT 'admin', code FROM permission
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,10 @@
+INSERT INTO permission (code, description) VALUES
+    ('user:read', 'View users')
+ON CONFLICT (code) DO NOTHING;
+
+UPDATE permission SET description = 'Manage users: unlock, change role, delete, restore and force logout' WHERE code = 'user:manage';
+
+INSERT INTO role_permission (role_code, permission_code) VALUES ('admin', 'user:read')
+ON CONFLICT DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS idx_user_role_code ON "user" (role_code);
@@ -0,0 +1,26 @@
+-- Akun dari provider OpenID Connect (Google, dll.) yang ditautkan ke user. Satu user bisa punya beberapa provider.
+CREATE TABLE IF NOT EXISTS user_identity (
+    id            VARCHAR(255) PRIMARY KEY,
+    user_id       VARCHAR(255) NOT NULL,
+    provider      VARCHAR(50)  NOT NULL,
+    subject       VARCHAR(255) NOT NULL,
+    email         VARCHAR(255),
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_login_at TIMESTAMPTZ,
+    UNIQUE (provider, subject),
+    UNIQUE (user_id, provider)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_identity_user_id ON user_identity (user_id);
+
+-- state, nonce dan PKCE verifier dari login OIDC yang sedang berjalan; dihapus saat callback dipakai.
+CREATE TABLE IF NOT EXISTS oidc_login_state (
+    state_hash    VARCHAR(64)  PRIMARY KEY,
+    provider      VARCHAR(50)  NOT NULL,
+    nonce         VARCHAR(255) NOT NULL,
+    code_verifier VARCHAR(255) NOT NULL,
+    expires_at    TIMESTAMPTZ NOT NULL,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_oidc_login_state_expires_at ON oidc_login_state (expires_at);
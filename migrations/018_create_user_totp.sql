@@ -0,0 +1,22 @@
+-- Secret TOTP per user (terenkripsi AES-GCM). confirmed_at NULL berarti pendaftaran belum dikonfirmasi.
+-- last_used_step mencegah kode yang sama dipakai dua kali.
+CREATE TABLE IF NOT EXISTS user_totp (
+    user_id          VARCHAR(255) PRIMARY KEY,
+    secret_encrypted TEXT        NOT NULL,
+    confirmed_at     TIMESTAMPTZ,
+    last_used_step   BIGINT      NOT NULL DEFAULT 0,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Kode pemulihan sekali pakai jika perangkat authenticator hilang. Hanya hash-nya yang disimpan.
+CREATE TABLE IF NOT EXISTS user_recovery_code (
+    id         VARCHAR(255) PRIMARY KEY,
+    user_id    VARCHAR(255) NOT NULL,
+    code_hash  VARCHAR(64)  NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    used_at    TIMESTAMPTZ,
+    UNIQUE (user_id, code_hash)
+);
+
+-- Refresh token dari login dengan 2FA tetap menerbitkan access token dengan amr "mfa" saat dirotasi
+ALTER TABLE refresh_token ADD COLUMN IF NOT EXISTS mfa BOOLEAN NOT NULL DEFAULT FALSE;
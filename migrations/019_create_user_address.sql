@@ -0,0 +1,41 @@
+-- Buku alamat user. Alamat yang dihapus hanya ditandai is_deleted; order menyimpan salinannya sendiri di order_address.
+CREATE TABLE IF NOT EXISTS user_address (
+    id                  VARCHAR(255) PRIMARY KEY,
+    user_id             VARCHAR(255) NOT NULL,
+    label               VARCHAR(50)  NOT NULL DEFAULT '',
+    recipient_name      VARCHAR(100) NOT NULL,
+    phone               VARCHAR(20)  NOT NULL,
+    line1               VARCHAR(255) NOT NULL,
+    line2               VARCHAR(255) NOT NULL DEFAULT '',
+    city                VARCHAR(100) NOT NULL,
+    region              VARCHAR(100) NOT NULL DEFAULT '',
+    postal_code         VARCHAR(12)  NOT NULL,
+    country_code        CHAR(2)      NOT NULL,
+    is_default_shipping BOOLEAN NOT NULL DEFAULT FALSE,
+    is_default_billing  BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMPTZ,
+    deleted_at          TIMESTAMPTZ,
+    is_deleted          BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_address_user_id ON user_address (user_id) WHERE is_deleted = FALSE;
+
+-- Paling banyak satu alamat default pengiriman dan satu alamat default penagihan per user
+CREATE UNIQUE INDEX IF NOT EXISTS uq_user_address_default_shipping ON user_address (user_id) WHERE is_default_shipping AND is_deleted = FALSE;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_user_address_default_billing ON user_address (user_id) WHERE is_default_billing AND is_deleted = FALSE;
+
+-- Snapshot alamat saat checkout, supaya perubahan buku alamat tidak mengubah riwayat order.
+CREATE TABLE IF NOT EXISTS order_address (
+    order_id       VARCHAR(255) NOT NULL REFERENCES "order" (id) ON DELETE CASCADE,
+    address_type   VARCHAR(20)  NOT NULL,
+    recipient_name VARCHAR(100) NOT NULL,
+    phone          VARCHAR(20)  NOT NULL,
+    line1          VARCHAR(255) NOT NULL,
+    line2          VARCHAR(255) NOT NULL DEFAULT '',
+    city           VARCHAR(100) NOT NULL,
+    region         VARCHAR(100) NOT NULL DEFAULT '',
+    postal_code    VARCHAR(12)  NOT NULL,
+    country_code   CHAR(2)      NOT NULL,
+    PRIMARY KEY (order_id, address_type)
+);
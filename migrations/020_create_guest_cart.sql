@@ -0,0 +1,25 @@
+-- Cart untuk pengunjung yang belum login, diakses dengan token opaque (hanya hash-nya yang disimpan).
+-- Isinya digabung ke user_cart saat login, lalu guest cart dihapus.
+CREATE TABLE IF NOT EXISTS guest_cart (
+    id         VARCHAR(255) PRIMARY KEY,
+    token_hash VARCHAR(64)  NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ  NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_guest_cart_expires_at ON guest_cart (expires_at);
+
+-- Kolom sama dengan user_cart supaya query cart bisa dipakai untuk keduanya
+CREATE TABLE IF NOT EXISTS guest_cart_item (
+    id            UUID PRIMARY KEY,
+    guest_cart_id VARCHAR(255) NOT NULL REFERENCES guest_cart (id) ON DELETE CASCADE,
+    product_id    VARCHAR(255) NOT NULL,
+    variant_id    VARCHAR(255),
+    quantity      INTEGER NOT NULL,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by    VARCHAR(255) NOT NULL,
+    updated_at    TIMESTAMPTZ,
+    updated_by    VARCHAR(255)
+);
+
+CREATE INDEX IF NOT EXISTS idx_guest_cart_item_guest_cart_id ON guest_cart_item (guest_cart_id);
@@ -0,0 +1,17 @@
+-- Rincian harga dari pricing engine disimpan di order supaya yang ditampilkan sama dengan yang ditagih.
+-- total_price tetap berisi grand total.
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS subtotal       NUMERIC(15, 2);
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS discount_total NUMERIC(15, 2) NOT NULL DEFAULT 0;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS tax_total      NUMERIC(15, 2) NOT NULL DEFAULT 0;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS shipping_total NUMERIC(15, 2) NOT NULL DEFAULT 0;
+
+-- Order lama belum punya diskon, pajak maupun ongkir, jadi subtotal = total_price
+UPDATE "order" SET subtotal = total_price WHERE subtotal IS NULL;
+ALTER TABLE "order" ALTER COLUMN subtotal SET NOT NULL;
+
+ALTER TABLE order_item ADD COLUMN IF NOT EXISTS discount NUMERIC(15, 2) NOT NULL DEFAULT 0;
+ALTER TABLE order_item ADD COLUMN IF NOT EXISTS tax      NUMERIC(15, 2) NOT NULL DEFAULT 0;
+ALTER TABLE order_item ADD COLUMN IF NOT EXISTS total    NUMERIC(15, 2);
+
+UPDATE order_item SET total = price * quantity WHERE total IS NULL;
+ALTER TABLE order_item ALTER COLUMN total SET NOT NULL;
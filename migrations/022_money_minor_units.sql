@@ -0,0 +1,180 @@
+-- Harga disimpan sebagai bilangan bulat dalam minor unit (mis. sen) beserta mata uang ISO 4217, bukan NUMERIC/float.
+-- Kolom NUMERIC lama tetap ada dan disinkronkan trigger selama rollout, sehingga binary lama yang hanya menulis
+-- kolom lama tetap jalan. Filter, sort dan facet harga produk masih membaca kolom lama (nilainya selalu sama).
+-- Data lama seluruhnya IDR yang punya 2 desimal, jadi minor unit = nilai * 100.
+
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS price_minor BIGINT;
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
+UPDATE "product" SET price_minor = ROUND(price * 100) WHERE price_minor IS NULL;
+ALTER TABLE "product" ALTER COLUMN price_minor SET NOT NULL;
+
+ALTER TABLE product_variant ADD COLUMN IF NOT EXISTS price_minor BIGINT;
+ALTER TABLE product_variant ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
+UPDATE product_variant SET price_minor = ROUND(price * 100) WHERE price_minor IS NULL AND price IS NOT NULL;
+
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS total_price_minor BIGINT;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS subtotal_minor BIGINT;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS discount_total_minor BIGINT;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS tax_total_minor BIGINT;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS shipping_total_minor BIGINT;
+UPDATE "order" SET
+    total_price_minor    = ROUND(total_price * 100),
+    subtotal_minor       = ROUND(subtotal * 100),
+    discount_total_minor = ROUND(discount_total * 100),
+    tax_total_minor      = ROUND(tax_total * 100),
+    shipping_total_minor = ROUND(shipping_total * 100)
+WHERE total_price_minor IS NULL;
+ALTER TABLE "order" ALTER COLUMN total_price_minor SET NOT NULL;
+ALTER TABLE "order" ALTER COLUMN subtotal_minor SET NOT NULL;
+ALTER TABLE "order" ALTER COLUMN discount_total_minor SET NOT NULL;
+ALTER TABLE "order" ALTER COLUMN tax_total_minor SET NOT NULL;
+ALTER TABLE "order" ALTER COLUMN shipping_total_minor SET NOT NULL;
+
+-- Mata uang item mengikuti order-nya
+ALTER TABLE order_item ADD COLUMN IF NOT EXISTS price_minor BIGINT;
+ALTER TABLE order_item ADD COLUMN IF NOT EXISTS discount_minor BIGINT;
+ALTER TABLE order_item ADD COLUMN IF NOT EXISTS tax_minor BIGINT;
+ALTER TABLE order_item ADD COLUMN IF NOT EXISTS total_minor BIGINT;
+UPDATE order_item SET
+    price_minor    = ROUND(price * 100),
+    discount_minor = ROUND(discount * 100),
+    tax_minor      = ROUND(tax * 100),
+    total_minor    = ROUND(total * 100)
+WHERE price_minor IS NULL;
+ALTER TABLE order_item ALTER COLUMN price_minor SET NOT NULL;
+ALTER TABLE order_item ALTER COLUMN discount_minor SET NOT NULL;
+ALTER TABLE order_item ALTER COLUMN tax_minor SET NOT NULL;
+ALTER TABLE order_item ALTER COLUMN total_minor SET NOT NULL;
+
+ALTER TABLE payment ADD COLUMN IF NOT EXISTS amount_minor BIGINT;
+UPDATE payment SET amount_minor = ROUND(amount * 100) WHERE amount_minor IS NULL;
+ALTER TABLE payment ALTER COLUMN amount_minor SET NOT NULL;
+
+-- Sinkronisasi selama rollout: kolom minor unit ditentukan dulu (dari kolom lama jika hanya kolom lama yang ditulis,
+-- yaitu oleh binary lama), lalu kolom lama selalu diisi ulang dari kolom minor unit.
+
+CREATE OR REPLACE FUNCTION sync_product_money() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' THEN
+        IF NEW.price_minor IS NOT DISTINCT FROM OLD.price_minor AND NEW.price IS DISTINCT FROM OLD.price THEN
+            NEW.price_minor := ROUND(NEW.price * 100);
+        END IF;
+    ELSE
+        NEW.price_minor := COALESCE(NEW.price_minor, ROUND(NEW.price * 100));
+    END IF;
+    NEW.price := NEW.price_minor / 100.0;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_product_money ON "product";
+CREATE TRIGGER trg_product_money BEFORE INSERT OR UPDATE ON "product"
+    FOR EACH ROW EXECUTE FUNCTION sync_product_money();
+
+CREATE OR REPLACE FUNCTION sync_product_variant_money() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' THEN
+        IF NEW.price_minor IS NOT DISTINCT FROM OLD.price_minor AND NEW.price IS DISTINCT FROM OLD.price THEN
+            NEW.price_minor := ROUND(NEW.price * 100);
+        END IF;
+    ELSE
+        NEW.price_minor := COALESCE(NEW.price_minor, ROUND(NEW.price * 100));
+    END IF;
+    NEW.price := NEW.price_minor / 100.0;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_product_variant_money ON product_variant;
+CREATE TRIGGER trg_product_variant_money BEFORE INSERT OR UPDATE ON product_variant
+    FOR EACH ROW EXECUTE FUNCTION sync_product_variant_money();
+
+CREATE OR REPLACE FUNCTION sync_order_money() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' THEN
+        IF NEW.total_price_minor IS NOT DISTINCT FROM OLD.total_price_minor AND NEW.total_price IS DISTINCT FROM OLD.total_price THEN
+            NEW.total_price_minor := ROUND(NEW.total_price * 100);
+        END IF;
+        IF NEW.subtotal_minor IS NOT DISTINCT FROM OLD.subtotal_minor AND NEW.subtotal IS DISTINCT FROM OLD.subtotal THEN
+            NEW.subtotal_minor := ROUND(NEW.subtotal * 100);
+        END IF;
+        IF NEW.discount_total_minor IS NOT DISTINCT FROM OLD.discount_total_minor AND NEW.discount_total IS DISTINCT FROM OLD.discount_total THEN
+            NEW.discount_total_minor := ROUND(NEW.discount_total * 100);
+        END IF;
+        IF NEW.tax_total_minor IS NOT DISTINCT FROM OLD.tax_total_minor AND NEW.tax_total IS DISTINCT FROM OLD.tax_total THEN
+            NEW.tax_total_minor := ROUND(NEW.tax_total * 100);
+        END IF;
+        IF NEW.shipping_total_minor IS NOT DISTINCT FROM OLD.shipping_total_minor AND NEW.shipping_total IS DISTINCT FROM OLD.shipping_total THEN
+            NEW.shipping_total_minor := ROUND(NEW.shipping_total * 100);
+        END IF;
+    ELSE
+        NEW.total_price_minor := COALESCE(NEW.total_price_minor, ROUND(NEW.total_price * 100));
+        NEW.subtotal_minor := COALESCE(NEW.subtotal_minor, ROUND(NEW.subtotal * 100));
+        NEW.discount_total_minor := COALESCE(NEW.discount_total_minor, ROUND(NEW.discount_total * 100));
+        NEW.tax_total_minor := COALESCE(NEW.tax_total_minor, ROUND(NEW.tax_total * 100));
+        NEW.shipping_total_minor := COALESCE(NEW.shipping_total_minor, ROUND(NEW.shipping_total * 100));
+    END IF;
+    NEW.total_price := NEW.total_price_minor / 100.0;
+    NEW.subtotal := NEW.subtotal_minor / 100.0;
+    NEW.discount_total := NEW.discount_total_minor / 100.0;
+    NEW.tax_total := NEW.tax_total_minor / 100.0;
+    NEW.shipping_total := NEW.shipping_total_minor / 100.0;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_order_money ON "order";
+CREATE TRIGGER trg_order_money BEFORE INSERT OR UPDATE ON "order"
+    FOR EACH ROW EXECUTE FUNCTION sync_order_money();
+
+CREATE OR REPLACE FUNCTION sync_order_item_money() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' THEN
+        IF NEW.price_minor IS NOT DISTINCT FROM OLD.price_minor AND NEW.price IS DISTINCT FROM OLD.price THEN
+            NEW.price_minor := ROUND(NEW.price * 100);
+        END IF;
+        IF NEW.discount_minor IS NOT DISTINCT FROM OLD.discount_minor AND NEW.discount IS DISTINCT FROM OLD.discount THEN
+            NEW.discount_minor := ROUND(NEW.discount * 100);
+        END IF;
+        IF NEW.tax_minor IS NOT DISTINCT FROM OLD.tax_minor AND NEW.tax IS DISTINCT FROM OLD.tax THEN
+            NEW.tax_minor := ROUND(NEW.tax * 100);
+        END IF;
+        IF NEW.total_minor IS NOT DISTINCT FROM OLD.total_minor AND NEW.total IS DISTINCT FROM OLD.total THEN
+            NEW.total_minor := ROUND(NEW.total * 100);
+        END IF;
+    ELSE
+        NEW.price_minor := COALESCE(NEW.price_minor, ROUND(NEW.price * 100));
+        NEW.discount_minor := COALESCE(NEW.discount_minor, ROUND(NEW.discount * 100));
+        NEW.tax_minor := COALESCE(NEW.tax_minor, ROUND(NEW.tax * 100));
+        NEW.total_minor := COALESCE(NEW.total_minor, ROUND(NEW.total * 100));
+    END IF;
+    NEW.price := NEW.price_minor / 100.0;
+    NEW.discount := NEW.discount_minor / 100.0;
+    NEW.tax := NEW.tax_minor / 100.0;
+    NEW.total := NEW.total_minor / 100.0;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_order_item_money ON order_item;
+CREATE TRIGGER trg_order_item_money BEFORE INSERT OR UPDATE ON order_item
+    FOR EACH ROW EXECUTE FUNCTION sync_order_item_money();
+
+CREATE OR REPLACE FUNCTION sync_payment_money() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' THEN
+        IF NEW.amount_minor IS NOT DISTINCT FROM OLD.amount_minor AND NEW.amount IS DISTINCT FROM OLD.amount THEN
+            NEW.amount_minor := ROUND(NEW.amount * 100);
+        END IF;
+    ELSE
+        NEW.amount_minor := COALESCE(NEW.amount_minor, ROUND(NEW.amount * 100));
+    END IF;
+    NEW.amount := NEW.amount_minor / 100.0;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_payment_money ON payment;
+CREATE TRIGGER trg_payment_money BEFORE INSERT OR UPDATE ON payment
+    FOR EACH ROW EXECUTE FUNCTION sync_payment_money();
@@ -0,0 +1,67 @@
+-- Kode kupon/promosi. discount_value adalah basis point untuk PERCENTAGE (1000 = 10%) atau minor unit untuk FIXED_AMOUNT.
+-- redemption_count dinaikkan di transaksi checkout dengan baris kupon dikunci, sehingga usage_limit tidak bisa terlampaui.
+CREATE TABLE IF NOT EXISTS coupon (
+    id                   VARCHAR(255) PRIMARY KEY,
+    code                 VARCHAR(64)  NOT NULL,
+    description          VARCHAR(255) NOT NULL DEFAULT '',
+    discount_type        VARCHAR(20)  NOT NULL,
+    discount_value       BIGINT       NOT NULL,
+    currency             VARCHAR(3)   NOT NULL,
+    min_cart_value_minor BIGINT       NOT NULL DEFAULT 0,
+    valid_from           TIMESTAMPTZ,
+    valid_to             TIMESTAMPTZ,
+    usage_limit          INT,
+    per_user_limit       INT,
+    redemption_count     INT          NOT NULL DEFAULT 0,
+    is_active            BOOLEAN      NOT NULL DEFAULT TRUE,
+    created_at           TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    created_by           VARCHAR(255) NOT NULL,
+    updated_at           TIMESTAMPTZ,
+    updated_by           VARCHAR(255),
+    CONSTRAINT chk_coupon_discount_type CHECK (discount_type IN ('PERCENTAGE', 'FIXED_AMOUNT')),
+    CONSTRAINT chk_coupon_redemption_count CHECK (usage_limit IS NULL OR redemption_count <= usage_limit)
+);
+
+-- Kode disimpan dalam huruf besar
+CREATE UNIQUE INDEX IF NOT EXISTS idx_coupon_code ON coupon (code);
+
+-- Cakupan kupon; kupon tanpa produk dan kategori berlaku untuk semua produk
+CREATE TABLE IF NOT EXISTS coupon_product (
+    coupon_id  VARCHAR(255) NOT NULL REFERENCES coupon (id) ON DELETE CASCADE,
+    product_id VARCHAR(255) NOT NULL,
+    PRIMARY KEY (coupon_id, product_id)
+);
+
+CREATE TABLE IF NOT EXISTS coupon_category (
+    coupon_id   VARCHAR(255) NOT NULL REFERENCES coupon (id) ON DELETE CASCADE,
+    category_id VARCHAR(255) NOT NULL REFERENCES category (id),
+    PRIMARY KEY (coupon_id, category_id)
+);
+
+-- Kupon yang dipasang di cart user (satu kupon per cart)
+CREATE TABLE IF NOT EXISTS user_cart_coupon (
+    user_id    VARCHAR(255) PRIMARY KEY,
+    coupon_id  VARCHAR(255) NOT NULL REFERENCES coupon (id) ON DELETE CASCADE,
+    applied_at TIMESTAMPTZ  NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS coupon_redemption (
+    id             VARCHAR(255) PRIMARY KEY,
+    coupon_id      VARCHAR(255) NOT NULL REFERENCES coupon (id),
+    user_id        VARCHAR(255) NOT NULL,
+    order_id       VARCHAR(255) NOT NULL UNIQUE REFERENCES "order" (id),
+    discount_minor BIGINT       NOT NULL,
+    created_at     TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_coupon_redemption_coupon_user ON coupon_redemption (coupon_id, user_id);
+
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS coupon_id VARCHAR(255) REFERENCES coupon (id);
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS coupon_code VARCHAR(64);
+
+INSERT INTO permission (code, description) VALUES
+    ('coupon:manage', 'Create, list and deactivate coupon codes')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permission (role_code, permission_code) VALUES ('admin', 'coupon:manage')
+ON CONFLICT DO NOTHING;
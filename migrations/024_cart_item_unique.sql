@@ -0,0 +1,42 @@
+-- Satu baris per produk dan varian di setiap cart, supaya penggabungan guest cart bisa memakai ON CONFLICT.
+-- variant_id NULL (produk tanpa varian) dianggap sama lewat COALESCE.
+-- Baris ganda yang sudah ada dijumlahkan ke baris tertua sebelum index dibuat.
+UPDATE public.user_cart c
+SET quantity = dup.total_quantity
+FROM (
+    SELECT id,
+           ROW_NUMBER() OVER (PARTITION BY user_id, product_id, COALESCE(variant_id, '') ORDER BY created_at, id) AS rn,
+           SUM(quantity) OVER (PARTITION BY user_id, product_id, COALESCE(variant_id, '')) AS total_quantity
+    FROM public.user_cart
+) dup
+WHERE c.id = dup.id AND dup.rn = 1 AND c.quantity <> dup.total_quantity;
+
+DELETE FROM public.user_cart c
+USING (
+    SELECT id,
+           ROW_NUMBER() OVER (PARTITION BY user_id, product_id, COALESCE(variant_id, '') ORDER BY created_at, id) AS rn
+    FROM public.user_cart
+) dup
+WHERE c.id = dup.id AND dup.rn > 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_user_cart_product ON public.user_cart (user_id, product_id, (COALESCE(variant_id, '')));
+
+UPDATE guest_cart_item c
+SET quantity = dup.total_quantity
+FROM (
+    SELECT id,
+           ROW_NUMBER() OVER (PARTITION BY guest_cart_id, product_id, COALESCE(variant_id, '') ORDER BY created_at, id) AS rn,
+           SUM(quantity) OVER (PARTITION BY guest_cart_id, product_id, COALESCE(variant_id, '')) AS total_quantity
+    FROM guest_cart_item
+) dup
+WHERE c.id = dup.id AND dup.rn = 1 AND c.quantity <> dup.total_quantity;
+
+DELETE FROM guest_cart_item c
+USING (
+    SELECT id,
+           ROW_NUMBER() OVER (PARTITION BY guest_cart_id, product_id, COALESCE(variant_id, '') ORDER BY created_at, id) AS rn
+    FROM guest_cart_item
+) dup
+WHERE c.id = dup.id AND dup.rn > 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_guest_cart_item_product ON guest_cart_item (guest_cart_id, product_id, (COALESCE(variant_id, '')));
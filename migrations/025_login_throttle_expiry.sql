@@ -0,0 +1,10 @@
+-- Baris login_throttle yang jendela hitungan gagal dan lockout-nya sudah lewat dihapus berkala berdasarkan expires_at.
+ALTER TABLE login_throttle ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+
+-- Jendela hitungan paling lama 15 menit, jadi satu jam setelah kegagalan terakhir aman untuk baris lama
+UPDATE login_throttle SET expires_at = GREATEST(last_failed_at + INTERVAL '1 hour', COALESCE(locked_until, last_failed_at))
+WHERE expires_at IS NULL;
+
+ALTER TABLE login_throttle ALTER COLUMN expires_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_login_throttle_expires_at ON login_throttle (expires_at);
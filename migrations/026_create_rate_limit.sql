@@ -0,0 +1,20 @@
+-- Rate limiter umum per key, dipakai untuk percobaan login dan pembuatan guest cart. Setiap key diawali tujuannya:
+-- "login-account:<email>", "login-ip:<address>" dan "guest-cart-ip:<address>".
+CREATE TABLE IF NOT EXISTS rate_limit (
+    limit_key    VARCHAR(255) PRIMARY KEY,
+    hit_count    INT         NOT NULL DEFAULT 0,
+    last_hit_at  TIMESTAMPTZ NOT NULL,
+    locked_until TIMESTAMPTZ,
+    expires_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_expires_at ON rate_limit (expires_at);
+
+-- Hitungan dan lockout yang masih berlaku dipindahkan dengan nama key baru. Tabel login_throttle tidak dipakai lagi
+-- dan bisa di-drop setelah tidak ada binary lama yang berjalan.
+INSERT INTO rate_limit (limit_key, hit_count, last_hit_at, locked_until, expires_at)
+SELECT CASE WHEN throttle_key LIKE 'guest-cart:%' THEN 'guest-cart-ip:' || SUBSTRING(throttle_key FROM 12) ELSE 'login-' || throttle_key END,
+       failed_count, last_failed_at, locked_until, expires_at
+FROM login_throttle
+WHERE expires_at > NOW()
+ON CONFLICT (limit_key) DO NOTHING;
@@ -11,6 +11,7 @@ import (
 	common "github.com/daiyanuthsa/grpc-ecom-be/pb/common"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -26,6 +27,7 @@ const (
 type AddProductToCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -67,6 +69,13 @@ func (x *AddProductToCartRequest) GetProductId() string {
 	return ""
 }
 
+func (x *AddProductToCartRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
 type AddProductToCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -120,13 +129,34 @@ func (x *AddProductToCartResponse) GetId() string {
 }
 
 type CartItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
-	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	ProductName   string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CartId      string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	ProductId   string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	ImageUrl    string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	// Field double adalah field kompatibilitas untuk client lama; gunakan field *_money.
+	//
+	// Deprecated: Marked as deprecated in cart/cart.proto.
+	Price       float64 `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity    int32   `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId   string  `protobuf:"bytes,7,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Sku         string  `protobuf:"bytes,8,opt,name=sku,proto3" json:"sku,omitempty"`
+	VariantName string  `protobuf:"bytes,9,opt,name=variant_name,json=variantName,proto3" json:"variant_name,omitempty"`
+	// subtotal = price * quantity; total = subtotal - discount + tax
+	//
+	// Deprecated: Marked as deprecated in cart/cart.proto.
+	Subtotal float64 `protobuf:"fixed64,10,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	// Deprecated: Marked as deprecated in cart/cart.proto.
+	Discount float64 `protobuf:"fixed64,11,opt,name=discount,proto3" json:"discount,omitempty"`
+	// Deprecated: Marked as deprecated in cart/cart.proto.
+	Tax float64 `protobuf:"fixed64,12,opt,name=tax,proto3" json:"tax,omitempty"`
+	// Deprecated: Marked as deprecated in cart/cart.proto.
+	Total         float64       `protobuf:"fixed64,13,opt,name=total,proto3" json:"total,omitempty"`
+	PriceMoney    *common.Money `protobuf:"bytes,14,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	SubtotalMoney *common.Money `protobuf:"bytes,15,opt,name=subtotal_money,json=subtotalMoney,proto3" json:"subtotal_money,omitempty"`
+	DiscountMoney *common.Money `protobuf:"bytes,16,opt,name=discount_money,json=discountMoney,proto3" json:"discount_money,omitempty"`
+	TaxMoney      *common.Money `protobuf:"bytes,17,opt,name=tax_money,json=taxMoney,proto3" json:"tax_money,omitempty"`
+	TotalMoney    *common.Money `protobuf:"bytes,18,opt,name=total_money,json=totalMoney,proto3" json:"total_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -189,6 +219,7 @@ func (x *CartItem) GetImageUrl() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in cart/cart.proto.
 func (x *CartItem) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -203,6 +234,94 @@ func (x *CartItem) GetQuantity() int32 {
 	return 0
 }
 
+func (x *CartItem) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *CartItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CartItem) GetVariantName() string {
+	if x != nil {
+		return x.VariantName
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in cart/cart.proto.
+func (x *CartItem) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in cart/cart.proto.
+func (x *CartItem) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in cart/cart.proto.
+func (x *CartItem) GetTax() float64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in cart/cart.proto.
+func (x *CartItem) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CartItem) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+func (x *CartItem) GetSubtotalMoney() *common.Money {
+	if x != nil {
+		return x.SubtotalMoney
+	}
+	return nil
+}
+
+func (x *CartItem) GetDiscountMoney() *common.Money {
+	if x != nil {
+		return x.DiscountMoney
+	}
+	return nil
+}
+
+func (x *CartItem) GetTaxMoney() *common.Money {
+	if x != nil {
+		return x.TaxMoney
+	}
+	return nil
+}
+
+func (x *CartItem) GetTotalMoney() *common.Money {
+	if x != nil {
+		return x.TotalMoney
+	}
+	return nil
+}
+
 type ListCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -240,12 +359,18 @@ func (*ListCartRequest) Descriptor() ([]byte, []int) {
 }
 
 type ListCartResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Items         []*CartItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	TotalPrice    float64                `protobuf:"fixed64,3,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Base  *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items []*CartItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// Sama dengan price_breakdown.grand_total, jumlah yang ditagih saat checkout
+	//
+	// Deprecated: Marked as deprecated in cart/cart.proto.
+	TotalPrice      float64                `protobuf:"fixed64,3,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	PriceBreakdown  *common.PriceBreakdown `protobuf:"bytes,4,opt,name=price_breakdown,json=priceBreakdown,proto3" json:"price_breakdown,omitempty"`
+	TotalPriceMoney *common.Money          `protobuf:"bytes,5,opt,name=total_price_money,json=totalPriceMoney,proto3" json:"total_price_money,omitempty"`
+	AppliedCoupon   *AppliedCoupon         `protobuf:"bytes,6,opt,name=applied_coupon,json=appliedCoupon,proto3" json:"applied_coupon,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListCartResponse) Reset() {
@@ -292,6 +417,7 @@ func (x *ListCartResponse) GetItems() []*CartItem {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in cart/cart.proto.
 func (x *ListCartResponse) GetTotalPrice() float64 {
 	if x != nil {
 		return x.TotalPrice
@@ -299,6 +425,89 @@ func (x *ListCartResponse) GetTotalPrice() float64 {
 	return 0
 }
 
+func (x *ListCartResponse) GetPriceBreakdown() *common.PriceBreakdown {
+	if x != nil {
+		return x.PriceBreakdown
+	}
+	return nil
+}
+
+func (x *ListCartResponse) GetTotalPriceMoney() *common.Money {
+	if x != nil {
+		return x.TotalPriceMoney
+	}
+	return nil
+}
+
+func (x *ListCartResponse) GetAppliedCoupon() *AppliedCoupon {
+	if x != nil {
+		return x.AppliedCoupon
+	}
+	return nil
+}
+
+// AppliedCoupon adalah kupon yang dipasang di cart. Jika is_applicable false, diskon tidak dihitung dan
+// message berisi alasannya (mis. nilai cart belum mencapai minimum); checkout akan ditolak sampai kupon dilepas.
+type AppliedCoupon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	IsApplicable  bool                   `protobuf:"varint,2,opt,name=is_applicable,json=isApplicable,proto3" json:"is_applicable,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppliedCoupon) Reset() {
+	*x = AppliedCoupon{}
+	mi := &file_cart_cart_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppliedCoupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedCoupon) ProtoMessage() {}
+
+func (x *AppliedCoupon) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedCoupon.ProtoReflect.Descriptor instead.
+func (*AppliedCoupon) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AppliedCoupon) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AppliedCoupon) GetIsApplicable() bool {
+	if x != nil {
+		return x.IsApplicable
+	}
+	return false
+}
+
+func (x *AppliedCoupon) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type UpdateCartItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
@@ -309,7 +518,7 @@ type UpdateCartItemRequest struct {
 
 func (x *UpdateCartItemRequest) Reset() {
 	*x = UpdateCartItemRequest{}
-	mi := &file_cart_cart_proto_msgTypes[5]
+	mi := &file_cart_cart_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -321,7 +530,7 @@ func (x *UpdateCartItemRequest) String() string {
 func (*UpdateCartItemRequest) ProtoMessage() {}
 
 func (x *UpdateCartItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[5]
+	mi := &file_cart_cart_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,7 +543,7 @@ func (x *UpdateCartItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCartItemRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCartItemRequest) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{5}
+	return file_cart_cart_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateCartItemRequest) GetCartId() string {
@@ -360,7 +569,7 @@ type UpdateCartItemResponse struct {
 
 func (x *UpdateCartItemResponse) Reset() {
 	*x = UpdateCartItemResponse{}
-	mi := &file_cart_cart_proto_msgTypes[6]
+	mi := &file_cart_cart_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -372,7 +581,7 @@ func (x *UpdateCartItemResponse) String() string {
 func (*UpdateCartItemResponse) ProtoMessage() {}
 
 func (x *UpdateCartItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[6]
+	mi := &file_cart_cart_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,7 +594,7 @@ func (x *UpdateCartItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCartItemResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCartItemResponse) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{6}
+	return file_cart_cart_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateCartItemResponse) GetBase() *common.BaseResponse {
@@ -404,7 +613,7 @@ type DeleteCartItemRequest struct {
 
 func (x *DeleteCartItemRequest) Reset() {
 	*x = DeleteCartItemRequest{}
-	mi := &file_cart_cart_proto_msgTypes[7]
+	mi := &file_cart_cart_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -416,7 +625,7 @@ func (x *DeleteCartItemRequest) String() string {
 func (*DeleteCartItemRequest) ProtoMessage() {}
 
 func (x *DeleteCartItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[7]
+	mi := &file_cart_cart_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -429,7 +638,7 @@ func (x *DeleteCartItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCartItemRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCartItemRequest) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{7}
+	return file_cart_cart_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteCartItemRequest) GetCartId() string {
@@ -448,7 +657,7 @@ type DeleteCartItemResponse struct {
 
 func (x *DeleteCartItemResponse) Reset() {
 	*x = DeleteCartItemResponse{}
-	mi := &file_cart_cart_proto_msgTypes[8]
+	mi := &file_cart_cart_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -460,7 +669,7 @@ func (x *DeleteCartItemResponse) String() string {
 func (*DeleteCartItemResponse) ProtoMessage() {}
 
 func (x *DeleteCartItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[8]
+	mi := &file_cart_cart_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +682,7 @@ func (x *DeleteCartItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCartItemResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCartItemResponse) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{8}
+	return file_cart_cart_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteCartItemResponse) GetBase() *common.BaseResponse {
@@ -483,32 +692,324 @@ func (x *DeleteCartItemResponse) GetBase() *common.BaseResponse {
 	return nil
 }
 
+type CreateGuestCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuestCartRequest) Reset() {
+	*x = CreateGuestCartRequest{}
+	mi := &file_cart_cart_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestCartRequest) ProtoMessage() {}
+
+func (x *CreateGuestCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestCartRequest.ProtoReflect.Descriptor instead.
+func (*CreateGuestCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{10}
+}
+
+// cart_token dikirim di metadata x-cart-token pada panggilan cart tanpa login,
+// dan saat login supaya isinya digabung ke cart user.
+type CreateGuestCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CartToken     string                 `protobuf:"bytes,2,opt,name=cart_token,json=cartToken,proto3" json:"cart_token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuestCartResponse) Reset() {
+	*x = CreateGuestCartResponse{}
+	mi := &file_cart_cart_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestCartResponse) ProtoMessage() {}
+
+func (x *CreateGuestCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestCartResponse.ProtoReflect.Descriptor instead.
+func (*CreateGuestCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateGuestCartResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateGuestCartResponse) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
+func (x *CreateGuestCartResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Kupon hanya untuk cart user yang login, karena batas pemakaian dihitung per user.
+type ApplyCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyCouponRequest) Reset() {
+	*x = ApplyCouponRequest{}
+	mi := &file_cart_cart_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCouponRequest) ProtoMessage() {}
+
+func (x *ApplyCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCouponRequest.ProtoReflect.Descriptor instead.
+func (*ApplyCouponRequest) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ApplyCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ApplyCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyCouponResponse) Reset() {
+	*x = ApplyCouponResponse{}
+	mi := &file_cart_cart_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCouponResponse) ProtoMessage() {}
+
+func (x *ApplyCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCouponResponse.ProtoReflect.Descriptor instead.
+func (*ApplyCouponResponse) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ApplyCouponResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type RemoveCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCouponRequest) Reset() {
+	*x = RemoveCouponRequest{}
+	mi := &file_cart_cart_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCouponRequest) ProtoMessage() {}
+
+func (x *RemoveCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCouponRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCouponRequest) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{14}
+}
+
+type RemoveCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCouponResponse) Reset() {
+	*x = RemoveCouponResponse{}
+	mi := &file_cart_cart_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCouponResponse) ProtoMessage() {}
+
+func (x *RemoveCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCouponResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCouponResponse) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RemoveCouponResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
 var File_cart_cart_proto protoreflect.FileDescriptor
 
 const file_cart_cart_proto_rawDesc = "" +
 	"\n" +
-	"\x0fcart/cart.proto\x12\x04cart\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\"D\n" +
+	"\x0fcart/cart.proto\x12\x04cart\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x1ccommon/price_breakdown.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"m\n" +
 	"\x17AddProductToCartRequest\x12)\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\"T\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\x12'\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tvariantId\"T\n" +
 	"\x18AddProductToCartResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"\xb4\x01\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xf4\x04\n" +
 	"\bCartItem\x12\x17\n" +
 	"\acart_id\x18\x01 \x01(\tR\x06cartId\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
 	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12\x1b\n" +
-	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x06 \x01(\x05R\bquantity\"\x11\n" +
-	"\x0fListCartRequest\"\x83\x01\n" +
+	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x18\n" +
+	"\x05price\x18\x05 \x01(\x01B\x02\x18\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\a \x01(\tR\tvariantId\x12\x10\n" +
+	"\x03sku\x18\b \x01(\tR\x03sku\x12!\n" +
+	"\fvariant_name\x18\t \x01(\tR\vvariantName\x12\x1e\n" +
+	"\bsubtotal\x18\n" +
+	" \x01(\x01B\x02\x18\x01R\bsubtotal\x12\x1e\n" +
+	"\bdiscount\x18\v \x01(\x01B\x02\x18\x01R\bdiscount\x12\x14\n" +
+	"\x03tax\x18\f \x01(\x01B\x02\x18\x01R\x03tax\x12\x18\n" +
+	"\x05total\x18\r \x01(\x01B\x02\x18\x01R\x05total\x12.\n" +
+	"\vprice_money\x18\x0e \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\x124\n" +
+	"\x0esubtotal_money\x18\x0f \x01(\v2\r.common.MoneyR\rsubtotalMoney\x124\n" +
+	"\x0ediscount_money\x18\x10 \x01(\v2\r.common.MoneyR\rdiscountMoney\x12*\n" +
+	"\ttax_money\x18\x11 \x01(\v2\r.common.MoneyR\btaxMoney\x12.\n" +
+	"\vtotal_money\x18\x12 \x01(\v2\r.common.MoneyR\n" +
+	"totalMoney\"\x11\n" +
+	"\x0fListCartRequest\"\xbf\x02\n" +
 	"\x10ListCartResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12$\n" +
-	"\x05items\x18\x02 \x03(\v2\x0e.cart.CartItemR\x05items\x12\x1f\n" +
-	"\vtotal_price\x18\x03 \x01(\x01R\n" +
-	"totalPrice\"h\n" +
+	"\x05items\x18\x02 \x03(\v2\x0e.cart.CartItemR\x05items\x12#\n" +
+	"\vtotal_price\x18\x03 \x01(\x01B\x02\x18\x01R\n" +
+	"totalPrice\x12?\n" +
+	"\x0fprice_breakdown\x18\x04 \x01(\v2\x16.common.PriceBreakdownR\x0epriceBreakdown\x129\n" +
+	"\x11total_price_money\x18\x05 \x01(\v2\r.common.MoneyR\x0ftotalPriceMoney\x12:\n" +
+	"\x0eapplied_coupon\x18\x06 \x01(\v2\x13.cart.AppliedCouponR\rappliedCoupon\"b\n" +
+	"\rAppliedCoupon\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12#\n" +
+	"\ris_applicable\x18\x02 \x01(\bR\fisApplicable\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"h\n" +
 	"\x15UpdateCartItemRequest\x12#\n" +
 	"\acart_id\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06cartId\x12*\n" +
@@ -519,12 +1020,29 @@ const file_cart_cart_proto_rawDesc = "" +
 	"\acart_id\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06cartId\"B\n" +
 	"\x16DeleteCartItemResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xb5\x02\n" +
-	"\vCartService\x12Q\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\x18\n" +
+	"\x16CreateGuestCartRequest\"\x9d\x01\n" +
+	"\x17CreateGuestCartResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x1d\n" +
+	"\n" +
+	"cart_token\x18\x02 \x01(\tR\tcartToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"3\n" +
+	"\x12ApplyCouponRequest\x12\x1d\n" +
+	"\x04code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x04code\"?\n" +
+	"\x13ApplyCouponResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\x15\n" +
+	"\x13RemoveCouponRequest\"@\n" +
+	"\x14RemoveCouponResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\x90\x04\n" +
+	"\vCartService\x12N\n" +
+	"\x0fCreateGuestCart\x12\x1c.cart.CreateGuestCartRequest\x1a\x1d.cart.CreateGuestCartResponse\x12Q\n" +
 	"\x10AddProductToCart\x12\x1d.cart.AddProductToCartRequest\x1a\x1e.cart.AddProductToCartResponse\x129\n" +
 	"\bListCart\x12\x15.cart.ListCartRequest\x1a\x16.cart.ListCartResponse\x12K\n" +
 	"\x0eUpdateCartItem\x12\x1b.cart.UpdateCartItemRequest\x1a\x1c.cart.UpdateCartItemResponse\x12K\n" +
-	"\x0eDeleteCartItem\x12\x1b.cart.DeleteCartItemRequest\x1a\x1c.cart.DeleteCartItemResponseB-Z+github.com/daiyanuthsa/grpc-ecom-be/pb/cartb\x06proto3"
+	"\x0eDeleteCartItem\x12\x1b.cart.DeleteCartItemRequest\x1a\x1c.cart.DeleteCartItemResponse\x12B\n" +
+	"\vApplyCoupon\x12\x18.cart.ApplyCouponRequest\x1a\x19.cart.ApplyCouponResponse\x12E\n" +
+	"\fRemoveCoupon\x12\x19.cart.RemoveCouponRequest\x1a\x1a.cart.RemoveCouponResponseB-Z+github.com/daiyanuthsa/grpc-ecom-be/pb/cartb\x06proto3"
 
 var (
 	file_cart_cart_proto_rawDescOnce sync.Once
@@ -538,38 +1056,66 @@ func file_cart_cart_proto_rawDescGZIP() []byte {
 	return file_cart_cart_proto_rawDescData
 }
 
-var file_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_cart_cart_proto_goTypes = []any{
 	(*AddProductToCartRequest)(nil),  // 0: cart.AddProductToCartRequest
 	(*AddProductToCartResponse)(nil), // 1: cart.AddProductToCartResponse
 	(*CartItem)(nil),                 // 2: cart.CartItem
 	(*ListCartRequest)(nil),          // 3: cart.ListCartRequest
 	(*ListCartResponse)(nil),         // 4: cart.ListCartResponse
-	(*UpdateCartItemRequest)(nil),    // 5: cart.UpdateCartItemRequest
-	(*UpdateCartItemResponse)(nil),   // 6: cart.UpdateCartItemResponse
-	(*DeleteCartItemRequest)(nil),    // 7: cart.DeleteCartItemRequest
-	(*DeleteCartItemResponse)(nil),   // 8: cart.DeleteCartItemResponse
-	(*common.BaseResponse)(nil),      // 9: common.BaseResponse
+	(*AppliedCoupon)(nil),            // 5: cart.AppliedCoupon
+	(*UpdateCartItemRequest)(nil),    // 6: cart.UpdateCartItemRequest
+	(*UpdateCartItemResponse)(nil),   // 7: cart.UpdateCartItemResponse
+	(*DeleteCartItemRequest)(nil),    // 8: cart.DeleteCartItemRequest
+	(*DeleteCartItemResponse)(nil),   // 9: cart.DeleteCartItemResponse
+	(*CreateGuestCartRequest)(nil),   // 10: cart.CreateGuestCartRequest
+	(*CreateGuestCartResponse)(nil),  // 11: cart.CreateGuestCartResponse
+	(*ApplyCouponRequest)(nil),       // 12: cart.ApplyCouponRequest
+	(*ApplyCouponResponse)(nil),      // 13: cart.ApplyCouponResponse
+	(*RemoveCouponRequest)(nil),      // 14: cart.RemoveCouponRequest
+	(*RemoveCouponResponse)(nil),     // 15: cart.RemoveCouponResponse
+	(*common.BaseResponse)(nil),      // 16: common.BaseResponse
+	(*common.Money)(nil),             // 17: common.Money
+	(*common.PriceBreakdown)(nil),    // 18: common.PriceBreakdown
+	(*timestamppb.Timestamp)(nil),    // 19: google.protobuf.Timestamp
 }
 var file_cart_cart_proto_depIdxs = []int32{
-	9, // 0: cart.AddProductToCartResponse.base:type_name -> common.BaseResponse
-	9, // 1: cart.ListCartResponse.base:type_name -> common.BaseResponse
-	2, // 2: cart.ListCartResponse.items:type_name -> cart.CartItem
-	9, // 3: cart.UpdateCartItemResponse.base:type_name -> common.BaseResponse
-	9, // 4: cart.DeleteCartItemResponse.base:type_name -> common.BaseResponse
-	0, // 5: cart.CartService.AddProductToCart:input_type -> cart.AddProductToCartRequest
-	3, // 6: cart.CartService.ListCart:input_type -> cart.ListCartRequest
-	5, // 7: cart.CartService.UpdateCartItem:input_type -> cart.UpdateCartItemRequest
-	7, // 8: cart.CartService.DeleteCartItem:input_type -> cart.DeleteCartItemRequest
-	1, // 9: cart.CartService.AddProductToCart:output_type -> cart.AddProductToCartResponse
-	4, // 10: cart.CartService.ListCart:output_type -> cart.ListCartResponse
-	6, // 11: cart.CartService.UpdateCartItem:output_type -> cart.UpdateCartItemResponse
-	8, // 12: cart.CartService.DeleteCartItem:output_type -> cart.DeleteCartItemResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	16, // 0: cart.AddProductToCartResponse.base:type_name -> common.BaseResponse
+	17, // 1: cart.CartItem.price_money:type_name -> common.Money
+	17, // 2: cart.CartItem.subtotal_money:type_name -> common.Money
+	17, // 3: cart.CartItem.discount_money:type_name -> common.Money
+	17, // 4: cart.CartItem.tax_money:type_name -> common.Money
+	17, // 5: cart.CartItem.total_money:type_name -> common.Money
+	16, // 6: cart.ListCartResponse.base:type_name -> common.BaseResponse
+	2,  // 7: cart.ListCartResponse.items:type_name -> cart.CartItem
+	18, // 8: cart.ListCartResponse.price_breakdown:type_name -> common.PriceBreakdown
+	17, // 9: cart.ListCartResponse.total_price_money:type_name -> common.Money
+	5,  // 10: cart.ListCartResponse.applied_coupon:type_name -> cart.AppliedCoupon
+	16, // 11: cart.UpdateCartItemResponse.base:type_name -> common.BaseResponse
+	16, // 12: cart.DeleteCartItemResponse.base:type_name -> common.BaseResponse
+	16, // 13: cart.CreateGuestCartResponse.base:type_name -> common.BaseResponse
+	19, // 14: cart.CreateGuestCartResponse.expires_at:type_name -> google.protobuf.Timestamp
+	16, // 15: cart.ApplyCouponResponse.base:type_name -> common.BaseResponse
+	16, // 16: cart.RemoveCouponResponse.base:type_name -> common.BaseResponse
+	10, // 17: cart.CartService.CreateGuestCart:input_type -> cart.CreateGuestCartRequest
+	0,  // 18: cart.CartService.AddProductToCart:input_type -> cart.AddProductToCartRequest
+	3,  // 19: cart.CartService.ListCart:input_type -> cart.ListCartRequest
+	6,  // 20: cart.CartService.UpdateCartItem:input_type -> cart.UpdateCartItemRequest
+	8,  // 21: cart.CartService.DeleteCartItem:input_type -> cart.DeleteCartItemRequest
+	12, // 22: cart.CartService.ApplyCoupon:input_type -> cart.ApplyCouponRequest
+	14, // 23: cart.CartService.RemoveCoupon:input_type -> cart.RemoveCouponRequest
+	11, // 24: cart.CartService.CreateGuestCart:output_type -> cart.CreateGuestCartResponse
+	1,  // 25: cart.CartService.AddProductToCart:output_type -> cart.AddProductToCartResponse
+	4,  // 26: cart.CartService.ListCart:output_type -> cart.ListCartResponse
+	7,  // 27: cart.CartService.UpdateCartItem:output_type -> cart.UpdateCartItemResponse
+	9,  // 28: cart.CartService.DeleteCartItem:output_type -> cart.DeleteCartItemResponse
+	13, // 29: cart.CartService.ApplyCoupon:output_type -> cart.ApplyCouponResponse
+	15, // 30: cart.CartService.RemoveCoupon:output_type -> cart.RemoveCouponResponse
+	24, // [24:31] is the sub-list for method output_type
+	17, // [17:24] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_cart_cart_proto_init() }
@@ -583,7 +1129,7 @@ func file_cart_cart_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cart_cart_proto_rawDesc), len(file_cart_cart_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,225 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: common/price_breakdown.proto
+
+package common
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PriceBreakdown is the order-level result of the pricing engine.
+// grand_total = subtotal - discount + tax + shipping.
+// Field double adalah field kompatibilitas untuk client lama; gunakan field *_money.
+type PriceBreakdown struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in common/price_breakdown.proto.
+	Subtotal float64 `protobuf:"fixed64,1,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	// Deprecated: Marked as deprecated in common/price_breakdown.proto.
+	Discount float64 `protobuf:"fixed64,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	// Deprecated: Marked as deprecated in common/price_breakdown.proto.
+	Tax float64 `protobuf:"fixed64,3,opt,name=tax,proto3" json:"tax,omitempty"`
+	// Deprecated: Marked as deprecated in common/price_breakdown.proto.
+	Shipping float64 `protobuf:"fixed64,4,opt,name=shipping,proto3" json:"shipping,omitempty"`
+	// Deprecated: Marked as deprecated in common/price_breakdown.proto.
+	GrandTotal      float64 `protobuf:"fixed64,5,opt,name=grand_total,json=grandTotal,proto3" json:"grand_total,omitempty"`
+	SubtotalMoney   *Money  `protobuf:"bytes,6,opt,name=subtotal_money,json=subtotalMoney,proto3" json:"subtotal_money,omitempty"`
+	DiscountMoney   *Money  `protobuf:"bytes,7,opt,name=discount_money,json=discountMoney,proto3" json:"discount_money,omitempty"`
+	TaxMoney        *Money  `protobuf:"bytes,8,opt,name=tax_money,json=taxMoney,proto3" json:"tax_money,omitempty"`
+	ShippingMoney   *Money  `protobuf:"bytes,9,opt,name=shipping_money,json=shippingMoney,proto3" json:"shipping_money,omitempty"`
+	GrandTotalMoney *Money  `protobuf:"bytes,10,opt,name=grand_total_money,json=grandTotalMoney,proto3" json:"grand_total_money,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PriceBreakdown) Reset() {
+	*x = PriceBreakdown{}
+	mi := &file_common_price_breakdown_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBreakdown) ProtoMessage() {}
+
+func (x *PriceBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_common_price_breakdown_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBreakdown.ProtoReflect.Descriptor instead.
+func (*PriceBreakdown) Descriptor() ([]byte, []int) {
+	return file_common_price_breakdown_proto_rawDescGZIP(), []int{0}
+}
+
+// Deprecated: Marked as deprecated in common/price_breakdown.proto.
+func (x *PriceBreakdown) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in common/price_breakdown.proto.
+func (x *PriceBreakdown) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in common/price_breakdown.proto.
+func (x *PriceBreakdown) GetTax() float64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in common/price_breakdown.proto.
+func (x *PriceBreakdown) GetShipping() float64 {
+	if x != nil {
+		return x.Shipping
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in common/price_breakdown.proto.
+func (x *PriceBreakdown) GetGrandTotal() float64 {
+	if x != nil {
+		return x.GrandTotal
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetSubtotalMoney() *Money {
+	if x != nil {
+		return x.SubtotalMoney
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetDiscountMoney() *Money {
+	if x != nil {
+		return x.DiscountMoney
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetTaxMoney() *Money {
+	if x != nil {
+		return x.TaxMoney
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetShippingMoney() *Money {
+	if x != nil {
+		return x.ShippingMoney
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetGrandTotalMoney() *Money {
+	if x != nil {
+		return x.GrandTotalMoney
+	}
+	return nil
+}
+
+var File_common_price_breakdown_proto protoreflect.FileDescriptor
+
+const file_common_price_breakdown_proto_rawDesc = "" +
+	"\n" +
+	"\x1ccommon/price_breakdown.proto\x12\x06common\x1a\x12common/money.proto\"\xb4\x03\n" +
+	"\x0ePriceBreakdown\x12\x1e\n" +
+	"\bsubtotal\x18\x01 \x01(\x01B\x02\x18\x01R\bsubtotal\x12\x1e\n" +
+	"\bdiscount\x18\x02 \x01(\x01B\x02\x18\x01R\bdiscount\x12\x14\n" +
+	"\x03tax\x18\x03 \x01(\x01B\x02\x18\x01R\x03tax\x12\x1e\n" +
+	"\bshipping\x18\x04 \x01(\x01B\x02\x18\x01R\bshipping\x12#\n" +
+	"\vgrand_total\x18\x05 \x01(\x01B\x02\x18\x01R\n" +
+	"grandTotal\x124\n" +
+	"\x0esubtotal_money\x18\x06 \x01(\v2\r.common.MoneyR\rsubtotalMoney\x124\n" +
+	"\x0ediscount_money\x18\a \x01(\v2\r.common.MoneyR\rdiscountMoney\x12*\n" +
+	"\ttax_money\x18\b \x01(\v2\r.common.MoneyR\btaxMoney\x124\n" +
+	"\x0eshipping_money\x18\t \x01(\v2\r.common.MoneyR\rshippingMoney\x129\n" +
+	"\x11grand_total_money\x18\n" +
+	" \x01(\v2\r.common.MoneyR\x0fgrandTotalMoneyB6Z4github.com/daiyanuthsa/grpc-ecom-be/pb/common;commonb\x06proto3"
+
+var (
+	file_common_price_breakdown_proto_rawDescOnce sync.Once
+	file_common_price_breakdown_proto_rawDescData []byte
+)
+
+func file_common_price_breakdown_proto_rawDescGZIP() []byte {
+	file_common_price_breakdown_proto_rawDescOnce.Do(func() {
+		file_common_price_breakdown_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_common_price_breakdown_proto_rawDesc), len(file_common_price_breakdown_proto_rawDesc)))
+	})
+	return file_common_price_breakdown_proto_rawDescData
+}
+
+var file_common_price_breakdown_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_common_price_breakdown_proto_goTypes = []any{
+	(*PriceBreakdown)(nil), // 0: common.PriceBreakdown
+	(*Money)(nil),          // 1: common.Money
+}
+var file_common_price_breakdown_proto_depIdxs = []int32{
+	1, // 0: common.PriceBreakdown.subtotal_money:type_name -> common.Money
+	1, // 1: common.PriceBreakdown.discount_money:type_name -> common.Money
+	1, // 2: common.PriceBreakdown.tax_money:type_name -> common.Money
+	1, // 3: common.PriceBreakdown.shipping_money:type_name -> common.Money
+	1, // 4: common.PriceBreakdown.grand_total_money:type_name -> common.Money
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_common_price_breakdown_proto_init() }
+func file_common_price_breakdown_proto_init() {
+	if File_common_price_breakdown_proto != nil {
+		return
+	}
+	file_common_money_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_price_breakdown_proto_rawDesc), len(file_common_price_breakdown_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_common_price_breakdown_proto_goTypes,
+		DependencyIndexes: file_common_price_breakdown_proto_depIdxs,
+		MessageInfos:      file_common_price_breakdown_proto_msgTypes,
+	}.Build()
+	File_common_price_breakdown_proto = out.File
+	file_common_price_breakdown_proto_goTypes = nil
+	file_common_price_breakdown_proto_depIdxs = nil
+}
@@ -0,0 +1,735 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: coupon/coupon.proto
+
+package coupon
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/daiyanuthsa/grpc-ecom-be/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Coupon struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code        string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// PERCENTAGE atau FIXED_AMOUNT
+	DiscountType string `protobuf:"bytes,4,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	// Untuk PERCENTAGE, dalam basis point (1000 = 10%)
+	PercentOffBasisPoints int32 `protobuf:"varint,5,opt,name=percent_off_basis_points,json=percentOffBasisPoints,proto3" json:"percent_off_basis_points,omitempty"`
+	// Untuk FIXED_AMOUNT
+	AmountOff    *common.Money          `protobuf:"bytes,6,opt,name=amount_off,json=amountOff,proto3" json:"amount_off,omitempty"`
+	MinCartValue *common.Money          `protobuf:"bytes,7,opt,name=min_cart_value,json=minCartValue,proto3" json:"min_cart_value,omitempty"`
+	ValidFrom    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	// 0 berarti tidak dibatasi
+	UsageLimit      int32                  `protobuf:"varint,10,opt,name=usage_limit,json=usageLimit,proto3" json:"usage_limit,omitempty"`
+	PerUserLimit    int32                  `protobuf:"varint,11,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	RedemptionCount int32                  `protobuf:"varint,12,opt,name=redemption_count,json=redemptionCount,proto3" json:"redemption_count,omitempty"`
+	IsActive        bool                   `protobuf:"varint,13,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ProductIds      []string               `protobuf:"bytes,14,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	CategoryIds     []string               `protobuf:"bytes,15,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,17,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Coupon) Reset() {
+	*x = Coupon{}
+	mi := &file_coupon_coupon_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coupon) ProtoMessage() {}
+
+func (x *Coupon) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_coupon_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coupon.ProtoReflect.Descriptor instead.
+func (*Coupon) Descriptor() ([]byte, []int) {
+	return file_coupon_coupon_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Coupon) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Coupon) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Coupon) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Coupon) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *Coupon) GetPercentOffBasisPoints() int32 {
+	if x != nil {
+		return x.PercentOffBasisPoints
+	}
+	return 0
+}
+
+func (x *Coupon) GetAmountOff() *common.Money {
+	if x != nil {
+		return x.AmountOff
+	}
+	return nil
+}
+
+func (x *Coupon) GetMinCartValue() *common.Money {
+	if x != nil {
+		return x.MinCartValue
+	}
+	return nil
+}
+
+func (x *Coupon) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *Coupon) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *Coupon) GetUsageLimit() int32 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *Coupon) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *Coupon) GetRedemptionCount() int32 {
+	if x != nil {
+		return x.RedemptionCount
+	}
+	return 0
+}
+
+func (x *Coupon) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Coupon) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *Coupon) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *Coupon) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Coupon) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// Kupon tanpa product_ids dan category_ids berlaku untuk semua produk. Kategori mencakup seluruh sub-kategorinya.
+type CreateCouponRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Code         string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description  string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DiscountType string                 `protobuf:"bytes,3,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	// Wajib untuk PERCENTAGE
+	PercentOffBasisPoints int32 `protobuf:"varint,4,opt,name=percent_off_basis_points,json=percentOffBasisPoints,proto3" json:"percent_off_basis_points,omitempty"`
+	// Wajib untuk FIXED_AMOUNT
+	AmountOff    *common.Money          `protobuf:"bytes,5,opt,name=amount_off,json=amountOff,proto3" json:"amount_off,omitempty"`
+	MinCartValue *common.Money          `protobuf:"bytes,6,opt,name=min_cart_value,json=minCartValue,proto3" json:"min_cart_value,omitempty"`
+	ValidFrom    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	// 0 berarti tidak dibatasi
+	UsageLimit    int32    `protobuf:"varint,9,opt,name=usage_limit,json=usageLimit,proto3" json:"usage_limit,omitempty"`
+	PerUserLimit  int32    `protobuf:"varint,10,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	ProductIds    []string `protobuf:"bytes,11,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	CategoryIds   []string `protobuf:"bytes,12,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCouponRequest) Reset() {
+	*x = CreateCouponRequest{}
+	mi := &file_coupon_coupon_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponRequest) ProtoMessage() {}
+
+func (x *CreateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_coupon_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponRequest.ProtoReflect.Descriptor instead.
+func (*CreateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_coupon_coupon_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetPercentOffBasisPoints() int32 {
+	if x != nil {
+		return x.PercentOffBasisPoints
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetAmountOff() *common.Money {
+	if x != nil {
+		return x.AmountOff
+	}
+	return nil
+}
+
+func (x *CreateCouponRequest) GetMinCartValue() *common.Money {
+	if x != nil {
+		return x.MinCartValue
+	}
+	return nil
+}
+
+func (x *CreateCouponRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *CreateCouponRequest) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *CreateCouponRequest) GetUsageLimit() int32 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *CreateCouponRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+type CreateCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCouponResponse) Reset() {
+	*x = CreateCouponResponse{}
+	mi := &file_coupon_coupon_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponResponse) ProtoMessage() {}
+
+func (x *CreateCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_coupon_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponResponse.ProtoReflect.Descriptor instead.
+func (*CreateCouponResponse) Descriptor() ([]byte, []int) {
+	return file_coupon_coupon_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateCouponResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateCouponResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListCouponsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponsRequest) Reset() {
+	*x = ListCouponsRequest{}
+	mi := &file_coupon_coupon_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponsRequest) ProtoMessage() {}
+
+func (x *ListCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_coupon_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponsRequest.ProtoReflect.Descriptor instead.
+func (*ListCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_coupon_coupon_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCouponsRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListCouponsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Coupons       []*Coupon                  `protobuf:"bytes,3,rep,name=coupons,proto3" json:"coupons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponsResponse) Reset() {
+	*x = ListCouponsResponse{}
+	mi := &file_coupon_coupon_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponsResponse) ProtoMessage() {}
+
+func (x *ListCouponsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_coupon_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponsResponse.ProtoReflect.Descriptor instead.
+func (*ListCouponsResponse) Descriptor() ([]byte, []int) {
+	return file_coupon_coupon_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListCouponsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListCouponsResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListCouponsResponse) GetCoupons() []*Coupon {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+// Kupon yang dinonaktifkan tidak bisa dipakai lagi; order yang sudah memakainya tidak berubah.
+type DeactivateCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateCouponRequest) Reset() {
+	*x = DeactivateCouponRequest{}
+	mi := &file_coupon_coupon_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateCouponRequest) ProtoMessage() {}
+
+func (x *DeactivateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_coupon_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateCouponRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_coupon_coupon_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeactivateCouponRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeactivateCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateCouponResponse) Reset() {
+	*x = DeactivateCouponResponse{}
+	mi := &file_coupon_coupon_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateCouponResponse) ProtoMessage() {}
+
+func (x *DeactivateCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coupon_coupon_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateCouponResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateCouponResponse) Descriptor() ([]byte, []int) {
+	return file_coupon_coupon_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeactivateCouponResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+var File_coupon_coupon_proto protoreflect.FileDescriptor
+
+const file_coupon_coupon_proto_rawDesc = "" +
+	"\n" +
+	"\x13coupon/coupon.proto\x12\x06coupon\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xae\x05\n" +
+	"\x06Coupon\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rdiscount_type\x18\x04 \x01(\tR\fdiscountType\x127\n" +
+	"\x18percent_off_basis_points\x18\x05 \x01(\x05R\x15percentOffBasisPoints\x12,\n" +
+	"\n" +
+	"amount_off\x18\x06 \x01(\v2\r.common.MoneyR\tamountOff\x123\n" +
+	"\x0emin_cart_value\x18\a \x01(\v2\r.common.MoneyR\fminCartValue\x129\n" +
+	"\n" +
+	"valid_from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\x12\x1f\n" +
+	"\vusage_limit\x18\n" +
+	" \x01(\x05R\n" +
+	"usageLimit\x12$\n" +
+	"\x0eper_user_limit\x18\v \x01(\x05R\fperUserLimit\x12)\n" +
+	"\x10redemption_count\x18\f \x01(\x05R\x0fredemptionCount\x12\x1b\n" +
+	"\tis_active\x18\r \x01(\bR\bisActive\x12\x1f\n" +
+	"\vproduct_ids\x18\x0e \x03(\tR\n" +
+	"productIds\x12!\n" +
+	"\fcategory_ids\x18\x0f \x03(\tR\vcategoryIds\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x11 \x01(\tR\tcreatedBy\"\x9a\x05\n" +
+	"\x13CreateCouponRequest\x120\n" +
+	"\x04code\x18\x01 \x01(\tB\x1c\xbaH\x19r\x172\x15^[A-Za-z0-9_-]{3,64}$R\x04code\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\vdescription\x12D\n" +
+	"\rdiscount_type\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1aR\n" +
+	"PERCENTAGER\fFIXED_AMOUNTR\fdiscountType\x12C\n" +
+	"\x18percent_off_basis_points\x18\x04 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\x90N(\x00R\x15percentOffBasisPoints\x12,\n" +
+	"\n" +
+	"amount_off\x18\x05 \x01(\v2\r.common.MoneyR\tamountOff\x123\n" +
+	"\x0emin_cart_value\x18\x06 \x01(\v2\r.common.MoneyR\fminCartValue\x129\n" +
+	"\n" +
+	"valid_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\x12(\n" +
+	"\vusage_limit\x18\t \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\n" +
+	"usageLimit\x12-\n" +
+	"\x0eper_user_limit\x18\n" +
+	" \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\fperUserLimit\x124\n" +
+	"\vproduct_ids\x18\v \x03(\tB\x13\xbaH\x10\x92\x01\r\x10d\x18\x01\"\ar\x05\x10\x01\x18\xff\x01R\n" +
+	"productIds\x126\n" +
+	"\fcategory_ids\x18\f \x03(\tB\x13\xbaH\x10\x92\x01\r\x10\x14\x18\x01\"\ar\x05\x10\x01\x18\xff\x01R\vcategoryIds\"P\n" +
+	"\x14CreateCouponResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"O\n" +
+	"\x12ListCouponsRequest\x129\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"\xa5\x01\n" +
+	"\x13ListCouponsResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\x12(\n" +
+	"\acoupons\x18\x03 \x03(\v2\x0e.coupon.CouponR\acoupons\"5\n" +
+	"\x17DeactivateCouponRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"D\n" +
+	"\x18DeactivateCouponResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xf9\x01\n" +
+	"\rCouponService\x12I\n" +
+	"\fCreateCoupon\x12\x1b.coupon.CreateCouponRequest\x1a\x1c.coupon.CreateCouponResponse\x12F\n" +
+	"\vListCoupons\x12\x1a.coupon.ListCouponsRequest\x1a\x1b.coupon.ListCouponsResponse\x12U\n" +
+	"\x10DeactivateCoupon\x12\x1f.coupon.DeactivateCouponRequest\x1a .coupon.DeactivateCouponResponseB/Z-github.com/daiyanuthsa/grpc-ecom-be/pb/couponb\x06proto3"
+
+var (
+	file_coupon_coupon_proto_rawDescOnce sync.Once
+	file_coupon_coupon_proto_rawDescData []byte
+)
+
+func file_coupon_coupon_proto_rawDescGZIP() []byte {
+	file_coupon_coupon_proto_rawDescOnce.Do(func() {
+		file_coupon_coupon_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_coupon_coupon_proto_rawDesc), len(file_coupon_coupon_proto_rawDesc)))
+	})
+	return file_coupon_coupon_proto_rawDescData
+}
+
+var file_coupon_coupon_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_coupon_coupon_proto_goTypes = []any{
+	(*Coupon)(nil),                    // 0: coupon.Coupon
+	(*CreateCouponRequest)(nil),       // 1: coupon.CreateCouponRequest
+	(*CreateCouponResponse)(nil),      // 2: coupon.CreateCouponResponse
+	(*ListCouponsRequest)(nil),        // 3: coupon.ListCouponsRequest
+	(*ListCouponsResponse)(nil),       // 4: coupon.ListCouponsResponse
+	(*DeactivateCouponRequest)(nil),   // 5: coupon.DeactivateCouponRequest
+	(*DeactivateCouponResponse)(nil),  // 6: coupon.DeactivateCouponResponse
+	(*common.Money)(nil),              // 7: common.Money
+	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
+	(*common.BaseResponse)(nil),       // 9: common.BaseResponse
+	(*common.PaginationRequest)(nil),  // 10: common.PaginationRequest
+	(*common.PaginationResponse)(nil), // 11: common.PaginationResponse
+}
+var file_coupon_coupon_proto_depIdxs = []int32{
+	7,  // 0: coupon.Coupon.amount_off:type_name -> common.Money
+	7,  // 1: coupon.Coupon.min_cart_value:type_name -> common.Money
+	8,  // 2: coupon.Coupon.valid_from:type_name -> google.protobuf.Timestamp
+	8,  // 3: coupon.Coupon.valid_to:type_name -> google.protobuf.Timestamp
+	8,  // 4: coupon.Coupon.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 5: coupon.CreateCouponRequest.amount_off:type_name -> common.Money
+	7,  // 6: coupon.CreateCouponRequest.min_cart_value:type_name -> common.Money
+	8,  // 7: coupon.CreateCouponRequest.valid_from:type_name -> google.protobuf.Timestamp
+	8,  // 8: coupon.CreateCouponRequest.valid_to:type_name -> google.protobuf.Timestamp
+	9,  // 9: coupon.CreateCouponResponse.base:type_name -> common.BaseResponse
+	10, // 10: coupon.ListCouponsRequest.pagination:type_name -> common.PaginationRequest
+	9,  // 11: coupon.ListCouponsResponse.base:type_name -> common.BaseResponse
+	11, // 12: coupon.ListCouponsResponse.pagination:type_name -> common.PaginationResponse
+	0,  // 13: coupon.ListCouponsResponse.coupons:type_name -> coupon.Coupon
+	9,  // 14: coupon.DeactivateCouponResponse.base:type_name -> common.BaseResponse
+	1,  // 15: coupon.CouponService.CreateCoupon:input_type -> coupon.CreateCouponRequest
+	3,  // 16: coupon.CouponService.ListCoupons:input_type -> coupon.ListCouponsRequest
+	5,  // 17: coupon.CouponService.DeactivateCoupon:input_type -> coupon.DeactivateCouponRequest
+	2,  // 18: coupon.CouponService.CreateCoupon:output_type -> coupon.CreateCouponResponse
+	4,  // 19: coupon.CouponService.ListCoupons:output_type -> coupon.ListCouponsResponse
+	6,  // 20: coupon.CouponService.DeactivateCoupon:output_type -> coupon.DeactivateCouponResponse
+	18, // [18:21] is the sub-list for method output_type
+	15, // [15:18] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_coupon_coupon_proto_init() }
+func file_coupon_coupon_proto_init() {
+	if File_coupon_coupon_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_coupon_coupon_proto_rawDesc), len(file_coupon_coupon_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_coupon_coupon_proto_goTypes,
+		DependencyIndexes: file_coupon_coupon_proto_depIdxs,
+		MessageInfos:      file_coupon_coupon_proto_msgTypes,
+	}.Build()
+	File_coupon_coupon_proto = out.File
+	file_coupon_coupon_proto_goTypes = nil
+	file_coupon_coupon_proto_depIdxs = nil
+}
@@ -25,11 +25,16 @@ const (
 )
 
 type CreateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	ImageFileName string                 `protobuf:"bytes,4,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Field kompatibilitas untuk client lama, dalam mata uang toko. Diabaikan jika price_money diisi.
+	//
+	// Deprecated: Marked as deprecated in product/product.proto.
+	Price         float64       `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	ImageFileName string        `protobuf:"bytes,4,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	CategoryIds   []string      `protobuf:"bytes,5,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	PriceMoney    *common.Money `protobuf:"bytes,6,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -78,6 +83,7 @@ func (x *CreateProductRequest) GetDescription() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in product/product.proto.
 func (x *CreateProductRequest) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -92,6 +98,20 @@ func (x *CreateProductRequest) GetImageFileName() string {
 	return ""
 }
 
+func (x *CreateProductRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
 type CreateProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -189,13 +209,21 @@ func (x *DetailProductRequest) GetId() string {
 }
 
 type DetailProductResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Base        *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id          string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Field kompatibilitas untuk client lama; gunakan price_money.
+	//
+	// Deprecated: Marked as deprecated in product/product.proto.
+	Price         float64           `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string            `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	CategoryIds   []string          `protobuf:"bytes,7,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	Options       []*ProductOption  `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty"`
+	Variants      []*ProductVariant `protobuf:"bytes,9,rep,name=variants,proto3" json:"variants,omitempty"`
+	Images        []*ProductImage   `protobuf:"bytes,10,rep,name=images,proto3" json:"images,omitempty"`
+	PriceMoney    *common.Money     `protobuf:"bytes,11,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -258,6 +286,7 @@ func (x *DetailProductResponse) GetDescription() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in product/product.proto.
 func (x *DetailProductResponse) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -272,31 +301,65 @@ func (x *DetailProductResponse) GetImageUrl() string {
 	return ""
 }
 
-type UpdateProductRequest struct {
+func (x *DetailProductResponse) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *DetailProductResponse) GetOptions() []*ProductOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *DetailProductResponse) GetVariants() []*ProductVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *DetailProductResponse) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *DetailProductResponse) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+type ProductImage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	ImageFileName string                 `protobuf:"bytes,5,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,4,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
+func (x *ProductImage) Reset() {
+	*x = ProductImage{}
 	mi := &file_product_product_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *ProductImage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*ProductImage) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+func (x *ProductImage) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -308,68 +371,61 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProductImage.ProtoReflect.Descriptor instead.
+func (*ProductImage) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UpdateProductRequest) GetId() string {
+func (x *ProductImage) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateProductRequest) GetDescription() string {
+func (x *ProductImage) GetImageUrl() string {
 	if x != nil {
-		return x.Description
+		return x.ImageUrl
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetPrice() float64 {
+func (x *ProductImage) GetSortOrder() int32 {
 	if x != nil {
-		return x.Price
+		return x.SortOrder
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetImageFileName() string {
+func (x *ProductImage) GetIsPrimary() bool {
 	if x != nil {
-		return x.ImageFileName
+		return x.IsPrimary
 	}
-	return ""
+	return false
 }
 
-type UpdateProductResponse struct {
+type ProductOption struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductResponse) Reset() {
-	*x = UpdateProductResponse{}
+func (x *ProductOption) Reset() {
+	*x = ProductOption{}
 	mi := &file_product_product_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductResponse) String() string {
+func (x *ProductOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductResponse) ProtoMessage() {}
+func (*ProductOption) ProtoMessage() {}
 
-func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+func (x *ProductOption) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -381,46 +437,47 @@ func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProductOption.ProtoReflect.Descriptor instead.
+func (*ProductOption) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UpdateProductResponse) GetBase() *common.BaseResponse {
+func (x *ProductOption) GetName() string {
 	if x != nil {
-		return x.Base
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateProductResponse) GetId() string {
+func (x *ProductOption) GetValues() []string {
 	if x != nil {
-		return x.Id
+		return x.Values
 	}
-	return ""
+	return nil
 }
 
-type DeleteProductRequest struct {
+type VariantOptionValue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductRequest) Reset() {
-	*x = DeleteProductRequest{}
+func (x *VariantOptionValue) Reset() {
+	*x = VariantOptionValue{}
 	mi := &file_product_product_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductRequest) String() string {
+func (x *VariantOptionValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductRequest) ProtoMessage() {}
+func (*VariantOptionValue) ProtoMessage() {}
 
-func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+func (x *VariantOptionValue) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -432,39 +489,58 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VariantOptionValue.ProtoReflect.Descriptor instead.
+func (*VariantOptionValue) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DeleteProductRequest) GetId() string {
+func (x *VariantOptionValue) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-type DeleteProductResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *VariantOptionValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
 }
 
-func (x *DeleteProductResponse) Reset() {
-	*x = DeleteProductResponse{}
+type ProductVariant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku   string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Field kompatibilitas untuk client lama; gunakan price_money.
+	//
+	// Deprecated: Marked as deprecated in product/product.proto.
+	Price            float64               `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	HasPriceOverride bool                  `protobuf:"varint,5,opt,name=has_price_override,json=hasPriceOverride,proto3" json:"has_price_override,omitempty"`
+	ImageUrl         string                `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Stock            int32                 `protobuf:"varint,7,opt,name=stock,proto3" json:"stock,omitempty"`
+	InStock          bool                  `protobuf:"varint,8,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	Options          []*VariantOptionValue `protobuf:"bytes,9,rep,name=options,proto3" json:"options,omitempty"`
+	PriceMoney       *common.Money         `protobuf:"bytes,10,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProductVariant) Reset() {
+	*x = ProductVariant{}
 	mi := &file_product_product_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductResponse) String() string {
+func (x *ProductVariant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductResponse) ProtoMessage() {}
+func (*ProductVariant) ProtoMessage() {}
 
-func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+func (x *ProductVariant) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -476,148 +552,116 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProductVariant.ProtoReflect.Descriptor instead.
+func (*ProductVariant) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DeleteProductResponse) GetBase() *common.BaseResponse {
+func (x *ProductVariant) GetId() string {
 	if x != nil {
-		return x.Base
+		return x.Id
 	}
-	return nil
-}
-
-type ListProductsRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
-	mi := &file_product_product_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListProductsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
-
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[8]
+func (x *ProductVariant) GetSku() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Sku
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{8}
+	return ""
 }
 
-func (x *ListProductsRequest) GetPagination() *common.PaginationRequest {
+func (x *ProductVariant) GetName() string {
 	if x != nil {
-		return x.Pagination
+		return x.Name
 	}
-	return nil
-}
-
-type ListProductsResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Pagination    *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Products      []*Product                 `protobuf:"bytes,3,rep,name=products,proto3" json:"products,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *ListProductsResponse) Reset() {
-	*x = ListProductsResponse{}
-	mi := &file_product_product_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+// Deprecated: Marked as deprecated in product/product.proto.
+func (x *ProductVariant) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
 }
 
-func (x *ListProductsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ProductVariant) GetHasPriceOverride() bool {
+	if x != nil {
+		return x.HasPriceOverride
+	}
+	return false
 }
 
-func (*ListProductsResponse) ProtoMessage() {}
-
-func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[9]
+func (x *ProductVariant) GetImageUrl() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ImageUrl
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
-func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{9}
+func (x *ProductVariant) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
 }
 
-func (x *ListProductsResponse) GetBase() *common.BaseResponse {
+func (x *ProductVariant) GetInStock() bool {
 	if x != nil {
-		return x.Base
+		return x.InStock
 	}
-	return nil
+	return false
 }
 
-func (x *ListProductsResponse) GetPagination() *common.PaginationResponse {
+func (x *ProductVariant) GetOptions() []*VariantOptionValue {
 	if x != nil {
-		return x.Pagination
+		return x.Options
 	}
 	return nil
 }
 
-func (x *ListProductsResponse) GetProducts() []*Product {
+func (x *ProductVariant) GetPriceMoney() *common.Money {
 	if x != nil {
-		return x.Products
+		return x.PriceMoney
 	}
 	return nil
 }
 
-type Product struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateProductRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Field kompatibilitas untuk client lama, dalam mata uang toko. Diabaikan jika price_money diisi.
+	//
+	// Deprecated: Marked as deprecated in product/product.proto.
+	Price         float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	ImageFileName string  `protobuf:"bytes,5,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	// Kategori baru produk. Jika kosong, kategori tidak diubah kecuali clear_category_ids diisi.
+	CategoryIds []string      `protobuf:"bytes,6,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	PriceMoney  *common.Money `protobuf:"bytes,7,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	// Menghapus semua kategori produk; hanya berlaku jika category_ids kosong.
+	ClearCategoryIds bool `protobuf:"varint,8,opt,name=clear_category_ids,json=clearCategoryIds,proto3" json:"clear_category_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Product) Reset() {
-	*x = Product{}
-	mi := &file_product_product_proto_msgTypes[10]
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_product_product_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Product) String() string {
+func (x *UpdateProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Product) ProtoMessage() {}
+func (*UpdateProductRequest) ProtoMessage() {}
 
-func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[10]
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,68 +672,1662 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Product.ProtoReflect.Descriptor instead.
-func (*Product) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Product) GetId() string {
+func (x *UpdateProductRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Product) GetName() string {
+func (x *UpdateProductRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Product) GetDescription() string {
+func (x *UpdateProductRequest) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *Product) GetPrice() float64 {
+// Deprecated: Marked as deprecated in product/product.proto.
+func (x *UpdateProductRequest) GetPrice() float64 {
 	if x != nil {
 		return x.Price
 	}
 	return 0
 }
 
-func (x *Product) GetImageUrl() string {
+func (x *UpdateProductRequest) GetImageFileName() string {
 	if x != nil {
-		return x.ImageUrl
+		return x.ImageFileName
 	}
 	return ""
 }
 
-type ListProductsAdminRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateProductRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
 }
 
-func (x *ListProductsAdminRequest) Reset() {
-	*x = ListProductsAdminRequest{}
+func (x *UpdateProductRequest) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetClearCategoryIds() bool {
+	if x != nil {
+		return x.ClearCategoryIds
+	}
+	return false
+}
+
+type UpdateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_product_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateProductResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateProductResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_product_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_product_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteProductResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListProductsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Filter        *ProductFilter            `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListProductsRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetFilter() *ProductFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Products      []*Product                 `protobuf:"bytes,3,rep,name=products,proto3" json:"products,omitempty"`
+	Facets        *ProductFacets             `protobuf:"bytes,4,opt,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListProductsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetFacets() *ProductFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type ProductFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinPrice      *float64               `protobuf:"fixed64,1,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	MaxPrice      *float64               `protobuf:"fixed64,2,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	NameContains  string                 `protobuf:"bytes,3,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	UpdatedFrom   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_from,json=updatedFrom,proto3" json:"updated_from,omitempty"`
+	UpdatedTo     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_to,json=updatedTo,proto3" json:"updated_to,omitempty"`
+	IsDeleted     *bool                  `protobuf:"varint,8,opt,name=is_deleted,json=isDeleted,proto3,oneof" json:"is_deleted,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,9,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductFilter) Reset() {
+	*x = ProductFilter{}
+	mi := &file_product_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductFilter) ProtoMessage() {}
+
+func (x *ProductFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductFilter.ProtoReflect.Descriptor instead.
+func (*ProductFilter) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProductFilter) GetMinPrice() float64 {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return 0
+}
+
+func (x *ProductFilter) GetMaxPrice() float64 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return 0
+}
+
+func (x *ProductFilter) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
+	}
+	return ""
+}
+
+func (x *ProductFilter) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ProductFilter) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ProductFilter) GetUpdatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedFrom
+	}
+	return nil
+}
+
+func (x *ProductFilter) GetUpdatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedTo
+	}
+	return nil
+}
+
+func (x *ProductFilter) GetIsDeleted() bool {
+	if x != nil && x.IsDeleted != nil {
+		return *x.IsDeleted
+	}
+	return false
+}
+
+func (x *ProductFilter) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type FacetBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetBucket) Reset() {
+	*x = FacetBucket{}
+	mi := &file_product_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetBucket) ProtoMessage() {}
+
+func (x *FacetBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetBucket.ProtoReflect.Descriptor instead.
+func (*FacetBucket) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FacetBucket) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FacetBucket) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *FacetBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ProductFacets struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         []*FacetBucket         `protobuf:"bytes,1,rep,name=price,proto3" json:"price,omitempty"`
+	CreatedAt     []*FacetBucket         `protobuf:"bytes,2,rep,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductFacets) Reset() {
+	*x = ProductFacets{}
+	mi := &file_product_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductFacets) ProtoMessage() {}
+
+func (x *ProductFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductFacets.ProtoReflect.Descriptor instead.
+func (*ProductFacets) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProductFacets) GetPrice() []*FacetBucket {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ProductFacets) GetCreatedAt() []*FacetBucket {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Product struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Field kompatibilitas untuk client lama; gunakan price_money.
+	//
+	// Deprecated: Marked as deprecated in product/product.proto.
+	Price         float64       `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string        `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	PriceMoney    *common.Money `protobuf:"bytes,6,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_product_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Product) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in product/product.proto.
+func (x *Product) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Product) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Product) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+type ListProductsAdminRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Filter        *ProductFilter            `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsAdminRequest) Reset() {
+	*x = ListProductsAdminRequest{}
+	mi := &file_product_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsAdminRequest) ProtoMessage() {}
+
+func (x *ListProductsAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsAdminRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsAdminRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListProductsAdminRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListProductsAdminRequest) GetFilter() *ProductFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListProductsAdminResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Products      []*ProductAdmin            `protobuf:"bytes,3,rep,name=products,proto3" json:"products,omitempty"`
+	Facets        *ProductFacets             `protobuf:"bytes,4,opt,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsAdminResponse) Reset() {
+	*x = ListProductsAdminResponse{}
+	mi := &file_product_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsAdminResponse) ProtoMessage() {}
+
+func (x *ListProductsAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsAdminResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsAdminResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListProductsAdminResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListProductsAdminResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListProductsAdminResponse) GetProducts() []*ProductAdmin {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsAdminResponse) GetFacets() *ProductFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type ProductAdmin struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Field kompatibilitas untuk client lama; gunakan price_money.
+	//
+	// Deprecated: Marked as deprecated in product/product.proto.
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,11,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	IsDeleted     bool                   `protobuf:"varint,12,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	PriceMoney    *common.Money          `protobuf:"bytes,13,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductAdmin) Reset() {
+	*x = ProductAdmin{}
+	mi := &file_product_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductAdmin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductAdmin) ProtoMessage() {}
+
+func (x *ProductAdmin) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductAdmin.ProtoReflect.Descriptor instead.
+func (*ProductAdmin) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ProductAdmin) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductAdmin) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductAdmin) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in product/product.proto.
+func (x *ProductAdmin) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductAdmin) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *ProductAdmin) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProductAdmin) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ProductAdmin) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ProductAdmin) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *ProductAdmin) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *ProductAdmin) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *ProductAdmin) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *ProductAdmin) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+type HighlightProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighlightProductsRequest) Reset() {
+	*x = HighlightProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighlightProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighlightProductsRequest) ProtoMessage() {}
+
+func (x *HighlightProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighlightProductsRequest.ProtoReflect.Descriptor instead.
+func (*HighlightProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{21}
+}
+
+type HighlightProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Products      []*Product             `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighlightProductsResponse) Reset() {
+	*x = HighlightProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighlightProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighlightProductsResponse) ProtoMessage() {}
+
+func (x *HighlightProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighlightProductsResponse.ProtoReflect.Descriptor instead.
+func (*HighlightProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *HighlightProductsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *HighlightProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type SearchProductsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Query         string                    `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRequest) ProtoMessage() {}
+
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchProductsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Products      []*Product                 `protobuf:"bytes,3,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsResponse) Reset() {
+	*x = SearchProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsResponse) ProtoMessage() {}
+
+func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
+func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchProductsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type ListProductsByCategoryRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	CategoryId    string                    `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsByCategoryRequest) Reset() {
+	*x = ListProductsByCategoryRequest{}
+	mi := &file_product_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsByCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsByCategoryRequest) ProtoMessage() {}
+
+func (x *ListProductsByCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsByCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsByCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListProductsByCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListProductsByCategoryRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListProductsByCategoryResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Products      []*Product                 `protobuf:"bytes,3,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsByCategoryResponse) Reset() {
+	*x = ListProductsByCategoryResponse{}
+	mi := &file_product_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsByCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsByCategoryResponse) ProtoMessage() {}
+
+func (x *ListProductsByCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsByCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsByCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListProductsByCategoryResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListProductsByCategoryResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListProductsByCategoryResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type SetProductOptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Options       []*ProductOption       `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductOptionsRequest) Reset() {
+	*x = SetProductOptionsRequest{}
+	mi := &file_product_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductOptionsRequest) ProtoMessage() {}
+
+func (x *SetProductOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductOptionsRequest.ProtoReflect.Descriptor instead.
+func (*SetProductOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetProductOptionsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetProductOptionsRequest) GetOptions() []*ProductOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type SetProductOptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductOptionsResponse) Reset() {
+	*x = SetProductOptionsResponse{}
+	mi := &file_product_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductOptionsResponse) ProtoMessage() {}
+
+func (x *SetProductOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductOptionsResponse.ProtoReflect.Descriptor instead.
+func (*SetProductOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetProductOptionsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type CreateProductVariantRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku       string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	// Field kompatibilitas untuk client lama, dalam mata uang toko. Diabaikan jika price_money diisi.
+	//
+	// Deprecated: Marked as deprecated in product/product.proto.
+	Price         *float64              `protobuf:"fixed64,3,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	ImageFileName string                `protobuf:"bytes,4,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	Stock         int32                 `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
+	Options       []*VariantOptionValue `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	PriceMoney    *common.Money         `protobuf:"bytes,7,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductVariantRequest) Reset() {
+	*x = CreateProductVariantRequest{}
+	mi := &file_product_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductVariantRequest) ProtoMessage() {}
+
+func (x *CreateProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateProductVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateProductVariantRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in product/product.proto.
+func (x *CreateProductVariantRequest) GetPrice() float64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *CreateProductVariantRequest) GetImageFileName() string {
+	if x != nil {
+		return x.ImageFileName
+	}
+	return ""
+}
+
+func (x *CreateProductVariantRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *CreateProductVariantRequest) GetOptions() []*VariantOptionValue {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateProductVariantRequest) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+type CreateProductVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductVariantResponse) Reset() {
+	*x = CreateProductVariantResponse{}
+	mi := &file_product_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductVariantResponse) ProtoMessage() {}
+
+func (x *CreateProductVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductVariantResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductVariantResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateProductVariantResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateProductVariantResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateProductVariantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku   string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	// Field kompatibilitas untuk client lama, dalam mata uang toko. Diabaikan jika price_money diisi.
+	//
+	// Deprecated: Marked as deprecated in product/product.proto.
+	Price         *float64              `protobuf:"fixed64,3,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	ImageFileName string                `protobuf:"bytes,4,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	Options       []*VariantOptionValue `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	PriceMoney    *common.Money         `protobuf:"bytes,6,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductVariantRequest) Reset() {
+	*x = UpdateProductVariantRequest{}
+	mi := &file_product_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductVariantRequest) ProtoMessage() {}
+
+func (x *UpdateProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateProductVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductVariantRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in product/product.proto.
+func (x *UpdateProductVariantRequest) GetPrice() float64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *UpdateProductVariantRequest) GetImageFileName() string {
+	if x != nil {
+		return x.ImageFileName
+	}
+	return ""
+}
+
+func (x *UpdateProductVariantRequest) GetOptions() []*VariantOptionValue {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *UpdateProductVariantRequest) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+type UpdateProductVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductVariantResponse) Reset() {
+	*x = UpdateProductVariantResponse{}
+	mi := &file_product_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductVariantResponse) ProtoMessage() {}
+
+func (x *UpdateProductVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductVariantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductVariantResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateProductVariantResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateProductVariantResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductVariantRequest) Reset() {
+	*x = DeleteProductVariantRequest{}
+	mi := &file_product_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductVariantRequest) ProtoMessage() {}
+
+func (x *DeleteProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteProductVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductVariantResponse) Reset() {
+	*x = DeleteProductVariantResponse{}
+	mi := &file_product_product_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsAdminRequest) String() string {
+func (x *DeleteProductVariantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsAdminRequest) ProtoMessage() {}
+func (*DeleteProductVariantResponse) ProtoMessage() {}
 
-func (x *ListProductsAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[11]
+func (x *DeleteProductVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,42 +2338,42 @@ func (x *ListProductsAdminRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsAdminRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsAdminRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DeleteProductVariantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductVariantResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListProductsAdminRequest) GetPagination() *common.PaginationRequest {
+func (x *DeleteProductVariantResponse) GetBase() *common.BaseResponse {
 	if x != nil {
-		return x.Pagination
+		return x.Base
 	}
 	return nil
 }
 
-type ListProductsAdminResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Pagination    *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Products      []*ProductAdmin            `protobuf:"bytes,3,rep,name=products,proto3" json:"products,omitempty"`
+type AttachProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ImageFileName string                 `protobuf:"bytes,2,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,3,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsAdminResponse) Reset() {
-	*x = ListProductsAdminResponse{}
-	mi := &file_product_product_proto_msgTypes[12]
+func (x *AttachProductImageRequest) Reset() {
+	*x = AttachProductImageRequest{}
+	mi := &file_product_product_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsAdminResponse) String() string {
+func (x *AttachProductImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsAdminResponse) ProtoMessage() {}
+func (*AttachProductImageRequest) ProtoMessage() {}
 
-func (x *ListProductsAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[12]
+func (x *AttachProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -746,65 +2384,55 @@ func (x *ListProductsAdminResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsAdminResponse.ProtoReflect.Descriptor instead.
-func (*ListProductsAdminResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use AttachProductImageRequest.ProtoReflect.Descriptor instead.
+func (*AttachProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ListProductsAdminResponse) GetBase() *common.BaseResponse {
+func (x *AttachProductImageRequest) GetProductId() string {
 	if x != nil {
-		return x.Base
+		return x.ProductId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListProductsAdminResponse) GetPagination() *common.PaginationResponse {
+func (x *AttachProductImageRequest) GetImageFileName() string {
 	if x != nil {
-		return x.Pagination
+		return x.ImageFileName
 	}
-	return nil
+	return ""
 }
 
-func (x *ListProductsAdminResponse) GetProducts() []*ProductAdmin {
+func (x *AttachProductImageRequest) GetIsPrimary() bool {
 	if x != nil {
-		return x.Products
+		return x.IsPrimary
 	}
-	return nil
+	return false
 }
 
-type ProductAdmin struct {
+type AttachProductImageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	UpdatedBy     string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
-	DeletedBy     string                 `protobuf:"bytes,11,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
-	IsDeleted     bool                   `protobuf:"varint,12,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductAdmin) Reset() {
-	*x = ProductAdmin{}
-	mi := &file_product_product_proto_msgTypes[13]
+func (x *AttachProductImageResponse) Reset() {
+	*x = AttachProductImageResponse{}
+	mi := &file_product_product_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductAdmin) String() string {
+func (x *AttachProductImageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductAdmin) ProtoMessage() {}
+func (*AttachProductImageResponse) ProtoMessage() {}
 
-func (x *ProductAdmin) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[13]
+func (x *AttachProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -815,116 +2443,231 @@ func (x *ProductAdmin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductAdmin.ProtoReflect.Descriptor instead.
-func (*ProductAdmin) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use AttachProductImageResponse.ProtoReflect.Descriptor instead.
+func (*AttachProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ProductAdmin) GetId() string {
+func (x *AttachProductImageResponse) GetBase() *common.BaseResponse {
 	if x != nil {
-		return x.Id
+		return x.Base
 	}
-	return ""
+	return nil
 }
 
-func (x *ProductAdmin) GetName() string {
+func (x *AttachProductImageResponse) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ProductAdmin) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+type ReorderProductImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ImageIds      []string               `protobuf:"bytes,2,rep,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductAdmin) GetPrice() float64 {
+func (x *ReorderProductImagesRequest) Reset() {
+	*x = ReorderProductImagesRequest{}
+	mi := &file_product_product_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProductImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProductImagesRequest) ProtoMessage() {}
+
+func (x *ReorderProductImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[37]
 	if x != nil {
-		return x.Price
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ProductAdmin) GetImageUrl() string {
+// Deprecated: Use ReorderProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReorderProductImagesRequest) GetProductId() string {
 	if x != nil {
-		return x.ImageUrl
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *ProductAdmin) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ReorderProductImagesRequest) GetImageIds() []string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ImageIds
 	}
 	return nil
 }
 
-func (x *ProductAdmin) GetCreatedBy() string {
+type ReorderProductImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProductImagesResponse) Reset() {
+	*x = ReorderProductImagesResponse{}
+	mi := &file_product_product_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProductImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProductImagesResponse) ProtoMessage() {}
+
+func (x *ReorderProductImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[38]
 	if x != nil {
-		return x.CreatedBy
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ProductAdmin) GetUpdatedAt() *timestamppb.Timestamp {
+// Deprecated: Use ReorderProductImagesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReorderProductImagesResponse) GetBase() *common.BaseResponse {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Base
 	}
 	return nil
 }
 
-func (x *ProductAdmin) GetUpdatedBy() string {
+type SetPrimaryProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImageId       string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryProductImageRequest) Reset() {
+	*x = SetPrimaryProductImageRequest{}
+	mi := &file_product_product_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryProductImageRequest) ProtoMessage() {}
+
+func (x *SetPrimaryProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[39]
 	if x != nil {
-		return x.UpdatedBy
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ProductAdmin) GetDeletedAt() *timestamppb.Timestamp {
+// Deprecated: Use SetPrimaryProductImageRequest.ProtoReflect.Descriptor instead.
+func (*SetPrimaryProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetPrimaryProductImageRequest) GetImageId() string {
 	if x != nil {
-		return x.DeletedAt
+		return x.ImageId
 	}
-	return nil
+	return ""
 }
 
-func (x *ProductAdmin) GetDeletedBy() string {
+type SetPrimaryProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryProductImageResponse) Reset() {
+	*x = SetPrimaryProductImageResponse{}
+	mi := &file_product_product_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryProductImageResponse) ProtoMessage() {}
+
+func (x *SetPrimaryProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[40]
 	if x != nil {
-		return x.DeletedBy
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ProductAdmin) GetIsDeleted() bool {
+// Deprecated: Use SetPrimaryProductImageResponse.ProtoReflect.Descriptor instead.
+func (*SetPrimaryProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetPrimaryProductImageResponse) GetBase() *common.BaseResponse {
 	if x != nil {
-		return x.IsDeleted
+		return x.Base
 	}
-	return false
+	return nil
 }
 
-type HighlightProductsRequest struct {
+type DetachProductImageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImageId       string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HighlightProductsRequest) Reset() {
-	*x = HighlightProductsRequest{}
-	mi := &file_product_product_proto_msgTypes[14]
+func (x *DetachProductImageRequest) Reset() {
+	*x = DetachProductImageRequest{}
+	mi := &file_product_product_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HighlightProductsRequest) String() string {
+func (x *DetachProductImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HighlightProductsRequest) ProtoMessage() {}
+func (*DetachProductImageRequest) ProtoMessage() {}
 
-func (x *HighlightProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[14]
+func (x *DetachProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,34 +2678,40 @@ func (x *HighlightProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HighlightProductsRequest.ProtoReflect.Descriptor instead.
-func (*HighlightProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DetachProductImageRequest.ProtoReflect.Descriptor instead.
+func (*DetachProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{41}
 }
 
-type HighlightProductsResponse struct {
+func (x *DetachProductImageRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type DetachProductImageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Products      []*Product             `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HighlightProductsResponse) Reset() {
-	*x = HighlightProductsResponse{}
-	mi := &file_product_product_proto_msgTypes[15]
+func (x *DetachProductImageResponse) Reset() {
+	*x = DetachProductImageResponse{}
+	mi := &file_product_product_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HighlightProductsResponse) String() string {
+func (x *DetachProductImageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HighlightProductsResponse) ProtoMessage() {}
+func (*DetachProductImageResponse) ProtoMessage() {}
 
-func (x *HighlightProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[15]
+func (x *DetachProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -973,60 +2722,93 @@ func (x *HighlightProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HighlightProductsResponse.ProtoReflect.Descriptor instead.
-func (*HighlightProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DetachProductImageResponse.ProtoReflect.Descriptor instead.
+func (*DetachProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *HighlightProductsResponse) GetBase() *common.BaseResponse {
+func (x *DetachProductImageResponse) GetBase() *common.BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *HighlightProductsResponse) GetProducts() []*Product {
-	if x != nil {
-		return x.Products
-	}
-	return nil
-}
-
 var File_product_product_proto protoreflect.FileDescriptor
 
 const file_product_product_proto_rawDesc = "" +
 	"\n" +
-	"\x15product/product.proto\x12\aproduct\x1a\x1acommon/base_response.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbe\x01\n" +
+	"\x15product/product.proto\x12\aproduct\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa6\x02\n" +
 	"\x14CreateProductRequest\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x02\x18\xff\x01R\x04name\x12,\n" +
 	"\vdescription\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x05\x18\xff\x01R\vdescription\x12$\n" +
-	"\x05price\x18\x03 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x05price\x122\n" +
+	"\xbaH\ar\x05\x10\x05\x18\xff\x01R\vdescription\x12&\n" +
+	"\x05price\x18\x03 \x01(\x01B\x10\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00\x18\x01R\x05price\x122\n" +
 	"\x0fimage_file_name\x18\x04 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x02\x18\xff\x01R\rimageFileName\"Q\n" +
+	"\xbaH\ar\x05\x10\x02\x18\xff\x01R\rimageFileName\x124\n" +
+	"\fcategory_ids\x18\x05 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10\x14\"\ar\x05\x10\x01\x18\xff\x01R\vcategoryIds\x12.\n" +
+	"\vprice_money\x18\x06 \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\"Q\n" +
 	"\x15CreateProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\"&\n" +
 	"\x14DetailProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xba\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa7\x03\n" +
 	"\x15DetailProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x06 \x01(\tR\bimageUrl\"\xda\x01\n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\x05price\x18\x05 \x01(\x01B\x02\x18\x01R\x05price\x12\x1b\n" +
+	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12!\n" +
+	"\fcategory_ids\x18\a \x03(\tR\vcategoryIds\x120\n" +
+	"\aoptions\x18\b \x03(\v2\x16.product.ProductOptionR\aoptions\x123\n" +
+	"\bvariants\x18\t \x03(\v2\x17.product.ProductVariantR\bvariants\x12-\n" +
+	"\x06images\x18\n" +
+	" \x03(\v2\x15.product.ProductImageR\x06images\x12.\n" +
+	"\vprice_money\x18\v \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\"y\n" +
+	"\fProductImage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\timage_url\x18\x02 \x01(\tR\bimageUrl\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x03 \x01(\x05R\tsortOrder\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x04 \x01(\bR\tisPrimary\"\\\n" +
+	"\rProductOption\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12,\n" +
+	"\x06values\x18\x02 \x03(\tB\x14\xbaH\x11\x92\x01\x0e\b\x01\x102\x18\x01\"\x06r\x04\x10\x01\x18dR\x06values\"T\n" +
+	"\x12VariantOptionValue\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12\x1f\n" +
+	"\x05value\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x05value\"\xc3\x02\n" +
+	"\x0eProductVariant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\x05price\x18\x04 \x01(\x01B\x02\x18\x01R\x05price\x12,\n" +
+	"\x12has_price_override\x18\x05 \x01(\bR\x10hasPriceOverride\x12\x1b\n" +
+	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12\x14\n" +
+	"\x05stock\x18\a \x01(\x05R\x05stock\x12\x19\n" +
+	"\bin_stock\x18\b \x01(\bR\ainStock\x125\n" +
+	"\aoptions\x18\t \x03(\v2\x1b.product.VariantOptionValueR\aoptions\x12.\n" +
+	"\vprice_money\x18\n" +
+	" \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\"\xf0\x02\n" +
 	"\x14UpdateProductRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x02\x18\xff\x01R\x02id\x12\x1e\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x02\x18\xff\x01R\x04name\x12,\n" +
 	"\vdescription\x18\x03 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x05\x18\xff\x01R\vdescription\x12$\n" +
-	"\x05price\x18\x04 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x05price\x122\n" +
+	"\xbaH\ar\x05\x10\x05\x18\xff\x01R\vdescription\x12&\n" +
+	"\x05price\x18\x04 \x01(\x01B\x10\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00\x18\x01R\x05price\x122\n" +
 	"\x0fimage_file_name\x18\x05 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x02\x18\xff\x01R\rimageFileName\"Q\n" +
+	"\xbaH\ar\x05\x10\x02\x18\xff\x01R\rimageFileName\x124\n" +
+	"\fcategory_ids\x18\x06 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10\x14\"\ar\x05\x10\x01\x18\xff\x01R\vcategoryIds\x12.\n" +
+	"\vprice_money\x18\a \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\x12,\n" +
+	"\x12clear_category_ids\x18\b \x01(\bR\x10clearCategoryIds\"Q\n" +
 	"\x15UpdateProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\"2\n" +
@@ -1034,38 +2816,71 @@ const file_product_product_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x02\x18\xff\x01R\x02id\"A\n" +
 	"\x15DeleteProductResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"P\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\x80\x01\n" +
 	"\x13ListProductsRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"\xaa\x01\n" +
+	"pagination\x12.\n" +
+	"\x06filter\x18\x02 \x01(\v2\x16.product.ProductFilterR\x06filter\"\xda\x01\n" +
 	"\x14ListProductsResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
 	"pagination\x12,\n" +
-	"\bproducts\x18\x03 \x03(\v2\x10.product.ProductR\bproducts\"\x82\x01\n" +
+	"\bproducts\x18\x03 \x03(\v2\x10.product.ProductR\bproducts\x12.\n" +
+	"\x06facets\x18\x04 \x01(\v2\x16.product.ProductFacetsR\x06facets\"\x8f\x04\n" +
+	"\rProductFilter\x120\n" +
+	"\tmin_price\x18\x01 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\bminPrice\x88\x01\x01\x120\n" +
+	"\tmax_price\x18\x02 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x01R\bmaxPrice\x88\x01\x01\x12,\n" +
+	"\rname_contains\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18dR\fnameContains\x12=\n" +
+	"\fcreated_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12=\n" +
+	"\fupdated_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedFrom\x129\n" +
+	"\n" +
+	"updated_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedTo\x12\"\n" +
+	"\n" +
+	"is_deleted\x18\b \x01(\bH\x02R\tisDeleted\x88\x01\x01\x12)\n" +
+	"\vcategory_id\x18\t \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\n" +
+	"categoryIdB\f\n" +
+	"\n" +
+	"_min_priceB\f\n" +
+	"\n" +
+	"_max_priceB\r\n" +
+	"\v_is_deleted\"K\n" +
+	"\vFacetBucket\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"p\n" +
+	"\rProductFacets\x12*\n" +
+	"\x05price\x18\x01 \x03(\v2\x14.product.FacetBucketR\x05price\x123\n" +
+	"\n" +
+	"created_at\x18\x02 \x03(\v2\x14.product.FacetBucketR\tcreatedAt\"\xb6\x01\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x05 \x01(\tR\bimageUrl\"U\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\x05price\x18\x04 \x01(\x01B\x02\x18\x01R\x05price\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12.\n" +
+	"\vprice_money\x18\x06 \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\"\x85\x01\n" +
 	"\x18ListProductsAdminRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"\xb4\x01\n" +
+	"pagination\x12.\n" +
+	"\x06filter\x18\x02 \x01(\v2\x16.product.ProductFilterR\x06filter\"\xe4\x01\n" +
 	"\x19ListProductsAdminResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
 	"pagination\x121\n" +
-	"\bproducts\x18\x03 \x03(\v2\x15.product.ProductAdminR\bproducts\"\xb4\x03\n" +
+	"\bproducts\x18\x03 \x03(\v2\x15.product.ProductAdminR\bproducts\x12.\n" +
+	"\x06facets\x18\x04 \x01(\v2\x16.product.ProductFacetsR\x06facets\"\xe8\x03\n" +
 	"\fProductAdmin\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\x05price\x18\x04 \x01(\x01B\x02\x18\x01R\x05price\x12\x1b\n" +
 	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
@@ -1081,11 +2896,107 @@ const file_product_product_proto_rawDesc = "" +
 	"\n" +
 	"deleted_by\x18\v \x01(\tR\tdeletedBy\x12\x1d\n" +
 	"\n" +
-	"is_deleted\x18\f \x01(\bR\tisDeleted\"\x1a\n" +
+	"is_deleted\x18\f \x01(\bR\tisDeleted\x12.\n" +
+	"\vprice_money\x18\r \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\"\x1a\n" +
 	"\x18HighlightProductsRequest\"s\n" +
 	"\x19HighlightProductsResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12,\n" +
-	"\bproducts\x18\x02 \x03(\v2\x10.product.ProductR\bproducts2\xd5\x04\n" +
+	"\bproducts\x18\x02 \x03(\v2\x10.product.ProductR\bproducts\"s\n" +
+	"\x15SearchProductsRequest\x12\x1f\n" +
+	"\x05query\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x05query\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"\xac\x01\n" +
+	"\x16SearchProductsResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\x12,\n" +
+	"\bproducts\x18\x03 \x03(\v2\x10.product.ProductR\bproducts\"\x87\x01\n" +
+	"\x1dListProductsByCategoryRequest\x12+\n" +
+	"\vcategory_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\n" +
+	"categoryId\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"\xb4\x01\n" +
+	"\x1eListProductsByCategoryResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\x12,\n" +
+	"\bproducts\x18\x03 \x03(\v2\x10.product.ProductR\bproducts\"\x81\x01\n" +
+	"\x18SetProductOptionsRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\x12:\n" +
+	"\aoptions\x18\x02 \x03(\v2\x16.product.ProductOptionB\b\xbaH\x05\x92\x01\x02\x10\x05R\aoptions\"E\n" +
+	"\x19SetProductOptionsResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\xe0\x02\n" +
+	"\x1bCreateProductVariantRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\x12\x1b\n" +
+	"\x03sku\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x03sku\x12+\n" +
+	"\x05price\x18\x03 \x01(\x01B\x10\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00\x18\x01H\x00R\x05price\x88\x01\x01\x120\n" +
+	"\x0fimage_file_name\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\rimageFileName\x12\x1d\n" +
+	"\x05stock\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x05stock\x12A\n" +
+	"\aoptions\x18\x06 \x03(\v2\x1b.product.VariantOptionValueB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10\x05R\aoptions\x12.\n" +
+	"\vprice_money\x18\a \x01(\v2\r.common.MoneyR\n" +
+	"priceMoneyB\b\n" +
+	"\x06_price\"X\n" +
+	"\x1cCreateProductVariantResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xb2\x02\n" +
+	"\x1bUpdateProductVariantRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12\x1b\n" +
+	"\x03sku\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x03sku\x12+\n" +
+	"\x05price\x18\x03 \x01(\x01B\x10\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00\x18\x01H\x00R\x05price\x88\x01\x01\x120\n" +
+	"\x0fimage_file_name\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\rimageFileName\x12A\n" +
+	"\aoptions\x18\x05 \x03(\v2\x1b.product.VariantOptionValueB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10\x05R\aoptions\x12.\n" +
+	"\vprice_money\x18\x06 \x01(\v2\r.common.MoneyR\n" +
+	"priceMoneyB\b\n" +
+	"\x06_price\"X\n" +
+	"\x1cUpdateProductVariantResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"9\n" +
+	"\x1bDeleteProductVariantRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"H\n" +
+	"\x1cDeleteProductVariantResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\x99\x01\n" +
+	"\x19AttachProductImageRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\x122\n" +
+	"\x0fimage_file_name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x02\x18\xff\x01R\rimageFileName\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x03 \x01(\bR\tisPrimary\"V\n" +
+	"\x1aAttachProductImageResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"|\n" +
+	"\x1bReorderProductImagesRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\x122\n" +
+	"\timage_ids\x18\x02 \x03(\tB\x15\xbaH\x12\x92\x01\x0f\b\x01\x102\x18\x01\"\ar\x05\x10\x01\x18\xff\x01R\bimageIds\"H\n" +
+	"\x1cReorderProductImagesResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"F\n" +
+	"\x1dSetPrimaryProductImageRequest\x12%\n" +
+	"\bimage_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aimageId\"J\n" +
+	"\x1eSetPrimaryProductImageResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"B\n" +
+	"\x19DetachProductImageRequest\x12%\n" +
+	"\bimage_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aimageId\"F\n" +
+	"\x1aDetachProductImageResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xac\f\n" +
 	"\x0eProductService\x12N\n" +
 	"\rCreateProduct\x12\x1d.product.CreateProductRequest\x1a\x1e.product.CreateProductResponse\x12N\n" +
 	"\rDetailProduct\x12\x1d.product.DetailProductRequest\x1a\x1e.product.DetailProductResponse\x12N\n" +
@@ -1093,7 +3004,17 @@ const file_product_product_proto_rawDesc = "" +
 	"\rDeleteProduct\x12\x1d.product.DeleteProductRequest\x1a\x1e.product.DeleteProductResponse\x12K\n" +
 	"\fListProducts\x12\x1c.product.ListProductsRequest\x1a\x1d.product.ListProductsResponse\x12Z\n" +
 	"\x11ListProductsAdmin\x12!.product.ListProductsAdminRequest\x1a\".product.ListProductsAdminResponse\x12Z\n" +
-	"\x11HighlightProducts\x12!.product.HighlightProductsRequest\x1a\".product.HighlightProductsResponseB0Z.github.com/daiyanuthsa/grpc-ecom-be/pb/productb\x06proto3"
+	"\x11HighlightProducts\x12!.product.HighlightProductsRequest\x1a\".product.HighlightProductsResponse\x12Q\n" +
+	"\x0eSearchProducts\x12\x1e.product.SearchProductsRequest\x1a\x1f.product.SearchProductsResponse\x12i\n" +
+	"\x16ListProductsByCategory\x12&.product.ListProductsByCategoryRequest\x1a'.product.ListProductsByCategoryResponse\x12Z\n" +
+	"\x11SetProductOptions\x12!.product.SetProductOptionsRequest\x1a\".product.SetProductOptionsResponse\x12c\n" +
+	"\x14CreateProductVariant\x12$.product.CreateProductVariantRequest\x1a%.product.CreateProductVariantResponse\x12c\n" +
+	"\x14UpdateProductVariant\x12$.product.UpdateProductVariantRequest\x1a%.product.UpdateProductVariantResponse\x12c\n" +
+	"\x14DeleteProductVariant\x12$.product.DeleteProductVariantRequest\x1a%.product.DeleteProductVariantResponse\x12]\n" +
+	"\x12AttachProductImage\x12\".product.AttachProductImageRequest\x1a#.product.AttachProductImageResponse\x12c\n" +
+	"\x14ReorderProductImages\x12$.product.ReorderProductImagesRequest\x1a%.product.ReorderProductImagesResponse\x12i\n" +
+	"\x16SetPrimaryProductImage\x12&.product.SetPrimaryProductImageRequest\x1a'.product.SetPrimaryProductImageResponse\x12]\n" +
+	"\x12DetachProductImage\x12\".product.DetachProductImageRequest\x1a#.product.DetachProductImageResponseB0Z.github.com/daiyanuthsa/grpc-ecom-be/pb/productb\x06proto3"
 
 var (
 	file_product_product_proto_rawDescOnce sync.Once
@@ -1107,66 +3028,155 @@ func file_product_product_proto_rawDescGZIP() []byte {
 	return file_product_product_proto_rawDescData
 }
 
-var file_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_product_product_proto_goTypes = []any{
-	(*CreateProductRequest)(nil),      // 0: product.CreateProductRequest
-	(*CreateProductResponse)(nil),     // 1: product.CreateProductResponse
-	(*DetailProductRequest)(nil),      // 2: product.DetailProductRequest
-	(*DetailProductResponse)(nil),     // 3: product.DetailProductResponse
-	(*UpdateProductRequest)(nil),      // 4: product.UpdateProductRequest
-	(*UpdateProductResponse)(nil),     // 5: product.UpdateProductResponse
-	(*DeleteProductRequest)(nil),      // 6: product.DeleteProductRequest
-	(*DeleteProductResponse)(nil),     // 7: product.DeleteProductResponse
-	(*ListProductsRequest)(nil),       // 8: product.ListProductsRequest
-	(*ListProductsResponse)(nil),      // 9: product.ListProductsResponse
-	(*Product)(nil),                   // 10: product.Product
-	(*ListProductsAdminRequest)(nil),  // 11: product.ListProductsAdminRequest
-	(*ListProductsAdminResponse)(nil), // 12: product.ListProductsAdminResponse
-	(*ProductAdmin)(nil),              // 13: product.ProductAdmin
-	(*HighlightProductsRequest)(nil),  // 14: product.HighlightProductsRequest
-	(*HighlightProductsResponse)(nil), // 15: product.HighlightProductsResponse
-	(*common.BaseResponse)(nil),       // 16: common.BaseResponse
-	(*common.PaginationRequest)(nil),  // 17: common.PaginationRequest
-	(*common.PaginationResponse)(nil), // 18: common.PaginationResponse
-	(*timestamppb.Timestamp)(nil),     // 19: google.protobuf.Timestamp
+	(*CreateProductRequest)(nil),           // 0: product.CreateProductRequest
+	(*CreateProductResponse)(nil),          // 1: product.CreateProductResponse
+	(*DetailProductRequest)(nil),           // 2: product.DetailProductRequest
+	(*DetailProductResponse)(nil),          // 3: product.DetailProductResponse
+	(*ProductImage)(nil),                   // 4: product.ProductImage
+	(*ProductOption)(nil),                  // 5: product.ProductOption
+	(*VariantOptionValue)(nil),             // 6: product.VariantOptionValue
+	(*ProductVariant)(nil),                 // 7: product.ProductVariant
+	(*UpdateProductRequest)(nil),           // 8: product.UpdateProductRequest
+	(*UpdateProductResponse)(nil),          // 9: product.UpdateProductResponse
+	(*DeleteProductRequest)(nil),           // 10: product.DeleteProductRequest
+	(*DeleteProductResponse)(nil),          // 11: product.DeleteProductResponse
+	(*ListProductsRequest)(nil),            // 12: product.ListProductsRequest
+	(*ListProductsResponse)(nil),           // 13: product.ListProductsResponse
+	(*ProductFilter)(nil),                  // 14: product.ProductFilter
+	(*FacetBucket)(nil),                    // 15: product.FacetBucket
+	(*ProductFacets)(nil),                  // 16: product.ProductFacets
+	(*Product)(nil),                        // 17: product.Product
+	(*ListProductsAdminRequest)(nil),       // 18: product.ListProductsAdminRequest
+	(*ListProductsAdminResponse)(nil),      // 19: product.ListProductsAdminResponse
+	(*ProductAdmin)(nil),                   // 20: product.ProductAdmin
+	(*HighlightProductsRequest)(nil),       // 21: product.HighlightProductsRequest
+	(*HighlightProductsResponse)(nil),      // 22: product.HighlightProductsResponse
+	(*SearchProductsRequest)(nil),          // 23: product.SearchProductsRequest
+	(*SearchProductsResponse)(nil),         // 24: product.SearchProductsResponse
+	(*ListProductsByCategoryRequest)(nil),  // 25: product.ListProductsByCategoryRequest
+	(*ListProductsByCategoryResponse)(nil), // 26: product.ListProductsByCategoryResponse
+	(*SetProductOptionsRequest)(nil),       // 27: product.SetProductOptionsRequest
+	(*SetProductOptionsResponse)(nil),      // 28: product.SetProductOptionsResponse
+	(*CreateProductVariantRequest)(nil),    // 29: product.CreateProductVariantRequest
+	(*CreateProductVariantResponse)(nil),   // 30: product.CreateProductVariantResponse
+	(*UpdateProductVariantRequest)(nil),    // 31: product.UpdateProductVariantRequest
+	(*UpdateProductVariantResponse)(nil),   // 32: product.UpdateProductVariantResponse
+	(*DeleteProductVariantRequest)(nil),    // 33: product.DeleteProductVariantRequest
+	(*DeleteProductVariantResponse)(nil),   // 34: product.DeleteProductVariantResponse
+	(*AttachProductImageRequest)(nil),      // 35: product.AttachProductImageRequest
+	(*AttachProductImageResponse)(nil),     // 36: product.AttachProductImageResponse
+	(*ReorderProductImagesRequest)(nil),    // 37: product.ReorderProductImagesRequest
+	(*ReorderProductImagesResponse)(nil),   // 38: product.ReorderProductImagesResponse
+	(*SetPrimaryProductImageRequest)(nil),  // 39: product.SetPrimaryProductImageRequest
+	(*SetPrimaryProductImageResponse)(nil), // 40: product.SetPrimaryProductImageResponse
+	(*DetachProductImageRequest)(nil),      // 41: product.DetachProductImageRequest
+	(*DetachProductImageResponse)(nil),     // 42: product.DetachProductImageResponse
+	(*common.Money)(nil),                   // 43: common.Money
+	(*common.BaseResponse)(nil),            // 44: common.BaseResponse
+	(*common.PaginationRequest)(nil),       // 45: common.PaginationRequest
+	(*common.PaginationResponse)(nil),      // 46: common.PaginationResponse
+	(*timestamppb.Timestamp)(nil),          // 47: google.protobuf.Timestamp
 }
 var file_product_product_proto_depIdxs = []int32{
-	16, // 0: product.CreateProductResponse.base:type_name -> common.BaseResponse
-	16, // 1: product.DetailProductResponse.base:type_name -> common.BaseResponse
-	16, // 2: product.UpdateProductResponse.base:type_name -> common.BaseResponse
-	16, // 3: product.DeleteProductResponse.base:type_name -> common.BaseResponse
-	17, // 4: product.ListProductsRequest.pagination:type_name -> common.PaginationRequest
-	16, // 5: product.ListProductsResponse.base:type_name -> common.BaseResponse
-	18, // 6: product.ListProductsResponse.pagination:type_name -> common.PaginationResponse
-	10, // 7: product.ListProductsResponse.products:type_name -> product.Product
-	17, // 8: product.ListProductsAdminRequest.pagination:type_name -> common.PaginationRequest
-	16, // 9: product.ListProductsAdminResponse.base:type_name -> common.BaseResponse
-	18, // 10: product.ListProductsAdminResponse.pagination:type_name -> common.PaginationResponse
-	13, // 11: product.ListProductsAdminResponse.products:type_name -> product.ProductAdmin
-	19, // 12: product.ProductAdmin.created_at:type_name -> google.protobuf.Timestamp
-	19, // 13: product.ProductAdmin.updated_at:type_name -> google.protobuf.Timestamp
-	19, // 14: product.ProductAdmin.deleted_at:type_name -> google.protobuf.Timestamp
-	16, // 15: product.HighlightProductsResponse.base:type_name -> common.BaseResponse
-	10, // 16: product.HighlightProductsResponse.products:type_name -> product.Product
-	0,  // 17: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
-	2,  // 18: product.ProductService.DetailProduct:input_type -> product.DetailProductRequest
-	4,  // 19: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
-	6,  // 20: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
-	8,  // 21: product.ProductService.ListProducts:input_type -> product.ListProductsRequest
-	11, // 22: product.ProductService.ListProductsAdmin:input_type -> product.ListProductsAdminRequest
-	14, // 23: product.ProductService.HighlightProducts:input_type -> product.HighlightProductsRequest
-	1,  // 24: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
-	3,  // 25: product.ProductService.DetailProduct:output_type -> product.DetailProductResponse
-	5,  // 26: product.ProductService.UpdateProduct:output_type -> product.UpdateProductResponse
-	7,  // 27: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
-	9,  // 28: product.ProductService.ListProducts:output_type -> product.ListProductsResponse
-	12, // 29: product.ProductService.ListProductsAdmin:output_type -> product.ListProductsAdminResponse
-	15, // 30: product.ProductService.HighlightProducts:output_type -> product.HighlightProductsResponse
-	24, // [24:31] is the sub-list for method output_type
-	17, // [17:24] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	43, // 0: product.CreateProductRequest.price_money:type_name -> common.Money
+	44, // 1: product.CreateProductResponse.base:type_name -> common.BaseResponse
+	44, // 2: product.DetailProductResponse.base:type_name -> common.BaseResponse
+	5,  // 3: product.DetailProductResponse.options:type_name -> product.ProductOption
+	7,  // 4: product.DetailProductResponse.variants:type_name -> product.ProductVariant
+	4,  // 5: product.DetailProductResponse.images:type_name -> product.ProductImage
+	43, // 6: product.DetailProductResponse.price_money:type_name -> common.Money
+	6,  // 7: product.ProductVariant.options:type_name -> product.VariantOptionValue
+	43, // 8: product.ProductVariant.price_money:type_name -> common.Money
+	43, // 9: product.UpdateProductRequest.price_money:type_name -> common.Money
+	44, // 10: product.UpdateProductResponse.base:type_name -> common.BaseResponse
+	44, // 11: product.DeleteProductResponse.base:type_name -> common.BaseResponse
+	45, // 12: product.ListProductsRequest.pagination:type_name -> common.PaginationRequest
+	14, // 13: product.ListProductsRequest.filter:type_name -> product.ProductFilter
+	44, // 14: product.ListProductsResponse.base:type_name -> common.BaseResponse
+	46, // 15: product.ListProductsResponse.pagination:type_name -> common.PaginationResponse
+	17, // 16: product.ListProductsResponse.products:type_name -> product.Product
+	16, // 17: product.ListProductsResponse.facets:type_name -> product.ProductFacets
+	47, // 18: product.ProductFilter.created_from:type_name -> google.protobuf.Timestamp
+	47, // 19: product.ProductFilter.created_to:type_name -> google.protobuf.Timestamp
+	47, // 20: product.ProductFilter.updated_from:type_name -> google.protobuf.Timestamp
+	47, // 21: product.ProductFilter.updated_to:type_name -> google.protobuf.Timestamp
+	15, // 22: product.ProductFacets.price:type_name -> product.FacetBucket
+	15, // 23: product.ProductFacets.created_at:type_name -> product.FacetBucket
+	43, // 24: product.Product.price_money:type_name -> common.Money
+	45, // 25: product.ListProductsAdminRequest.pagination:type_name -> common.PaginationRequest
+	14, // 26: product.ListProductsAdminRequest.filter:type_name -> product.ProductFilter
+	44, // 27: product.ListProductsAdminResponse.base:type_name -> common.BaseResponse
+	46, // 28: product.ListProductsAdminResponse.pagination:type_name -> common.PaginationResponse
+	20, // 29: product.ListProductsAdminResponse.products:type_name -> product.ProductAdmin
+	16, // 30: product.ListProductsAdminResponse.facets:type_name -> product.ProductFacets
+	47, // 31: product.ProductAdmin.created_at:type_name -> google.protobuf.Timestamp
+	47, // 32: product.ProductAdmin.updated_at:type_name -> google.protobuf.Timestamp
+	47, // 33: product.ProductAdmin.deleted_at:type_name -> google.protobuf.Timestamp
+	43, // 34: product.ProductAdmin.price_money:type_name -> common.Money
+	44, // 35: product.HighlightProductsResponse.base:type_name -> common.BaseResponse
+	17, // 36: product.HighlightProductsResponse.products:type_name -> product.Product
+	45, // 37: product.SearchProductsRequest.pagination:type_name -> common.PaginationRequest
+	44, // 38: product.SearchProductsResponse.base:type_name -> common.BaseResponse
+	46, // 39: product.SearchProductsResponse.pagination:type_name -> common.PaginationResponse
+	17, // 40: product.SearchProductsResponse.products:type_name -> product.Product
+	45, // 41: product.ListProductsByCategoryRequest.pagination:type_name -> common.PaginationRequest
+	44, // 42: product.ListProductsByCategoryResponse.base:type_name -> common.BaseResponse
+	46, // 43: product.ListProductsByCategoryResponse.pagination:type_name -> common.PaginationResponse
+	17, // 44: product.ListProductsByCategoryResponse.products:type_name -> product.Product
+	5,  // 45: product.SetProductOptionsRequest.options:type_name -> product.ProductOption
+	44, // 46: product.SetProductOptionsResponse.base:type_name -> common.BaseResponse
+	6,  // 47: product.CreateProductVariantRequest.options:type_name -> product.VariantOptionValue
+	43, // 48: product.CreateProductVariantRequest.price_money:type_name -> common.Money
+	44, // 49: product.CreateProductVariantResponse.base:type_name -> common.BaseResponse
+	6,  // 50: product.UpdateProductVariantRequest.options:type_name -> product.VariantOptionValue
+	43, // 51: product.UpdateProductVariantRequest.price_money:type_name -> common.Money
+	44, // 52: product.UpdateProductVariantResponse.base:type_name -> common.BaseResponse
+	44, // 53: product.DeleteProductVariantResponse.base:type_name -> common.BaseResponse
+	44, // 54: product.AttachProductImageResponse.base:type_name -> common.BaseResponse
+	44, // 55: product.ReorderProductImagesResponse.base:type_name -> common.BaseResponse
+	44, // 56: product.SetPrimaryProductImageResponse.base:type_name -> common.BaseResponse
+	44, // 57: product.DetachProductImageResponse.base:type_name -> common.BaseResponse
+	0,  // 58: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
+	2,  // 59: product.ProductService.DetailProduct:input_type -> product.DetailProductRequest
+	8,  // 60: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
+	10, // 61: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
+	12, // 62: product.ProductService.ListProducts:input_type -> product.ListProductsRequest
+	18, // 63: product.ProductService.ListProductsAdmin:input_type -> product.ListProductsAdminRequest
+	21, // 64: product.ProductService.HighlightProducts:input_type -> product.HighlightProductsRequest
+	23, // 65: product.ProductService.SearchProducts:input_type -> product.SearchProductsRequest
+	25, // 66: product.ProductService.ListProductsByCategory:input_type -> product.ListProductsByCategoryRequest
+	27, // 67: product.ProductService.SetProductOptions:input_type -> product.SetProductOptionsRequest
+	29, // 68: product.ProductService.CreateProductVariant:input_type -> product.CreateProductVariantRequest
+	31, // 69: product.ProductService.UpdateProductVariant:input_type -> product.UpdateProductVariantRequest
+	33, // 70: product.ProductService.DeleteProductVariant:input_type -> product.DeleteProductVariantRequest
+	35, // 71: product.ProductService.AttachProductImage:input_type -> product.AttachProductImageRequest
+	37, // 72: product.ProductService.ReorderProductImages:input_type -> product.ReorderProductImagesRequest
+	39, // 73: product.ProductService.SetPrimaryProductImage:input_type -> product.SetPrimaryProductImageRequest
+	41, // 74: product.ProductService.DetachProductImage:input_type -> product.DetachProductImageRequest
+	1,  // 75: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
+	3,  // 76: product.ProductService.DetailProduct:output_type -> product.DetailProductResponse
+	9,  // 77: product.ProductService.UpdateProduct:output_type -> product.UpdateProductResponse
+	11, // 78: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
+	13, // 79: product.ProductService.ListProducts:output_type -> product.ListProductsResponse
+	19, // 80: product.ProductService.ListProductsAdmin:output_type -> product.ListProductsAdminResponse
+	22, // 81: product.ProductService.HighlightProducts:output_type -> product.HighlightProductsResponse
+	24, // 82: product.ProductService.SearchProducts:output_type -> product.SearchProductsResponse
+	26, // 83: product.ProductService.ListProductsByCategory:output_type -> product.ListProductsByCategoryResponse
+	28, // 84: product.ProductService.SetProductOptions:output_type -> product.SetProductOptionsResponse
+	30, // 85: product.ProductService.CreateProductVariant:output_type -> product.CreateProductVariantResponse
+	32, // 86: product.ProductService.UpdateProductVariant:output_type -> product.UpdateProductVariantResponse
+	34, // 87: product.ProductService.DeleteProductVariant:output_type -> product.DeleteProductVariantResponse
+	36, // 88: product.ProductService.AttachProductImage:output_type -> product.AttachProductImageResponse
+	38, // 89: product.ProductService.ReorderProductImages:output_type -> product.ReorderProductImagesResponse
+	40, // 90: product.ProductService.SetPrimaryProductImage:output_type -> product.SetPrimaryProductImageResponse
+	42, // 91: product.ProductService.DetachProductImage:output_type -> product.DetachProductImageResponse
+	75, // [75:92] is the sub-list for method output_type
+	58, // [58:75] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_product_product_proto_init() }
@@ -1174,13 +3184,16 @@ func file_product_product_proto_init() {
 	if File_product_product_proto != nil {
 		return
 	}
+	file_product_product_proto_msgTypes[14].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[29].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_product_proto_rawDesc), len(file_product_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
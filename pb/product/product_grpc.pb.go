@@ -19,13 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_CreateProduct_FullMethodName     = "/product.ProductService/CreateProduct"
-	ProductService_DetailProduct_FullMethodName     = "/product.ProductService/DetailProduct"
-	ProductService_UpdateProduct_FullMethodName     = "/product.ProductService/UpdateProduct"
-	ProductService_DeleteProduct_FullMethodName     = "/product.ProductService/DeleteProduct"
-	ProductService_ListProducts_FullMethodName      = "/product.ProductService/ListProducts"
-	ProductService_ListProductsAdmin_FullMethodName = "/product.ProductService/ListProductsAdmin"
-	ProductService_HighlightProducts_FullMethodName = "/product.ProductService/HighlightProducts"
+	ProductService_CreateProduct_FullMethodName          = "/product.ProductService/CreateProduct"
+	ProductService_DetailProduct_FullMethodName          = "/product.ProductService/DetailProduct"
+	ProductService_UpdateProduct_FullMethodName          = "/product.ProductService/UpdateProduct"
+	ProductService_DeleteProduct_FullMethodName          = "/product.ProductService/DeleteProduct"
+	ProductService_ListProducts_FullMethodName           = "/product.ProductService/ListProducts"
+	ProductService_ListProductsAdmin_FullMethodName      = "/product.ProductService/ListProductsAdmin"
+	ProductService_HighlightProducts_FullMethodName      = "/product.ProductService/HighlightProducts"
+	ProductService_SearchProducts_FullMethodName         = "/product.ProductService/SearchProducts"
+	ProductService_ListProductsByCategory_FullMethodName = "/product.ProductService/ListProductsByCategory"
+	ProductService_SetProductOptions_FullMethodName      = "/product.ProductService/SetProductOptions"
+	ProductService_CreateProductVariant_FullMethodName   = "/product.ProductService/CreateProductVariant"
+	ProductService_UpdateProductVariant_FullMethodName   = "/product.ProductService/UpdateProductVariant"
+	ProductService_DeleteProductVariant_FullMethodName   = "/product.ProductService/DeleteProductVariant"
+	ProductService_AttachProductImage_FullMethodName     = "/product.ProductService/AttachProductImage"
+	ProductService_ReorderProductImages_FullMethodName   = "/product.ProductService/ReorderProductImages"
+	ProductService_SetPrimaryProductImage_FullMethodName = "/product.ProductService/SetPrimaryProductImage"
+	ProductService_DetachProductImage_FullMethodName     = "/product.ProductService/DetachProductImage"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -39,6 +49,16 @@ type ProductServiceClient interface {
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
 	ListProductsAdmin(ctx context.Context, in *ListProductsAdminRequest, opts ...grpc.CallOption) (*ListProductsAdminResponse, error)
 	HighlightProducts(ctx context.Context, in *HighlightProductsRequest, opts ...grpc.CallOption) (*HighlightProductsResponse, error)
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error)
+	ListProductsByCategory(ctx context.Context, in *ListProductsByCategoryRequest, opts ...grpc.CallOption) (*ListProductsByCategoryResponse, error)
+	SetProductOptions(ctx context.Context, in *SetProductOptionsRequest, opts ...grpc.CallOption) (*SetProductOptionsResponse, error)
+	CreateProductVariant(ctx context.Context, in *CreateProductVariantRequest, opts ...grpc.CallOption) (*CreateProductVariantResponse, error)
+	UpdateProductVariant(ctx context.Context, in *UpdateProductVariantRequest, opts ...grpc.CallOption) (*UpdateProductVariantResponse, error)
+	DeleteProductVariant(ctx context.Context, in *DeleteProductVariantRequest, opts ...grpc.CallOption) (*DeleteProductVariantResponse, error)
+	AttachProductImage(ctx context.Context, in *AttachProductImageRequest, opts ...grpc.CallOption) (*AttachProductImageResponse, error)
+	ReorderProductImages(ctx context.Context, in *ReorderProductImagesRequest, opts ...grpc.CallOption) (*ReorderProductImagesResponse, error)
+	SetPrimaryProductImage(ctx context.Context, in *SetPrimaryProductImageRequest, opts ...grpc.CallOption) (*SetPrimaryProductImageResponse, error)
+	DetachProductImage(ctx context.Context, in *DetachProductImageRequest, opts ...grpc.CallOption) (*DetachProductImageResponse, error)
 }
 
 type productServiceClient struct {
@@ -119,6 +139,106 @@ func (c *productServiceClient) HighlightProducts(ctx context.Context, in *Highli
 	return out, nil
 }
 
+func (c *productServiceClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SearchProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProductsByCategory(ctx context.Context, in *ListProductsByCategoryRequest, opts ...grpc.CallOption) (*ListProductsByCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductsByCategoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductsByCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProductOptions(ctx context.Context, in *SetProductOptionsRequest, opts ...grpc.CallOption) (*SetProductOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProductOptionsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetProductOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateProductVariant(ctx context.Context, in *CreateProductVariantRequest, opts ...grpc.CallOption) (*CreateProductVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProductVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateProductVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateProductVariant(ctx context.Context, in *UpdateProductVariantRequest, opts ...grpc.CallOption) (*UpdateProductVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProductVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateProductVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProductVariant(ctx context.Context, in *DeleteProductVariantRequest, opts ...grpc.CallOption) (*DeleteProductVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProductVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AttachProductImage(ctx context.Context, in *AttachProductImageRequest, opts ...grpc.CallOption) (*AttachProductImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachProductImageResponse)
+	err := c.cc.Invoke(ctx, ProductService_AttachProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReorderProductImages(ctx context.Context, in *ReorderProductImagesRequest, opts ...grpc.CallOption) (*ReorderProductImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderProductImagesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReorderProductImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetPrimaryProductImage(ctx context.Context, in *SetPrimaryProductImageRequest, opts ...grpc.CallOption) (*SetPrimaryProductImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPrimaryProductImageResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetPrimaryProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DetachProductImage(ctx context.Context, in *DetachProductImageRequest, opts ...grpc.CallOption) (*DetachProductImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetachProductImageResponse)
+	err := c.cc.Invoke(ctx, ProductService_DetachProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -130,6 +250,16 @@ type ProductServiceServer interface {
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
 	ListProductsAdmin(context.Context, *ListProductsAdminRequest) (*ListProductsAdminResponse, error)
 	HighlightProducts(context.Context, *HighlightProductsRequest) (*HighlightProductsResponse, error)
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
+	ListProductsByCategory(context.Context, *ListProductsByCategoryRequest) (*ListProductsByCategoryResponse, error)
+	SetProductOptions(context.Context, *SetProductOptionsRequest) (*SetProductOptionsResponse, error)
+	CreateProductVariant(context.Context, *CreateProductVariantRequest) (*CreateProductVariantResponse, error)
+	UpdateProductVariant(context.Context, *UpdateProductVariantRequest) (*UpdateProductVariantResponse, error)
+	DeleteProductVariant(context.Context, *DeleteProductVariantRequest) (*DeleteProductVariantResponse, error)
+	AttachProductImage(context.Context, *AttachProductImageRequest) (*AttachProductImageResponse, error)
+	ReorderProductImages(context.Context, *ReorderProductImagesRequest) (*ReorderProductImagesResponse, error)
+	SetPrimaryProductImage(context.Context, *SetPrimaryProductImageRequest) (*SetPrimaryProductImageResponse, error)
+	DetachProductImage(context.Context, *DetachProductImageRequest) (*DetachProductImageResponse, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -161,6 +291,36 @@ func (UnimplementedProductServiceServer) ListProductsAdmin(context.Context, *Lis
 func (UnimplementedProductServiceServer) HighlightProducts(context.Context, *HighlightProductsRequest) (*HighlightProductsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HighlightProducts not implemented")
 }
+func (UnimplementedProductServiceServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductsByCategory(context.Context, *ListProductsByCategoryRequest) (*ListProductsByCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductsByCategory not implemented")
+}
+func (UnimplementedProductServiceServer) SetProductOptions(context.Context, *SetProductOptionsRequest) (*SetProductOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductOptions not implemented")
+}
+func (UnimplementedProductServiceServer) CreateProductVariant(context.Context, *CreateProductVariantRequest) (*CreateProductVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductVariant not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProductVariant(context.Context, *UpdateProductVariantRequest) (*UpdateProductVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductVariant not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProductVariant(context.Context, *DeleteProductVariantRequest) (*DeleteProductVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductVariant not implemented")
+}
+func (UnimplementedProductServiceServer) AttachProductImage(context.Context, *AttachProductImageRequest) (*AttachProductImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachProductImage not implemented")
+}
+func (UnimplementedProductServiceServer) ReorderProductImages(context.Context, *ReorderProductImagesRequest) (*ReorderProductImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderProductImages not implemented")
+}
+func (UnimplementedProductServiceServer) SetPrimaryProductImage(context.Context, *SetPrimaryProductImageRequest) (*SetPrimaryProductImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryProductImage not implemented")
+}
+func (UnimplementedProductServiceServer) DetachProductImage(context.Context, *DetachProductImageRequest) (*DetachProductImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachProductImage not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -308,6 +468,186 @@ func _ProductService_HighlightProducts_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SearchProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProductsByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsByCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProductsByCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProductsByCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProductsByCategory(ctx, req.(*ListProductsByCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetProductOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetProductOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductOptions(ctx, req.(*SetProductOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateProductVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProductVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProductVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProductVariant(ctx, req.(*CreateProductVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProductVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProductVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProductVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProductVariant(ctx, req.(*UpdateProductVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProductVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProductVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProductVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProductVariant(ctx, req.(*DeleteProductVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AttachProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AttachProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AttachProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AttachProductImage(ctx, req.(*AttachProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReorderProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderProductImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReorderProductImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReorderProductImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReorderProductImages(ctx, req.(*ReorderProductImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetPrimaryProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetPrimaryProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetPrimaryProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetPrimaryProductImage(ctx, req.(*SetPrimaryProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DetachProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DetachProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DetachProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DetachProductImage(ctx, req.(*DetachProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,6 +683,46 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HighlightProducts",
 			Handler:    _ProductService_HighlightProducts_Handler,
 		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductService_SearchProducts_Handler,
+		},
+		{
+			MethodName: "ListProductsByCategory",
+			Handler:    _ProductService_ListProductsByCategory_Handler,
+		},
+		{
+			MethodName: "SetProductOptions",
+			Handler:    _ProductService_SetProductOptions_Handler,
+		},
+		{
+			MethodName: "CreateProductVariant",
+			Handler:    _ProductService_CreateProductVariant_Handler,
+		},
+		{
+			MethodName: "UpdateProductVariant",
+			Handler:    _ProductService_UpdateProductVariant_Handler,
+		},
+		{
+			MethodName: "DeleteProductVariant",
+			Handler:    _ProductService_DeleteProductVariant_Handler,
+		},
+		{
+			MethodName: "AttachProductImage",
+			Handler:    _ProductService_AttachProductImage_Handler,
+		},
+		{
+			MethodName: "ReorderProductImages",
+			Handler:    _ProductService_ReorderProductImages_Handler,
+		},
+		{
+			MethodName: "SetPrimaryProductImage",
+			Handler:    _ProductService_SetPrimaryProductImage_Handler,
+		},
+		{
+			MethodName: "DetachProductImage",
+			Handler:    _ProductService_DetachProductImage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "product/product.proto",